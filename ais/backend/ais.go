@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"os"
 	"regexp"
+	"slices"
 	"sync"
 	"time"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/api/env"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
@@ -31,15 +33,28 @@ import (
 // NOTE: some of the methods here are part of the of the *extended* native AIS API outside
 // generic `BackendProvider` (see core/backend.go)
 
+// Remote cluster endpoints:
+// - each attached cluster is reachable via one or more configured proxy URLs (cmn.BackendConfAIS)
+//   and, additionally, via the public URLs of its other proxies (as per remote Smap);
+// - one endpoint is current; upon connection failure (unreachable, timeout, 502/503/504)
+//   remAis health-checks the rest and fails over to the first one that responds;
+// - all GET, PUT, HEAD, and list requests are retried with backoff (see remAis.do).
+
 // TODO:
 // - include `appliedCfgVer` in the GetInfo* response (to synchronize p._remais, etc.)
 // - periodically refresh remote Smap
-// - use m.remote[uuid].smap to load balance and retry disconnects
+// - use m.remote[uuid].smap to load balance
 
 const ua = "aisnode/backend"
 
 const remAisDefunct = "defunct" // uuid configured offline
 
+// retry (and fail over) remote cluster requests
+const (
+	remAisRetries = 3
+	remAisBackoff = time.Second // initial; doubles with every retry
+)
+
 // props to request when doing a HEAD — covers all cmn.ObjAttrs fields
 // TODO -- FIXME: remove
 const remAisHeadProps = apc.GetPropsSize + apc.LsPropsSepa + apc.GetPropsChecksum + apc.LsPropsSepa +
@@ -47,12 +62,19 @@ const remAisHeadProps = apc.GetPropsSize + apc.LsPropsSepa + apc.GetPropsChecksu
 
 type (
 	remAis struct {
-		smap *meta.Smap
-		m    *AISbp
-		url  string
-		uuid string
-		bp   api.BaseParams
-		bpL  api.BaseParams // long & list
+		smap    *meta.Smap
+		m       *AISbp
+		cliH    *http.Client
+		cliTLS  *http.Client
+		url     string // current endpoint
+		uuid    string
+		bp      api.BaseParams
+		bpL     api.BaseParams // long & list
+		conf    []string       // configured URLs
+		urls    []string       // configured and discovered (remote Smap) URLs to fail over to
+		toutL   time.Duration  // bpL client timeout
+		mu      sync.RWMutex   // protects current endpoint (and Smap) upon failover
+		failing atomic.Bool
 	}
 	AISbp struct {
 		t      core.TargetPut
@@ -88,12 +110,15 @@ func NewAIS(t core.TargetPut, tstats stats.Tracker, startingUp bool) *AISbp {
 func (r *remAis) String() string {
 	var alias string
 	for a, uuid := range r.m.alias {
-		if uuid == r.smap.UUID {
+		if uuid == r.uuid {
 			alias = a
 			break
 		}
 	}
-	return fmt.Sprintf("remote cluster (%s, %q, %q, %s)", r.url, alias, r.smap.UUID, r.smap)
+	r.mu.RLock()
+	s := fmt.Sprintf("remote cluster (%s, %q, %q, %s)", r.url, alias, r.uuid, r.smap)
+	r.mu.RUnlock()
+	return s
 }
 
 func unsetUUID(bck *cmn.Bck) { bck.Ns.UUID = "" }
@@ -208,7 +233,7 @@ func (m *AISbp) GetInfoInternal() (res meta.RemAisVec) {
 	m.mu.RLock()
 	res.A = make([]*meta.RemAis, 0, len(m.remote))
 	for uuid, remAis := range m.remote {
-		out := &meta.RemAis{UUID: uuid, URL: remAis.url}
+		out := &meta.RemAis{UUID: uuid, URL: remAis.currURL()}
 		for a, u := range m.alias {
 			if uuid == u {
 				out.Alias = a
@@ -228,21 +253,10 @@ func (m *AISbp) GetInfoInternal() (res meta.RemAisVec) {
 // See also: GetInfoInternal()
 // TODO: ditto
 func (m *AISbp) GetInfo(clusterConf cmn.BackendConfAIS) (res meta.RemAisVec) {
-	var (
-		cfg              = cmn.GCO.Get()
-		cliPlain, cliTLS = remaisClients(cfg.Client)
-	)
-
 	m.mu.RLock()
 	res.A = make([]*meta.RemAis, 0, len(m.remote))
 	for uuid, remAis := range m.remote {
-		var (
-			out    = &meta.RemAis{UUID: uuid, URL: remAis.url}
-			client = cliPlain
-		)
-		if cos.IsHTTPS(remAis.url) {
-			client = cliTLS
-		}
+		out := &meta.RemAis{UUID: uuid}
 		for a, u := range m.alias {
 			if uuid == u {
 				out.Alias = a
@@ -250,18 +264,29 @@ func (m *AISbp) GetInfo(clusterConf cmn.BackendConfAIS) (res meta.RemAisVec) {
 			}
 		}
 
-		// online?
-		if smap, err := api.GetClusterMap(api.BaseParams{Client: client, URL: remAis.url, UA: ua}); err == nil {
+		// online? (failing over if need be, but not retrying)
+		bp, _ := remAis.params()
+		smap, err := api.GetClusterMap(bp)
+		if err != nil && remAisRetriable(err) && remAis.failover(bp.URL) {
+			bp, _ = remAis.params()
+			smap, err = api.GetClusterMap(bp)
+		}
+		if err == nil {
 			if smap.UUID != uuid {
 				nlog.Errorf("%s: UUID has changed %q", remAis, smap.UUID)
 				continue
 			}
+			remAis.mu.Lock()
 			if smap.Version < remAis.smap.Version {
 				nlog.Errorf("%s: detected older Smap %s - proceeding to override anyway", remAis, smap)
 			}
 			remAis.smap = smap
+			remAis._discover()
+			remAis.mu.Unlock()
 		}
-		out.Smap = remAis.smap
+		remAis.mu.RLock()
+		out.URL, out.Smap = remAis.url, remAis.smap
+		remAis.mu.RUnlock()
 		res.A = append(res.A, out)
 	}
 	// defunct (cluster config not updated yet locally?)
@@ -293,7 +318,6 @@ func (r *remAis) init(alias string, confURLs []string, cfg *cmn.ClusterConfig) (
 	var (
 		url          string
 		remSmap      *meta.Smap
-		clientL      http.Client
 		cliH, cliTLS = remaisClients(cfg.Client)
 	)
 
@@ -322,21 +346,130 @@ func (r *remAis) init(alias string, confURLs []string, cfg *cmn.ClusterConfig) (
 		return true, err // offline
 	}
 
-	r.smap, r.url = remSmap, url
-	if cos.IsHTTPS(url) {
-		r.bp = api.BaseParams{Client: cliTLS, URL: url, UA: ua}
-		clientL = *cliTLS
-	} else {
-		r.bp = api.BaseParams{Client: cliH, URL: url, UA: ua}
-		clientL = *cliH
-	}
+	r.cliH, r.cliTLS = cliH, cliTLS
+	r.conf = confURLs
+	r.toutL = cfg.Client.TimeoutLong.D()
+	r.uuid = remSmap.UUID
+	r._set(url, remSmap)
+
+	return false, nil
+}
 
+func (r *remAis) client(u string) *http.Client { return cos.Ternary(cos.IsHTTPS(u), r.cliTLS, r.cliH) }
+
+func (r *remAis) currURL() (u string) {
+	r.mu.RLock()
+	u = r.url
+	r.mu.RUnlock()
+	return u
+}
+
+func (r *remAis) params() (bp, bpL api.BaseParams) {
+	r.mu.RLock()
+	bp, bpL = r.bp, r.bpL
+	r.mu.RUnlock()
+	return bp, bpL
+}
+
+// (is called under lock) make `u` the current endpoint
+func (r *remAis) _set(u string, smap *meta.Smap) {
+	r.url, r.smap = u, smap
+	r.bp = api.BaseParams{Client: r.client(u), URL: u, UA: ua}
+	clientL := *r.bp.Client
+	clientL.Timeout = r.toutL
 	r.bpL = r.bp
-	clientL.Timeout = cfg.Client.TimeoutLong.D()
 	r.bpL.Client = &clientL
-	r.uuid = remSmap.UUID
+	r._discover()
+}
 
-	return false, nil
+// (is called under lock) configured URLs first, followed by the public URLs
+// of the remote cluster's other (active) proxies
+func (r *remAis) _discover() {
+	urls := make([]string, 0, len(r.conf)+r.smap.CountProxies())
+	urls = append(urls, r.conf...)
+	for _, psi := range r.smap.Pmap {
+		if psi.InMaintOrDecomm() {
+			continue
+		}
+		if u := psi.URL(cmn.NetPublic); u != "" && !slices.Contains(urls, u) {
+			urls = append(urls, u)
+		}
+	}
+	r.urls = urls
+}
+
+// health-check the other known endpoints (in order) and switch to the first one that responds;
+// returns false if none does (or another goroutine is concurrently doing the same)
+func (r *remAis) failover(failed string) bool {
+	r.mu.RLock()
+	curr, urls := r.url, r.urls
+	r.mu.RUnlock()
+	if curr != failed {
+		return true // already switched
+	}
+	if !r.failing.CAS(false, true) {
+		return false
+	}
+	defer r.failing.Store(false)
+
+	for _, u := range urls {
+		if u == failed {
+			continue
+		}
+		smap, err := api.GetClusterMap(api.BaseParams{Client: r.client(u), URL: u, UA: ua})
+		if err != nil {
+			if cmn.Rom.V(4, cos.ModBackend) {
+				nlog.Warningln(r.String(), "failed to reach", u, "err:", err)
+			}
+			continue
+		}
+		if smap.UUID != r.uuid {
+			nlog.Errorf("%s: %s references a different cluster %q - skipping", r, u, smap.UUID)
+			continue
+		}
+		r.mu.Lock()
+		r._set(u, smap)
+		r.mu.Unlock()
+		nlog.Warningln(r.String(), "failed over from", failed)
+		return true
+	}
+	return false
+}
+
+// call remote cluster; upon connection failure, fail over to another endpoint
+// or, if there's none, back off and retry the same
+func (r *remAis) do(call func(bp, bpL api.BaseParams) error) error {
+	for i := 0; ; i++ {
+		bp, bpL := r.params()
+		err := call(bp, bpL)
+		if err == nil || i >= remAisRetries || !remAisRetriable(err) {
+			return err
+		}
+		if !r.failover(bp.URL) {
+			time.Sleep(remAisBackoff << i)
+		}
+		if cmn.Rom.V(4, cos.ModBackend) {
+			nlog.Infoln(r.String(), "retry #", i+1, "err:", err)
+		}
+	}
+}
+
+// connection-level failures (that api.* returns as ErrHTTP with no response status)
+// and remote gateway unavailability
+func remAisRetriable(err error) bool {
+	herr := cmn.AsErrHTTP(err)
+	if herr == nil {
+		return cos.IsErrRetriableConn(err) || cos.IsErrDNSLookup(err)
+	}
+	switch herr.Status {
+	case http.StatusRequestTimeout, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	switch herr.TypeCode {
+	case "OpError", "DNSError", "deadlineExceededError":
+		return true
+	}
+	return false
 }
 
 // NOTE: supporting remote attachments both by alias and by UUID interchangeably,
@@ -361,7 +494,7 @@ func (m *AISbp) add(newAis *remAis, newAlias string) error {
 			}
 		}
 		m.alias[newAlias] = newAis.smap.UUID // alias
-		if newAis.url != remAis.url {
+		if newAis.url != remAis.currURL() {
 			nlog.Warningf("%s: different new URL %s - overriding", remAis, newAis)
 		}
 		if newAis.smap.Version < remAis.smap.Version {
@@ -450,7 +583,11 @@ func (m *AISbp) HeadBucket(_ context.Context, remoteBck *meta.Bck) (bckProps cos
 	debug.Assert(uuid == remAis.uuid)
 	bck := remoteBck.Clone()
 	unsetUUID(&bck)
-	if p, err = api.HeadBucket(remAis.bp, bck, false /*dontAddRemote*/); err != nil {
+	err = remAis.do(func(bp, _ api.BaseParams) (err error) {
+		p, err = api.HeadBucket(bp, bck, false /*dontAddRemote*/)
+		return err
+	})
+	if err != nil {
 		ecode, err = m.extractErrCode(err, remAis.uuid)
 		return
 	}
@@ -464,7 +601,7 @@ func (m *AISbp) HeadBucket(_ context.Context, remoteBck *meta.Bck) (bckProps cos
 	bckProps[apc.HdrBackendProvider] = apc.AIS
 	bckProps[apc.HdrRemAisUUID] = remAis.uuid
 	bckProps[apc.HdrRemAisAlias] = alias
-	bckProps[apc.HdrRemAisURL] = remAis.url
+	bckProps[apc.HdrRemAisURL] = remAis.currURL()
 
 	return
 }
@@ -488,7 +625,11 @@ func (m *AISbp) ListObjects(ctx context.Context, remoteBck *meta.Bck, msg *apc.L
 	bck := remoteBck.Clone()
 	unsetUUID(&bck)
 
-	lstRes, err := api.ListObjectsPage(remAis.bpL, bck, remoteMsg, api.ListArgs{Context: ctx})
+	var lstRes *cmn.LsoRes
+	err := remAis.do(func(_, bpL api.BaseParams) (err error) {
+		lstRes, err = api.ListObjectsPage(bpL, bck, remoteMsg, api.ListArgs{Context: ctx})
+		return err
+	})
 	if err != nil {
 		return m.extractErrCode(err, remAis.uuid)
 	}
@@ -550,7 +691,10 @@ func (m *AISbp) blist(uuid string, qbck cmn.QueryBcks) (bcks cmn.Bcks, err error
 	if remAis, err = m.getRemAis(uuid); err != nil {
 		return
 	}
-	bcks, err = api.ListBuckets(remAis.bp, remoteQuery, apc.FltExists)
+	err = remAis.do(func(bp, _ api.BaseParams) (err error) {
+		bcks, err = api.ListBuckets(bp, remoteQuery, apc.FltExists)
+		return err
+	})
 	if err != nil {
 		_, err = m.extractErrCode(err, uuid)
 		return nil, err
@@ -576,8 +720,12 @@ func (m *AISbp) HeadObj(_ context.Context, lom *core.LOM, _ *http.Request) (oa *
 		return
 	}
 	unsetUUID(&remoteBck)
-	if op, err = api.HeadObjectV2(remAis.bp, remoteBck, lom.ObjName,
-		remAisHeadProps, api.HeadArgs{FltPresence: apc.FltPresent, Silent: true}); err != nil {
+	err = remAis.do(func(bp, _ api.BaseParams) (err error) {
+		op, err = api.HeadObjectV2(bp, remoteBck, lom.ObjName,
+			remAisHeadProps, api.HeadArgs{FltPresence: apc.FltPresent, Silent: true})
+		return err
+	})
+	if err != nil {
 		ecode, err = m.extractErrCode(err, remAis.uuid)
 		return
 	}
@@ -587,7 +735,6 @@ func (m *AISbp) HeadObj(_ context.Context, lom *core.LOM, _ *http.Request) (oa *
 	return
 }
 
-// TODO: retry
 func (m *AISbp) GetObj(_ context.Context, lom *core.LOM, owt cmn.OWT, _ *http.Request) (ecode int, err error) {
 	var (
 		remAis    *remAis
@@ -599,7 +746,11 @@ func (m *AISbp) GetObj(_ context.Context, lom *core.LOM, owt cmn.OWT, _ *http.Re
 		return
 	}
 	unsetUUID(&remoteBck)
-	if r, size, err = api.GetObjectReader(remAis.bpL, remoteBck, lom.ObjName, nil /*api.GetArgs*/); err != nil {
+	err = remAis.do(func(_, bpL api.BaseParams) (err error) {
+		r, size, err = api.GetObjectReader(bpL, remoteBck, lom.ObjName, nil /*api.GetArgs*/)
+		return err
+	})
+	if err != nil {
 		return m.extractErrCode(err, remAis.uuid)
 	}
 	params := core.AllocPutParams()
@@ -613,8 +764,6 @@ func (m *AISbp) GetObj(_ context.Context, lom *core.LOM, owt cmn.OWT, _ *http.Re
 	err = m.t.PutObject(lom, params)
 	core.FreePutParams(params)
 
-	// TODO: retry upon 'unreachable' or timeout
-
 	return m.extractErrCode(err, remAis.uuid)
 }
 
@@ -639,7 +788,11 @@ func (m *AISbp) GetObjReader(_ context.Context, lom *core.LOM, offset, length in
 	} else {
 		hargs := api.HeadArgs{FltPresence: apc.FltPresent, Silent: true}
 		var op *cmn.ObjectPropsV2
-		if op, res.Err = api.HeadObjectV2(remAis.bp, remoteBck, lom.ObjName, remAisHeadProps, hargs); res.Err != nil {
+		res.Err = remAis.do(func(bp, _ api.BaseParams) (err error) {
+			op, err = api.HeadObjectV2(bp, remoteBck, lom.ObjName, remAisHeadProps, hargs)
+			return err
+		})
+		if res.Err != nil {
 			res.ErrCode, res.Err = m.extractErrCode(res.Err, remAis.uuid)
 			return res
 		}
@@ -651,12 +804,14 @@ func (m *AISbp) GetObjReader(_ context.Context, lom *core.LOM, offset, length in
 		lom.SetCksum(nil)
 	}
 
-	res.R, res.Size, res.Err = api.GetObjectReader(remAis.bpL, remoteBck, lom.ObjName, args)
+	res.Err = remAis.do(func(_, bpL api.BaseParams) (err error) {
+		res.R, res.Size, err = api.GetObjectReader(bpL, remoteBck, lom.ObjName, args)
+		return err
+	})
 	res.ErrCode, res.Err = m.extractErrCode(res.Err, remAis.uuid)
 	return res
 }
 
-// TODO: retry upon 'unreachable' or timeout
 func (m *AISbp) PutObj(_ context.Context, r io.ReadCloser, lom *core.LOM, _ *http.Request) (int, error) {
 	remoteBck := lom.Bck().Clone()
 	remAis, err := m.getRemAis(remoteBck.Ns.UUID)
@@ -666,16 +821,31 @@ func (m *AISbp) PutObj(_ context.Context, r io.ReadCloser, lom *core.LOM, _ *htt
 	}
 
 	unsetUUID(&remoteBck)
-	size := lom.Lsize(true) // _special_ as it's still a workfile at this point
-	args := api.PutArgs{
-		BaseParams: remAis.bpL,
-		Bck:        remoteBck,
-		ObjName:    lom.ObjName,
-		Cksum:      lom.Checksum(),
-		Reader:     r.(cos.ReadOpenCloser),
-		Size:       uint64(size),
-	}
-	oah, errV := api.PutObject(&args)
+	var (
+		oah    api.ObjAttrs
+		size   = lom.Lsize(true) // _special_ as it's still a workfile at this point
+		rdr    = r.(cos.ReadOpenCloser)
+		reopen bool
+	)
+	errV := remAis.do(func(_, bpL api.BaseParams) (err error) {
+		reader := rdr
+		if reopen {
+			if reader, err = rdr.Open(); err != nil {
+				return err
+			}
+		}
+		reopen = true
+		args := api.PutArgs{
+			BaseParams: bpL,
+			Bck:        remoteBck,
+			ObjName:    lom.ObjName,
+			Cksum:      lom.Checksum(),
+			Reader:     reader,
+			Size:       uint64(size),
+		}
+		oah, err = api.PutObject(&args)
+		return err
+	})
 	if errV != nil {
 		return m.extractErrCode(errV, remAis.uuid)
 	}
@@ -700,6 +870,7 @@ func (m *AISbp) DeleteObj(_ context.Context, lom *core.LOM) (ecode int, err erro
 		return
 	}
 	unsetUUID(&remoteBck)
-	err = api.DeleteObject(remAis.bp, remoteBck, lom.ObjName)
+	bp, _ := remAis.params()
+	err = api.DeleteObject(bp, remoteBck, lom.ObjName)
 	return m.extractErrCode(err, remAis.uuid)
 }
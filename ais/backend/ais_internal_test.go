@@ -0,0 +1,126 @@
+// Package backend contains core/backend interface implementations for supported backend providers.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+func newTestRemAis(t *testing.T, smap *meta.Smap, urls ...string) *remAis {
+	t.Helper()
+	r := &remAis{
+		m:      &AISbp{alias: cos.StrKVs{}},
+		cliH:   &http.Client{Timeout: time.Second},
+		cliTLS: &http.Client{Timeout: time.Second},
+		conf:   urls,
+		uuid:   smap.UUID,
+		toutL:  time.Second,
+	}
+	r._set(urls[0], smap)
+	return r
+}
+
+func smapServer(smap *meta.Smap) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set(cos.HdrContentType, cos.ContentJSON)
+		w.Write(cos.MustMarshal(smap))
+	}))
+}
+
+func TestRemAisFailover(t *testing.T) {
+	smap := &meta.Smap{UUID: "remote-uuid", Version: 3}
+
+	down := smapServer(smap)
+	downURL := down.URL
+	down.Close()
+
+	other := smapServer(&meta.Smap{UUID: "other-uuid", Version: 5})
+	defer other.Close()
+
+	up := smapServer(smap)
+	defer up.Close()
+
+	r := newTestRemAis(t, smap, downURL, other.URL, up.URL)
+
+	if !r.failover(downURL) {
+		t.Fatal("expected failover")
+	}
+	if u := r.currURL(); u != up.URL {
+		t.Fatalf("expected to fail over to %s (skipping %s that references a different cluster), got %s", up.URL, other.URL, u)
+	}
+	bp, bpL := r.params()
+	if bp.URL != up.URL || bpL.URL != up.URL {
+		t.Fatalf("expected %s, got %s and %s", up.URL, bp.URL, bpL.URL)
+	}
+	// stale caller (already switched)
+	if !r.failover(downURL) {
+		t.Fatal("expected true when current endpoint differs from the failed one")
+	}
+}
+
+func TestRemAisDo(t *testing.T) {
+	smap := &meta.Smap{UUID: "remote-uuid", Version: 3}
+	up := smapServer(smap)
+	defer up.Close()
+
+	r := newTestRemAis(t, smap, "http://127.0.0.1:1", up.URL)
+
+	var (
+		calls int
+		urls  []string
+	)
+	err := r.do(func(bp, _ api.BaseParams) error {
+		calls++
+		urls = append(urls, bp.URL)
+		if bp.URL != up.URL {
+			return &cmn.ErrHTTP{Status: http.StatusBadRequest, TypeCode: "OpError", Message: "connection refused"}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 || urls[1] != up.URL {
+		t.Fatalf("expected second call to fail over to %s, got %v", up.URL, urls)
+	}
+
+	// not retriable
+	calls = 0
+	errNotFound := &cmn.ErrHTTP{Status: http.StatusNotFound, Message: "not found"}
+	if err := r.do(func(api.BaseParams, api.BaseParams) error { calls++; return errNotFound }); err != errNotFound || calls != 1 {
+		t.Fatalf("expected a single call returning %v, got %d calls and %v", errNotFound, calls, err)
+	}
+}
+
+func TestRemAisRetriable(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{syscall.ECONNREFUSED, true},
+		{syscall.ECONNRESET, true},
+		{errors.New("other"), false},
+		{&cmn.ErrHTTP{Status: http.StatusServiceUnavailable}, true},
+		{&cmn.ErrHTTP{Status: http.StatusBadGateway}, true},
+		{&cmn.ErrHTTP{Status: http.StatusBadRequest, TypeCode: "OpError"}, true},
+		{&cmn.ErrHTTP{Status: http.StatusNotFound}, false},
+		{&cmn.ErrHTTP{Status: http.StatusForbidden}, false},
+	}
+	for _, test := range tests {
+		if got := remAisRetriable(test.err); got != test.want {
+			t.Errorf("%v: expected %t, got %t", test.err, test.want, got)
+		}
+	}
+}
@@ -23,7 +23,8 @@ func (m *AISbp) StartMpt(lom *core.LOM, _ *http.Request) (id string, ecode int,
 	}
 	unsetUUID(&remoteBck)
 
-	uploadID, err := api.CreateMultipartUpload(remAis.bpL, remoteBck, lom.ObjName)
+	_, bpL := remAis.params()
+	uploadID, err := api.CreateMultipartUpload(bpL, remoteBck, lom.ObjName)
 	if err != nil {
 		return "", http.StatusInternalServerError, err
 	}
@@ -44,9 +45,10 @@ func (m *AISbp) PutMptPart(lom *core.LOM, r cos.ReadOpenCloser, _ *http.Request,
 	}
 	unsetUUID(&remoteBck)
 
+	_, bpL := remAis.params()
 	err = api.UploadPart(&api.PutPartArgs{
 		PutArgs: api.PutArgs{
-			BaseParams: remAis.bpL,
+			BaseParams: bpL,
 			Bck:        remoteBck,
 			ObjName:    lom.ObjName,
 			Reader:     r,
@@ -78,7 +80,8 @@ func (m *AISbp) CompleteMpt(lom *core.LOM, _ *http.Request, uploadID string, _ [
 		pns[i] = part.PartNumber
 	}
 
-	err = api.CompleteMultipartUpload(remAis.bpL, remoteBck, lom.ObjName, uploadID, pns)
+	_, bpL := remAis.params()
+	err = api.CompleteMultipartUpload(bpL, remoteBck, lom.ObjName, uploadID, pns)
 	if err != nil {
 		return "", "", http.StatusInternalServerError, err
 	}
@@ -96,7 +99,8 @@ func (m *AISbp) AbortMpt(lom *core.LOM, _ *http.Request, uploadID string) (ecode
 	}
 	unsetUUID(&remoteBck)
 
-	err = api.AbortMultipartUpload(remAis.bpL, remoteBck, lom.ObjName, uploadID)
+	_, bpL := remAis.params()
+	err = api.AbortMultipartUpload(bpL, remoteBck, lom.ObjName, uploadID)
 	if err != nil {
 		return http.StatusInternalServerError, err
 	}
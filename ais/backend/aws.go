@@ -568,6 +568,7 @@ func (*s3bp) PutObj(ctx context.Context, r io.ReadCloser, lom *core.LOM, oreq *h
 	const tag = "[put_object]"
 	var (
 		svc                   *s3.Client
+		input                 *s3.PutObjectInput
 		uploader              *s3manager.Uploader
 		uploadOutput          *s3manager.UploadOutput
 		h                     = cmn.BackendHelpers.Amazon
@@ -617,12 +618,16 @@ func (*s3bp) PutObj(ctx context.Context, r io.ReadCloser, lom *core.LOM, oreq *h
 		uploader.PartSize = partSize
 	}
 
-	uploadOutput, err = uploader.Upload(ctx, &s3.PutObjectInput{
+	input = &s3.PutObjectInput{
 		Bucket:   aws.String(cloudBck.Name),
 		Key:      aws.String(lom.ObjName),
 		Body:     r,
 		Metadata: md,
-	})
+	}
+	if tags, ok := lom.GetCustomKey(cmn.TagsObjMD); ok && tags != "" {
+		input.Tagging = aws.String(tags) // (same URL-query encoding)
+	}
+	uploadOutput, err = uploader.Upload(ctx, input)
 	cos.Close(r)
 
 	if err != nil {
@@ -688,6 +693,48 @@ func (*s3bp) DeleteObj(ctx context.Context, lom *core.LOM) (ecode int, err error
 	return
 }
 
+//
+// OBJECT TAGGING
+//
+
+// (core.TagsBackend)
+func (*s3bp) PutObjTags(ctx context.Context, lom *core.LOM, tags cos.StrKVs) (ecode int, err error) {
+	const tag = "[put_object_tagging]"
+	var (
+		svc      *s3.Client
+		cloudBck = lom.Bck().RemoteBck()
+		sessConf = sessConf{bck: cloudBck}
+	)
+	svc, err = sessConf.s3client(tag)
+	if err != nil {
+		return
+	}
+	if len(tags) == 0 {
+		_, err = svc.DeleteObjectTagging(ctx, &s3.DeleteObjectTaggingInput{
+			Bucket: aws.String(cloudBck.Name),
+			Key:    aws.String(lom.ObjName),
+		})
+	} else {
+		tagSet := make([]types.Tag, 0, len(tags))
+		for k, v := range tags {
+			tagSet = append(tagSet, types.Tag{Key: aws.String(k), Value: aws.String(v)})
+		}
+		_, err = svc.PutObjectTagging(ctx, &s3.PutObjectTaggingInput{
+			Bucket:  aws.String(cloudBck.Name),
+			Key:     aws.String(lom.ObjName),
+			Tagging: &types.Tagging{TagSet: tagSet},
+		})
+	}
+	if err != nil {
+		ecode, err = awsErrorToAISError(err, cloudBck, lom.ObjName, sessConf.detail())
+		return
+	}
+	if cmn.Rom.V(5, cos.ModBackend) {
+		nlog.Infoln(tag, lom.String(), len(tags))
+	}
+	return
+}
+
 //
 // static helpers
 //
@@ -34,6 +34,7 @@ import (
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
@@ -58,12 +59,23 @@ const (
 	azErrPrefix = "azure-error["
 )
 
+// ADLS Gen2 (hierarchical namespace) directories are blobs with this metadata key set to "true"
+const azIsFolder = "hdi_isfolder"
+
+// hierarchical namespace (see azbp.isHNS)
+const (
+	azHNSUnknown = iota
+	azHNSDisabled
+	azHNSEnabled
+)
+
 type (
 	azbp struct {
 		t     core.TargetPut
 		creds *azblob.SharedKeyCredential
 		u     string
 		base
+		hns atomic.Int32 // account-level; discovered upon the first list-objects
 	}
 )
 
@@ -242,18 +254,25 @@ func (azbp *azbp) HeadBucket(ctx context.Context, bck *meta.Bck) (cos.StrKVs, in
 // LIST OBJECTS
 //
 
-// TODO: support non-recursive (apc.LsNoRecursion) operation, as in:
+// Non-recursive (apc.LsNoRecursion) listing uses delimiter ("/") listing, same as:
 // $ az storage blob list -c abc --prefix sub/ --delimiter /
-// TODO: research "hierarchical namespaces"
-// See also: aws.go, gcp.go
+// and returns virtual directories ("blob prefixes") unless apc.LsNoDirs.
+//
+// Hierarchical namespace (ADLS Gen2): directories are first-class (and maintained by
+// the service) - non-recursive listing of such accounts does not scan the subtree;
+// the directories themselves are listed as zero-size blobs with `hdi_isfolder` metadata -
+// those we report as directories (including empty ones).
+//
+// See also: aws.go, gcp.go, and docs/howto_virt_dirs.md
 func (azbp *azbp) ListObjects(ctx context.Context, bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoRes) (int, error) {
 	msg.PageSize = calcPageSize(msg.PageSize, bck.MaxPageSize())
 	var (
-		h        = cmn.BackendHelpers.Azure
 		cloudBck = bck.RemoteBck()
 		cntURL   = azbp.u + "/" + cloudBck.Name
 		num      = int32(msg.PageSize)
-		opts     = container.ListBlobsFlatOptions{Prefix: apc.Ptr(msg.Prefix), MaxResults: &num}
+		blobs    []*container.BlobItem
+		prefixes []*container.BlobPrefix
+		marker   *string
 	)
 	lst.ContinuationToken = ""
 
@@ -265,28 +284,77 @@ func (azbp *azbp) ListObjects(ctx context.Context, bck *meta.Bck, msg *apc.LsoMs
 		nlog.Infof("list_objects %s", cloudBck.Name)
 	}
 	if msg.ContinuationToken != "" {
-		opts.Marker = apc.Ptr(msg.ContinuationToken)
+		marker = apc.Ptr(msg.ContinuationToken)
 	}
+	hns := azbp.isHNS(ctx, client)
+	include := container.ListBlobsInclude{Metadata: hns}
 
-	pager := client.NewListBlobsFlatPager(&opts)
-	resp, err := pager.NextPage(ctx)
-	if err != nil {
-		return azureErrorToAISError(err, cloudBck, "")
+	if msg.IsFlagSet(apc.LsNoRecursion) {
+		opts := container.ListBlobsHierarchyOptions{Prefix: apc.Ptr(msg.Prefix), MaxResults: &num, Marker: marker, Include: include}
+		pager := client.NewListBlobsHierarchyPager("/", &opts)
+		resp, err := pager.NextPage(ctx)
+		if err != nil {
+			return azureErrorToAISError(err, cloudBck, "")
+		}
+		blobs, prefixes = resp.Segment.BlobItems, resp.Segment.BlobPrefixes
+		marker = resp.NextMarker
+	} else {
+		opts := container.ListBlobsFlatOptions{Prefix: apc.Ptr(msg.Prefix), MaxResults: &num, Marker: marker, Include: include}
+		pager := client.NewListBlobsFlatPager(&opts)
+		resp, err := pager.NextPage(ctx)
+		if err != nil {
+			return azureErrorToAISError(err, cloudBck, "")
+		}
+		blobs = resp.Segment.BlobItems
+		marker = resp.NextMarker
 	}
 
+	azbp.lsoPage(msg, blobs, prefixes, lst)
+
+	if marker != nil {
+		lst.ContinuationToken = *marker
+	}
+	if cmn.Rom.V(4, cos.ModBackend) {
+		nlog.Infof("[list_objects] count %d(marker: %s)", len(lst.Entries), lst.ContinuationToken)
+	}
+	return 0, nil
+}
+
+func (*azbp) lsoPage(msg *apc.LsoMsg, blobs []*container.BlobItem, prefixes []*container.BlobPrefix, lst *cmn.LsoRes) {
 	var (
+		h          = cmn.BackendHelpers.Azure
 		wantCustom = msg.WantProp(apc.GetPropsCustom)
+		noDirs     = msg.IsFlagSet(apc.LsNoDirs)
+		dirs       map[string]struct{} // (to dedup HNS directories vs blob prefixes)
 		custom     []string
 	)
 	if wantCustom {
 		custom = make([]string, 0, 8)
 	}
 	lst.Entries = lst.Entries[:0]
-	for _, blob := range resp.Segment.BlobItems {
-		en := cmn.LsoEnt{Name: *blob.Name, Size: *blob.Properties.ContentLength}
+	for _, blob := range blobs {
+		en := cmn.LsoEnt{Name: *blob.Name}
+		if blob.Properties != nil && blob.Properties.ContentLength != nil {
+			en.Size = *blob.Properties.ContentLength
+		}
+		debug.Assert(en.Name != "")
 
-		// not expecting directories
-		debug.Assert(en.Name != "" && !cos.IsLastB(en.Name, '/'), en.Name)
+		// directories: HNS folders and (rarely) zero-size "dir/" blobs
+		if isFolder := azIsFolderBlob(blob); isFolder || (en.Size == 0 && cos.IsLastB(en.Name, '/')) {
+			if noDirs {
+				continue
+			}
+			if isFolder && !cos.IsLastB(en.Name, '/') {
+				en.Name += "/"
+			}
+			if dirs == nil {
+				dirs = make(map[string]struct{}, 4)
+			}
+			dirs[en.Name] = struct{}{}
+			en.Size, en.Flags = 0, apc.EntryIsDir
+			lst.Entries = append(lst.Entries, &en)
+			continue
+		}
 
 		if msg.IsFlagSet(apc.LsNameOnly) || msg.IsFlagSet(apc.LsNameSize) {
 			lst.Entries = append(lst.Entries, &en)
@@ -299,7 +367,7 @@ func (azbp *azbp) ListObjects(ctx context.Context, bck *meta.Bck, msg *apc.LsoMs
 		if wantCustom {
 			custom = custom[:0]
 			custom = append(custom, cmn.ETag, etag)
-			if !blob.Properties.LastModified.IsZero() {
+			if blob.Properties.LastModified != nil && !blob.Properties.LastModified.IsZero() {
 				custom = append(custom, cmn.LsoLastModified, fmtLsoTime(*blob.Properties.LastModified))
 			}
 			if blob.Properties.ContentType != nil {
@@ -313,13 +381,54 @@ func (azbp *azbp) ListObjects(ctx context.Context, bck *meta.Bck, msg *apc.LsoMs
 		lst.Entries = append(lst.Entries, &en)
 	}
 
-	if resp.NextMarker != nil {
-		lst.ContinuationToken = *resp.NextMarker
+	// append virtual directories unless '--no-dirs'
+	if noDirs {
+		return
 	}
-	if cmn.Rom.V(4, cos.ModBackend) {
-		nlog.Infof("[list_objects] count %d(marker: %s)", len(lst.Entries), lst.ContinuationToken)
+	for _, prefix := range prefixes {
+		if prefix.Name == nil {
+			continue
+		}
+		if _, ok := dirs[*prefix.Name]; ok {
+			continue
+		}
+		lst.Entries = append(lst.Entries, &cmn.LsoEnt{Name: *prefix.Name, Flags: apc.EntryIsDir})
 	}
-	return 0, nil
+}
+
+func azIsFolderBlob(blob *container.BlobItem) bool {
+	for k, v := range blob.Metadata {
+		if strings.EqualFold(k, azIsFolder) && v != nil && strings.EqualFold(*v, "true") {
+			return true
+		}
+	}
+	return false
+}
+
+// whether the account has hierarchical namespace enabled (ADLS Gen2);
+// failing to find out implies not (and, unless denied access, will retry next time)
+func (azbp *azbp) isHNS(ctx context.Context, client *container.Client) bool {
+	switch azbp.hns.Load() {
+	case azHNSEnabled:
+		return true
+	case azHNSDisabled:
+		return false
+	}
+	resp, err := client.GetAccountInfo(ctx, nil)
+	if err != nil {
+		var stgErr *azcore.ResponseError
+		if errors.As(err, &stgErr) && (stgErr.StatusCode == http.StatusForbidden || stgErr.StatusCode == http.StatusUnauthorized) {
+			azbp.hns.Store(azHNSDisabled)
+		}
+		nlog.Warningln("azure: failed to get account info (assuming flat namespace):", err)
+		return false
+	}
+	enabled := resp.IsHierarchicalNamespaceEnabled != nil && *resp.IsHierarchicalNamespaceEnabled
+	azbp.hns.Store(cos.Ternary(enabled, int32(azHNSEnabled), int32(azHNSDisabled)))
+	if enabled {
+		nlog.Infoln("azure: hierarchical namespace enabled")
+	}
+	return enabled
 }
 
 //
@@ -491,6 +600,11 @@ func (azbp *azbp) PutObj(ctx context.Context, r io.ReadCloser, lom *core.LOM, _
 	if size := lom.Lsize(true); size > cos.MiB {
 		opts.Concurrency = int(min((size+cos.MiB-1)/cos.MiB, 8))
 	}
+	if s, ok := lom.GetCustomKey(cmn.TagsObjMD); ok && s != "" {
+		if tags, err := cmn.ParseObjTags(s); err == nil {
+			opts.Tags = tags
+		}
+	}
 
 	resp, err := client.UploadStream(ctx, cloudBck.Name, lom.ObjName, r, &opts)
 	if err != nil {
@@ -513,6 +627,30 @@ func (azbp *azbp) PutObj(ctx context.Context, r io.ReadCloser, lom *core.LOM, _
 	return http.StatusOK, nil
 }
 
+//
+// OBJECT TAGGING (blob index tags)
+//
+
+// (core.TagsBackend)
+func (azbp *azbp) PutObjTags(ctx context.Context, lom *core.LOM, tags cos.StrKVs) (int, error) {
+	var (
+		cloudBck = lom.Bck().RemoteBck()
+		blURL    = azbp.u + "/" + cloudBck.Name + "/" + lom.ObjName
+	)
+	client, err := blockblob.NewClientWithSharedKeyCredential(blURL, azbp.creds, nil)
+	if err != nil {
+		return azureErrorToAISError(err, cloudBck, lom.ObjName)
+	}
+	// NOTE: empty map removes all existing tags
+	if _, err := client.SetTags(ctx, tags, nil); err != nil {
+		return azureErrorToAISError(err, cloudBck, lom.ObjName)
+	}
+	if cmn.Rom.V(5, cos.ModBackend) {
+		nlog.Infof("[put_object_tagging] %s (%d)", lom, len(tags))
+	}
+	return http.StatusOK, nil
+}
+
 //
 // DELETE OBJECT
 //
@@ -0,0 +1,238 @@
+//go:build azure
+
+// Package backend contains core/backend interface implementations for supported backend providers.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+)
+
+// offline Azure emulator: (subset of) List Blobs and Get Account Information
+type (
+	azMockBlob struct {
+		name   string
+		size   int64
+		folder bool // HNS directory
+	}
+	azMock struct {
+		blobs []azMockBlob // sorted by name
+		hns   bool
+	}
+
+	azXMLProps struct {
+		ContentLength int64  `xml:"Content-Length"`
+		Etag          string `xml:"Etag"`
+		LastModified  string `xml:"Last-Modified"`
+	}
+	azXMLMeta struct {
+		IsFolder string `xml:"hdi_isfolder,omitempty"`
+	}
+	azXMLBlob struct {
+		Name       string     `xml:"Name"`
+		Properties azXMLProps `xml:"Properties"`
+		Metadata   *azXMLMeta `xml:"Metadata,omitempty"`
+	}
+	azXMLPrefix struct {
+		Name string `xml:"Name"`
+	}
+	azXMLBlobs struct {
+		Blobs    []azXMLBlob   `xml:"Blob"`
+		Prefixes []azXMLPrefix `xml:"BlobPrefix"`
+	}
+	azXMLList struct {
+		XMLName    xml.Name   `xml:"EnumerationResults"`
+		Prefix     string     `xml:"Prefix"`
+		Delimiter  string     `xml:"Delimiter,omitempty"`
+		Blobs      azXMLBlobs `xml:"Blobs"`
+		NextMarker string     `xml:"NextMarker"`
+	}
+)
+
+func newAzMock(hns bool, blobs ...azMockBlob) *azMock {
+	slices.SortFunc(blobs, func(a, b azMockBlob) int { return strings.Compare(a.name, b.name) })
+	return &azMock{blobs: blobs, hns: hns}
+}
+
+func (m *azMock) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	switch {
+	case q.Get("restype") == "account" && q.Get("comp") == "properties":
+		w.Header().Set("x-ms-is-hns-enabled", strconv.FormatBool(m.hns))
+		w.Header().Set("x-ms-account-kind", "StorageV2")
+		w.WriteHeader(http.StatusOK)
+	case q.Get("restype") == "container" && q.Get("comp") == "list":
+		m.list(w, q.Get("prefix"), q.Get("delimiter"), q.Get("marker"), q.Get("maxresults"), strings.Contains(q.Get("include"), "metadata"))
+	default:
+		http.Error(w, "unsupported: "+r.URL.String(), http.StatusNotImplemented)
+	}
+}
+
+// emits blobs and (when delimited) blob prefixes in lexicographical order, with paging
+func (m *azMock) list(w http.ResponseWriter, prefix, delim, marker, maxresults string, wantMeta bool) {
+	var (
+		out  = azXMLList{Prefix: prefix, Delimiter: delim}
+		num  = 5000
+		cnt  int
+		prev string
+	)
+	if maxresults != "" {
+		num, _ = strconv.Atoi(maxresults)
+	}
+	for _, b := range m.blobs {
+		if !strings.HasPrefix(b.name, prefix) || b.name < marker {
+			continue
+		}
+		var dir string
+		if delim != "" {
+			if i := strings.Index(b.name[len(prefix):], delim); i >= 0 {
+				dir = b.name[:len(prefix)+i+1]
+				if dir == prev {
+					continue
+				}
+			}
+		}
+		if cnt == num {
+			out.NextMarker = b.name
+			break
+		}
+		cnt++
+		if dir != "" {
+			prev = dir
+			out.Blobs.Prefixes = append(out.Blobs.Prefixes, azXMLPrefix{Name: dir})
+			continue
+		}
+		blob := azXMLBlob{
+			Name:       b.name,
+			Properties: azXMLProps{ContentLength: b.size, Etag: "0x8D" + strconv.Itoa(len(b.name)), LastModified: "Mon, 02 Jan 2006 15:04:05 GMT"},
+		}
+		if wantMeta && b.folder {
+			blob.Metadata = &azXMLMeta{IsFolder: "true"}
+		}
+		out.Blobs.Blobs = append(out.Blobs.Blobs, blob)
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusOK)
+	b, _ := xml.Marshal(out)
+	w.Write(b)
+}
+
+func newTestAzbp(t *testing.T, u string) *azbp {
+	t.Helper()
+	creds, err := azblob.NewSharedKeyCredential("acct", base64.StdEncoding.EncodeToString([]byte("secret")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &azbp{creds: creds, u: u, base: base{provider: apc.Azure}}
+}
+
+// list all pages
+func azList(t *testing.T, bp *azbp, msg *apc.LsoMsg) (names []string) {
+	t.Helper()
+	bck := &meta.Bck{Name: "cnt", Provider: apc.Azure}
+	for {
+		lst := &cmn.LsoRes{}
+		if _, err := bp.ListObjects(t.Context(), bck, msg, lst); err != nil {
+			t.Fatal(err)
+		}
+		for _, en := range lst.Entries {
+			name := en.Name
+			if en.IsAnyFlagSet(apc.EntryIsDir) {
+				name += "(dir)"
+			}
+			names = append(names, name)
+		}
+		if lst.ContinuationToken == "" {
+			return names
+		}
+		msg.ContinuationToken = lst.ContinuationToken
+	}
+}
+
+func TestAzureListNoRecursion(t *testing.T) {
+	mock := newAzMock(false,
+		azMockBlob{name: "a.txt", size: 1},
+		azMockBlob{name: "sub/b.txt", size: 2},
+		azMockBlob{name: "sub/c.txt", size: 3},
+		azMockBlob{name: "sub/deep/d.txt", size: 4},
+		azMockBlob{name: "zzz/e.txt", size: 5},
+	)
+	ts := httptest.NewServer(mock)
+	defer ts.Close()
+	bp := newTestAzbp(t, ts.URL)
+
+	tests := []struct {
+		msg  apc.LsoMsg
+		want []string
+	}{
+		{apc.LsoMsg{Flags: apc.LsNameSize}, []string{"a.txt", "sub/b.txt", "sub/c.txt", "sub/deep/d.txt", "zzz/e.txt"}},
+		{apc.LsoMsg{Flags: apc.LsNameSize | apc.LsNoRecursion}, []string{"a.txt", "sub/(dir)", "zzz/(dir)"}},
+		{apc.LsoMsg{Flags: apc.LsNameSize | apc.LsNoRecursion, Prefix: "sub/"}, []string{"sub/b.txt", "sub/c.txt", "sub/deep/(dir)"}},
+		{apc.LsoMsg{Flags: apc.LsNameSize | apc.LsNoRecursion | apc.LsNoDirs}, []string{"a.txt"}},
+		{apc.LsoMsg{Flags: apc.LsNoRecursion, PageSize: 1}, []string{"a.txt", "sub/(dir)", "zzz/(dir)"}},
+	}
+	for i, test := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			msg := test.msg
+			if names := azList(t, bp, &msg); !slices.Equal(names, test.want) {
+				t.Errorf("expected %v, got %v", test.want, names)
+			}
+		})
+	}
+}
+
+func TestAzureListHNS(t *testing.T) {
+	mock := newAzMock(true,
+		azMockBlob{name: "a.txt", size: 1},
+		azMockBlob{name: "empty", folder: true},
+		azMockBlob{name: "sub", folder: true},
+		azMockBlob{name: "sub/b.txt", size: 2},
+		azMockBlob{name: "sub/deep", folder: true},
+		azMockBlob{name: "sub/deep/d.txt", size: 4},
+	)
+	ts := httptest.NewServer(mock)
+	defer ts.Close()
+	bp := newTestAzbp(t, ts.URL)
+
+	tests := []struct {
+		msg  apc.LsoMsg
+		want []string
+	}{
+		// directories are reported as such (rather than zero-size objects)
+		{apc.LsoMsg{Flags: apc.LsNameSize}, []string{"a.txt", "empty/(dir)", "sub/(dir)", "sub/b.txt", "sub/deep/(dir)", "sub/deep/d.txt"}},
+		{apc.LsoMsg{Flags: apc.LsNameSize | apc.LsNoDirs}, []string{"a.txt", "sub/b.txt", "sub/deep/d.txt"}},
+		// including empty ones, and without duplicates
+		{apc.LsoMsg{Flags: apc.LsNameSize | apc.LsNoRecursion}, []string{"a.txt", "empty/(dir)", "sub/(dir)"}},
+		{apc.LsoMsg{Flags: apc.LsNameSize | apc.LsNoRecursion, Prefix: "sub/"}, []string{"sub/b.txt", "sub/deep/(dir)"}},
+	}
+	for i, test := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			msg := test.msg
+			names := azList(t, bp, &msg)
+			slices.Sort(names)
+			want := slices.Clone(test.want)
+			slices.Sort(want)
+			if !slices.Equal(names, want) {
+				t.Errorf("expected %v, got %v", want, names)
+			}
+		})
+	}
+	if bp.hns.Load() != azHNSEnabled {
+		t.Errorf("expected hierarchical namespace to be detected (%d)", bp.hns.Load())
+	}
+}
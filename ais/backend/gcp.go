@@ -38,6 +38,7 @@ const (
 	gcpXMLEndpoint  = "https://storage.googleapis.com"
 	gcpChecksumType = "x-goog-meta-ais-cksum-type"
 	gcpChecksumVal  = "x-goog-meta-ais-cksum-val"
+	gcpTags         = "x-goog-meta-ais-tags" // GCS has no object tagging - see PutObjTags
 
 	projectIDField  = "project_id"
 	projectIDEnvVar = "GOOGLE_CLOUD_PROJECT"
@@ -68,7 +69,10 @@ var (
 )
 
 // interface guard
-var _ core.Backend = (*gsbp)(nil)
+var (
+	_ core.Backend     = (*gsbp)(nil)
+	_ core.TagsBackend = (*gsbp)(nil)
+)
 
 func NewGCP(t core.TargetPut, tstats stats.Tracker, startingUp bool) (core.Backend, error) {
 	bp := &gsbp{
@@ -325,6 +329,9 @@ func (gsbp *gsbp) HeadObj(ctx context.Context, lom *core.LOM, _ *http.Request) (
 			oa.SetCksum(cksumType, cksumValue)
 		}
 	}
+	if v := attrs.Metadata[gcpTags]; v != "" {
+		oa.SetCustomKey(cmn.TagsObjMD, v)
+	}
 
 	oa.SetCustomKey(cos.HdrLastModified, fmtHdrTime(attrs.Updated))
 
@@ -446,6 +453,9 @@ func gcpSetCustom(lom *core.LOM, attrs *storage.ObjectAttrs) (expCksum *cos.Cksu
 	if v, ok := h.EncodeETag(attrs.Etag); ok {
 		lom.SetCustomKey(cmn.ETag, v)
 	}
+	if v := attrs.Metadata[gcpTags]; v != "" {
+		lom.SetCustomKey(cmn.TagsObjMD, v)
+	}
 
 	lom.SetCustomKey(cmn.LsoLastModified, fmtLsoTime(attrs.Updated))
 	lom.SetCustomKey(cos.HdrLastModified, fmtHdrTime(attrs.Updated))
@@ -473,6 +483,9 @@ func (gsbp *gsbp) PutObj(ctx context.Context, r io.ReadCloser, lom *core.LOM, _
 		gcpChecksumType: cksumType,
 		gcpChecksumVal:  cksumVal,
 	}
+	if tags, ok := lom.GetCustomKey(cmn.TagsObjMD); ok && tags != "" {
+		wc.Metadata[gcpTags] = tags
+	}
 
 	buf, slab := gsbp.t.PageMM().Alloc()
 	written, err := io.CopyBuffer(wc, r, buf)
@@ -498,6 +511,30 @@ func (gsbp *gsbp) PutObj(ctx context.Context, r io.ReadCloser, lom *core.LOM, _
 	return 0, nil
 }
 
+//
+// OBJECT TAGGING (custom metadata)
+//
+
+// (core.TagsBackend)
+// GCS has no object tagging; tags are stored as a single custom metadata entry
+// that can only be patched - removing all tags leaves it empty
+func (gsbp *gsbp) PutObjTags(ctx context.Context, lom *core.LOM, tags cos.StrKVs) (int, error) {
+	cloudBck := lom.Bck().RemoteBck()
+	client, e := gsbp.getClient(ctx, cloudBck)
+	if e != nil {
+		return 0, e
+	}
+	o := client.Bucket(cloudBck.Name).Object(lom.ObjName)
+	uattrs := storage.ObjectAttrsToUpdate{Metadata: map[string]string{gcpTags: cmn.EncodeObjTags(tags)}}
+	if _, err := o.Update(ctx, uattrs); err != nil {
+		return gcpObjErr(ctx, client, err, cloudBck)
+	}
+	if cmn.Rom.V(5, cos.ModBackend) {
+		nlog.Infof("[put_object_tagging] %s (%d)", lom, len(tags))
+	}
+	return 0, nil
+}
+
 //
 // DELETE OBJECT
 //
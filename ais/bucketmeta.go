@@ -6,6 +6,7 @@ package ais
 
 import (
 	"fmt"
+	"maps"
 	"net/http"
 	"net/textproto"
 	"path/filepath"
@@ -191,6 +192,17 @@ func (m *bucketMD) clone() *bucketMD {
 		}
 		dst.Providers[provider] = dstNamespaces
 	}
+	if len(m.NsQuotas) > 0 {
+		dst.NsQuotas = make(map[string]*cmn.QuotaConf, len(m.NsQuotas))
+		for uname, quota := range m.NsQuotas {
+			q := *quota
+			dst.NsQuotas[uname] = &q
+		}
+	}
+	if len(m.Schedules) > 0 {
+		// (immutable - replaced rather than modified in place)
+		dst.Schedules = maps.Clone(m.Schedules)
+	}
 
 	dst.vstr = m.vstr
 	dst._sgl = nil
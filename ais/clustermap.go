@@ -384,6 +384,7 @@ func (m *smapX) clone() *smapX {
 	}
 	dst.Primary = dst.GetProxy(m.Primary.ID())
 	dst._sgl = nil
+	dst.ResetDigests()
 	return dst
 }
 
@@ -0,0 +1,54 @@
+// Package ais: internal unit tests
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// cloned (and modified) Smap must place objects exactly as the same Smap decoded by other nodes
+func TestSmapCloneWeightedHRW(t *testing.T) {
+	smap := newSmap()
+	for i := range 4 {
+		tsi := newSnode(fmt.Sprintf("t%d", i), apc.Target, meta.NetInfo{}, meta.NetInfo{}, meta.NetInfo{})
+		smap.Tmap[tsi.ID()] = tsi
+	}
+	psi := newSnode("p0", apc.Proxy, meta.NetInfo{}, meta.NetInfo{}, meta.NetInfo{})
+	smap.Pmap[psi.ID()] = psi
+	smap.Primary = psi
+	smap.Version = 1
+	smap.InitDigests()
+	tassert.Fatalf(t, !smap.IsWeighted(), "expecting unweighted %s", smap)
+
+	clone := smap.clone()
+	clone.Tmap["t0"].HrwWeight = 60
+	clone.Tmap["t1"].HrwWeight = 15
+	clone.Version++
+	tassert.Fatalf(t, clone.IsWeighted(), "expecting weighted clone prior to (residual) init")
+	clone.InitDigests()
+
+	var decoded meta.Smap
+	tassert.CheckFatal(t, jsoniter.Unmarshal(cos.MustMarshal(&clone.Smap), &decoded))
+	decoded.InitDigests()
+
+	tassert.Fatalf(t, clone.IsWeighted() && decoded.IsWeighted(), "expecting weighted: clone %t, decoded %t",
+		clone.IsWeighted(), decoded.IsWeighted())
+	for i := range 1000 {
+		uname := cos.UnsafeB(fmt.Sprintf("ais/@#nsp/bck/obj-%d", i))
+		t1, err := clone.HrwName2T(uname)
+		tassert.CheckFatal(t, err)
+		t2, err := decoded.HrwName2T(uname)
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, t1.ID() == t2.ID(), "object %d: clone => %s, decoded => %s", i, t1, t2)
+	}
+}
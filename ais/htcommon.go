@@ -800,6 +800,14 @@ func _reMirror(bprops, nprops *cmn.Bprops) bool {
 	return false
 }
 
+// (objsize_limit == 0 both before and after implies nothing to do regardless of chunk_size)
+func _reChunk(bprops, nprops *cmn.Bprops) bool {
+	if bprops.Chunks.ObjSizeLimit != nprops.Chunks.ObjSizeLimit {
+		return true
+	}
+	return nprops.Chunks.AutoEnabled() && bprops.Chunks.ChunkSize != nprops.Chunks.ChunkSize
+}
+
 func _reEC(bprops, nprops *cmn.Bprops, bck *meta.Bck, smap *smapX) (targetCnt int, yes bool) {
 	if !nprops.EC.Enabled {
 		if bprops.EC.Enabled {
@@ -592,6 +592,12 @@ func (c *clupost) rereg(osi *meta.Snode) bool {
 		// also ref0417 (ais/earlystart)
 		nlog.Warningf("%s: renewing %s(flags %s) => %s(flags %s)", p, osi.StringEx(), osi.Fl2S(), nsi.StringEx(), nsi.Fl2S())
 		return true
+	case osi.FailureDomain != nsi.FailureDomain:
+		nlog.Warningf("%s: renewing %s: failure domain %q => %q", p, nsi.StringEx(), osi.FailureDomain, nsi.FailureDomain)
+		return true
+	case osi.HrwWeight != nsi.HrwWeight:
+		nlog.Warningf("%s: renewing %s: hrw weight %d => %d", p, nsi.StringEx(), osi.HrwWeight, nsi.HrwWeight)
+		return true
 	default:
 		nlog.Infoln(p.String(), "node", nsi.StringEx(), "is already _in_ - nothing to do")
 		return false
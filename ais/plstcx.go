@@ -42,6 +42,14 @@ func (p *proxy) listObjects(w http.ResponseWriter, r *http.Request, bck *meta.Bc
 		}
 	}
 
+	if lsmsg.Snapshot != "" {
+		if err := _checkSnapshot(bck, lsmsg); err != nil {
+			p.statsT.IncBck(stats.ErrListCount, bck.Bucket())
+			p.writeErr(w, r, err)
+			return
+		}
+	}
+
 	// default props & flags => user-provided message
 	lsmsg.NormalizeNameSizeDflt()
 
@@ -96,6 +104,18 @@ func _checkVerChanged(bck *meta.Bck, lsmsg *apc.LsoMsg) error {
 	return nil
 }
 
+func _checkSnapshot(bck *meta.Bck, lsmsg *apc.LsoMsg) error {
+	if bck.Props.Snapshot(lsmsg.Snapshot) == nil {
+		return cmn.NewErrSnapshotNotFound(bck.Bucket(), lsmsg.Snapshot)
+	}
+	for _, fl := range []uint64{apc.LsVersions, apc.LsNoRecursion, apc.LsArchDir, apc.LsDiff} {
+		if lsmsg.IsFlagSet(fl) {
+			return fmt.Errorf("cannot list snapshot %q of %s: unsupported flags %#x", lsmsg.Snapshot, bck.Cname(""), lsmsg.Flags)
+		}
+	}
+	return nil
+}
+
 // one page; common code (native, s3 api)
 func (p *proxy) lsPage(bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg, hdr http.Header, smap *smapX) (*cmn.LsoRes, error) {
 	var (
@@ -463,21 +483,33 @@ func finLsoA(objs *cmn.LsoRes, lsmsg *apc.LsoMsg) {
 	// when recursion is disabled (apc.LsNoRecursion)
 	// the result _may_ include duplicated names of the virtual subdirectories
 	if lsmsg.IsFlagSet(apc.LsNoRecursion) {
-		objs.Entries = dedupLso(objs.Entries, maxSize)
+		if lsmsg.IsFlagSet(apc.LsVersions) {
+			objs.Entries = dedupLso(objs.Entries, 0) // (see below)
+		} else {
+			objs.Entries = dedupLso(objs.Entries, maxSize)
+		}
 	}
 	if l := len(objs.Entries); l >= maxSize {
+		if lsmsg.IsFlagSet(apc.LsVersions) {
+			// never split same-name entries (versions) between pages
+			for maxSize < l && objs.Entries[maxSize].Name == objs.Entries[maxSize-1].Name {
+				maxSize++
+			}
+		}
 		clear(objs.Entries[maxSize:])
 		objs.Entries = objs.Entries[:maxSize]
 		objs.ContinuationToken = objs.Entries[maxSize-1].Name
 	}
 }
 
-// - remove adjacent entries with the same Name (the input must already be sorted by Name)
+// - remove adjacent entries with the same Name (the input must already be sorted by Name);
+// - keep prior versions, if any (apc.LsVersions);
 // - stop after producing maxSize entries
 func dedupLso(entries cmn.LsoEntries, maxSize int) cmn.LsoEntries {
 	var j int
 	for _, en := range entries {
-		if j > 0 && entries[j-1].Name == en.Name {
+		if j > 0 && entries[j-1].Name == en.Name && !entries[j-1].IsAnyFlagSet(apc.EntryIsVersion) &&
+			!en.IsAnyFlagSet(apc.EntryIsVersion) {
 			continue
 		}
 
@@ -34,6 +34,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/NVIDIA/aistore/stats"
 	"github.com/NVIDIA/aistore/xact"
@@ -56,6 +57,9 @@ type (
 			mtv         sync.Mutex
 		}
 
+		quota         pquota      // cluster-wide usage of buckets and namespaces with quotas
+		sched         psched      // recurring jobs: run history and overlap state
+		admit         padmit      // xaction admission queue
 		fastKalive    atomic.Bool // can accept fast keepalives
 		membershipTxn atomic.Bool // admin-initiated membership change
 	}
@@ -231,6 +235,8 @@ func (p *proxy) Run() error {
 
 	p.notifs.init(p)
 	p.ic.init(p)
+	hk.Reg("job-schedule"+hk.NameSuffix, p.schedHK, hk.JobSchedIval)
+	hk.Reg("xact-queue"+hk.NameSuffix, p.admitHK, hk.XactQueueIval)
 	stats.RegSmapMetrics(p.owner.smap)
 
 	p.initRecvHandlers()
@@ -818,6 +824,7 @@ func (p *proxy) bgetObjects(w http.ResponseWriter, r *http.Request, qbck *cmn.Qu
 		bckArgs.r = r
 		bckArgs.msg = msg
 		bckArgs.perms = apc.AceObjLIST
+		bckArgs.scope = &cmn.PolicyScope{Prefix: lsmsg.Prefix, Recursive: !lsmsg.IsFlagSet(apc.LsNoRecursion)}
 		bckArgs.bck = bck
 		bckArgs.dpq = dpq
 		bckArgs.createAIS = false
@@ -1012,6 +1019,10 @@ func (p *proxy) httpobjdelete(w http.ResponseWriter, r *http.Request) {
 		bckArgs.perms = apc.AceObjDELETE
 		bckArgs.createAIS = false
 	}
+	// object lock: bypassing governance retention requires the permission to set bucket ACL
+	if cos.IsParseBool(r.URL.Query().Get(apc.QparamBypassGovernance)) {
+		bckArgs.perms |= apc.AceBckSetACL
+	}
 	bck, objName, err := p._parseReqTry(w, r, bckArgs)
 	if err != nil {
 		return
@@ -1044,7 +1055,7 @@ func (p *proxy) httpobjdelete(w http.ResponseWriter, r *http.Request) {
 	p.statsT.IncBck(stats.DeleteCount, bck.Bucket())
 }
 
-// +gen:endpoint DELETE /v1/buckets/{bucket-name}[apc.QparamProvider=string,apc.QparamNamespace=string,apc.QparamKeepRemote=bool] action=[apc.ActDestroyBck=apc.ActMsg|apc.ActEvictRemoteBck=apc.ActMsg|apc.ActDeleteObjects=apc.EvdMsg|apc.ActEvictObjects=apc.EvdMsg]
+// +gen:endpoint DELETE /v1/buckets/{bucket-name}[apc.QparamProvider=string,apc.QparamNamespace=string,apc.QparamKeepRemote=bool] action=[apc.ActDestroyBck=apc.ActMsg|apc.ActEvictRemoteBck=apc.ActMsg|apc.ActDeleteObjects=apc.EvdMsg|apc.ActEvictObjects=apc.EvdMsg|apc.ActDestroySnapshot=apc.ActMsg]
 // +gen:payload apc.ActDeleteObjects={"action": "delete-listrange", "value": {"objnames": ["o1", "o2"]}}
 // +gen:payload apc.ActEvictObjects={"action": "evict-listrange", "value": {"template": "prefix{001..100}"}}
 // Delete a bucket or delete/evict objects within a bucket
@@ -1153,6 +1164,8 @@ func (p *proxy) httpbckdelete(w http.ResponseWriter, r *http.Request, apireq *ap
 		writeXid(w, xid)
 	case apc.ActDestroyNBI:
 		p.destroyNBI(w, r, bck, am)
+	case apc.ActDestroySnapshot:
+		p.destroySnapshot(w, r, msg, bck)
 
 	default:
 		p.writeErrAct(w, r, msg.Action)
@@ -1506,7 +1519,7 @@ func (p *proxy) httpbckput(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// +gen:endpoint POST /v1/buckets/{bucket-name}[apc.QparamProvider=string,apc.QparamNamespace=string,apc.QparamBckTo=string,apc.QparamDontHeadRemote=bool] action=[apc.ActCreateBck=cmn.BpropsToSet|apc.ActMoveBck=apc.ActMsg|apc.ActCopyBck=apc.TCBMsg|apc.ActETLBck=apc.TCBMsg|apc.ActCopyObjects=cmn.TCOMsg|apc.ActETLObjects=cmn.TCOMsg|apc.ActPrefetchObjects=apc.PrefetchMsg|apc.ActMakeNCopies=int|apc.ActECEncode=cmn.ECConfToSet|apc.ActRechunk=apc.RechunkMsg|apc.ActCreateNBI=apc.CreateNBIMsg]
+// +gen:endpoint POST /v1/buckets/{bucket-name}[apc.QparamProvider=string,apc.QparamNamespace=string,apc.QparamBckTo=string,apc.QparamDontHeadRemote=bool,apc.QparamAdmitPrio=int,apc.QparamAdmitDeadline=string] action=[apc.ActCreateBck=cmn.BpropsToSet|apc.ActMoveBck=apc.ActMsg|apc.ActCopyBck=apc.TCBMsg|apc.ActETLBck=apc.TCBMsg|apc.ActCopyObjects=cmn.TCOMsg|apc.ActETLObjects=cmn.TCOMsg|apc.ActPrefetchObjects=apc.PrefetchMsg|apc.ActMakeNCopies=int|apc.ActECEncode=cmn.ECConfToSet|apc.ActRechunk=apc.RechunkMsg|apc.ActCreateNBI=apc.CreateNBIMsg|apc.ActCreateSnapshot=apc.ActMsg]
 // +gen:payload apc.ActCopyBck={"action": "copy-bck", "value": {"prefix": "images/", "prepend": "backup/", "latest-ver": true, "num-workers": 8}}
 // +gen:payload apc.ActETLBck={"action": "etl-bck", "value": {"id": "ETL_NAME", "prefix": "images/", "num-workers": 8}}
 // +gen:payload apc.ActCopyObjects={"action": "copy-objects", "value": {"tobck": {"name": "destination-bucket", "provider": "ais"}, "template": "shard-{001..100}.tar"}}
@@ -1535,6 +1548,9 @@ func (p *proxy) httpbckpost(w http.ResponseWriter, r *http.Request) {
 		p.writeErr(w, r, err)
 		return
 	}
+	if p.admitXact(w, r, msg, bucket) {
+		return
+	}
 	p._bckpost(w, r, msg, bucket)
 }
 
@@ -1669,6 +1685,13 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			nlog.Infoln("proceeding to copy remote", bckFrom.String())
 		}
 
+		if tcbmsg.Snapshot != "" {
+			if err := _checkCopySnap(bckFrom, msg, tcbmsg); err != nil {
+				p.writeErr(w, r, err)
+				return
+			}
+		}
+
 		bckTo, ecode, err = p.initBckTo(w, r, query, bckTo)
 		if err != nil {
 			return
@@ -1681,6 +1704,9 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 				return
 			}
 			nlog.Infof(warnDstNotExist, p, bckTo, bckFrom)
+		} else if tcbmsg.Snapshot != "" {
+			p.writeErr(w, r, cmn.NewErrBckAlreadyExists(bckTo.Bucket()))
+			return
 		}
 
 		// start x-tcb or x-tco
@@ -1814,6 +1840,9 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			p.writeErr(w, r, err)
 			return
 		}
+	case apc.ActCreateSnapshot:
+		p.createSnapshot(w, r, msg, bck)
+		return
 	case apc.ActCreateNBI:
 		if err := p.initTrySysBck(w, r, msg, meta.SysBckNBI()); err != nil {
 			return
@@ -2312,7 +2341,7 @@ func toHdr(w http.ResponseWriter, bck *meta.Bck, info *cmn.BsummResult, status i
 	}
 }
 
-// +gen:endpoint PATCH /v1/buckets/{bucket-name}[apc.QparamProvider=string,apc.QparamNamespace=string] action=[apc.ActSetBprops=cmn.BpropsToSet|apc.ActResetBprops=apc.ActMsg]
+// +gen:endpoint PATCH /v1/buckets/{bucket-name}[apc.QparamProvider=string,apc.QparamNamespace=string,apc.QparamRechunk=string] action=[apc.ActSetBprops=cmn.BpropsToSet|apc.ActResetBprops=apc.ActMsg]
 // +gen:payload apc.ActSetBprops={"action": "set-bprops", "value": {"versioning": {"enabled": true}, "mirror": {"enabled": true, "copies": 2}}}
 // Update or reset bucket properties
 func (p *proxy) httpbckpatch(w http.ResponseWriter, r *http.Request, apireq *apiRequest) {
@@ -2399,7 +2428,18 @@ func (p *proxy) httpbckpatch(w http.ResponseWriter, r *http.Request, apireq *api
 			return
 		}
 	}
-	if xid, err = p.setBprops(msg, bck, nprops); err != nil {
+	rechunk := apireq.query.Get(apc.QparamRechunk)
+	if rechunk != "" && rechunk != apc.RechunkDryRun {
+		yes, err := cos.ParseBool(rechunk)
+		if err != nil {
+			p.writeErrf(w, r, "invalid %s=%q (expecting bool or %q)", apc.QparamRechunk, rechunk, apc.RechunkDryRun)
+			return
+		}
+		if !yes {
+			rechunk = ""
+		}
+	}
+	if xid, err = p.setBprops(msg, bck, nprops, rechunk); err != nil {
 		p.writeErr(w, r, err)
 		return
 	}
@@ -2451,6 +2491,9 @@ func (p *proxy) httpobjpatch(w http.ResponseWriter, r *http.Request) {
 		bckArgs.perms = apc.AceObjUpdate
 		bckArgs.createAIS = false
 	}
+	if cos.IsParseBool(r.URL.Query().Get(apc.QparamBypassGovernance)) {
+		bckArgs.perms |= apc.AceBckSetACL
+	}
 	bck, objName, err := p._parseReqTry(w, r, bckArgs)
 	if err != nil {
 		return
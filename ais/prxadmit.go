@@ -0,0 +1,347 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+// Xaction admission queue (see cmn.XactQueue):
+// - the first attempt is executed in-process, as is (with the caller's credentials and access checks);
+// - upon "limited coexistence" conflict the request gets queued, and the caller receives queue ID;
+// - pending requests are retried (admitHK) in the order of their priorities, on behalf of the caller
+//   (similar to scheduled jobs, they are not subject to token validation - see accessPrefix);
+// - strictly so: a request that still conflicts ends the retry pass, holding back all
+//   lower-priority (and later) ones until it starts, fails, expires, or gets canceled;
+// - the queue is kept in memory and does not survive primary change.
+
+type (
+	admitReq struct {
+		handler func(http.ResponseWriter, *http.Request)
+		method  string
+		uri     string // path and query (sans admission parameters)
+		body    []byte // action message
+		cmn.XactQueueEntry
+		dispatching bool
+	}
+	padmit struct {
+		pending []*admitReq           // admission order: priority (higher first), then FIFO
+		done    []*cmn.XactQueueEntry // most recently resolved last
+		mu      sync.Mutex
+		running atomic.Bool // retry pass in progress
+	}
+)
+
+// returns true when the request is (being) handled by the admission queue
+func (p *proxy) admitXact(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg, bucket string) bool {
+	query := r.URL.Query()
+	if !query.Has(apc.QparamAdmitPrio) {
+		return false
+	}
+	if p.forwardCP(w, r, msg, bucket) {
+		return true
+	}
+	prio, deadline, err := cmn.ParseAdmitQuery(query.Get(apc.QparamAdmitPrio), query.Get(apc.QparamAdmitDeadline))
+	if err != nil {
+		p.writeErr(w, r, err)
+		return true
+	}
+	req := &admitReq{XactQueueEntry: cmn.XactQueueEntry{Action: msg.Action, Prio: prio}}
+	if bucket == "" {
+		// PUT /v1/cluster
+		var xargs xact.ArgsMsg
+		if err := cos.MorphMarshal(msg.Value, &xargs); err != nil {
+			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+			return true
+		}
+		_, req.Kind = xact.GetKindName(xargs.Kind)
+		if !xargs.Bck.IsEmpty() {
+			req.Bck = xargs.Bck.Cname("")
+		}
+		req.handler, req.method = p.cluPubHandler, http.MethodPut
+	} else {
+		// POST /v1/buckets/bucket-name
+		if _, ok := xact.Table[msg.Action]; !ok {
+			p.writeErrf(w, r, "%s: bucket action %q does not run xaction and cannot be queued", p, msg.Action)
+			return true
+		}
+		bck, err := newBckFromQ(bucket, query, nil)
+		if err != nil {
+			p.writeErr(w, r, err)
+			return true
+		}
+		req.Bck = bck.Cname("")
+		req.handler, req.method = p.bucketHandler, http.MethodPost
+	}
+	query.Del(apc.QparamAdmitPrio)
+	query.Del(apc.QparamAdmitDeadline)
+	req.uri = (&url.URL{Path: r.URL.Path, RawQuery: query.Encode()}).String()
+	req.body = cos.MustMarshal(msg)
+
+	// first attempt
+	r2 := r.Clone(r.Context())
+	r2.URL.RawQuery = query.Encode()
+	r2.Body, r2.ContentLength = io.NopCloser(bytes.NewReader(req.body)), int64(len(req.body))
+	jw := newJobWriter(true)
+	req.handler(jw, r2)
+
+	errConflict := jw.err()
+	if errConflict == nil || !cmn.IsErrLimitedCoexistence(errConflict) {
+		jw.copyTo(w)
+		return true
+	}
+
+	// queue
+	now := time.Now()
+	req.ID = cos.GenUUID()
+	req.State, req.Err = cmn.XqPending, errConflict.Error()
+	req.Attempts, req.Added, req.Updated = 1, now.UnixNano(), now.UnixNano()
+	if deadline > 0 {
+		req.Deadline = now.Add(deadline).UnixNano()
+	}
+	if err := p.primary().admit.add(req); err != nil {
+		p.writeErr(w, r, err, http.StatusTooManyRequests)
+		return true
+	}
+	nlog.Infoln("queued", req.String(), "[", req.Err, "]")
+
+	w.Header().Set(cos.HdrContentLength, strconv.Itoa(len(req.ID)))
+	w.WriteHeader(http.StatusAccepted)
+	w.Write(cos.UnsafeB(req.ID))
+	return true
+}
+
+// PUT {action: dequeue-xaction, name: queue ID}
+// +gen:payload apc.ActXactDequeue={"action": "dequeue-xaction", "name": "Xq7hTlpAb"}
+func (p *proxy) dequeueXact(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
+	if msg.Name == "" {
+		p.writeErrf(w, r, "%s: missing queue ID", msg.Action)
+		return
+	}
+	if err := p.primary().admit.cancel(msg.Name, time.Now()); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	nlog.Infoln(msg.Action, msg.Name)
+}
+
+// GET /v1/cluster?what=xact-queue - from the primary, locally or otherwise
+func (p *proxy) getXactQueue() (cmn.XactQueue, error) {
+	return fromPrimary(p, apc.WhatXactQueue, func() cmn.XactQueue { return p.primary().admit.list() })
+}
+
+// expire and retry pending requests (primary only)
+func (p *proxy) admitHK(int64) time.Duration {
+	if !p.ClusterStarted() || nlog.Stopping() {
+		return hk.XactQueueIval
+	}
+	var (
+		pa   = &p.primary().admit
+		now  = time.Now()
+		smap = p.owner.smap.get()
+	)
+	if !smap.isPrimary(p.si) {
+		if n := pa.abort("primary changed", now); n > 0 {
+			nlog.Warningln(p.String(), "is not primary: failed", n, "queued request(s)")
+		}
+		return hk.XactQueueIval
+	}
+	for _, e := range pa.expire(now) {
+		nlog.Warningln("expired", e.String())
+	}
+	if !pa.running.CAS(false, true) {
+		return hk.XactQueueIval
+	}
+	if reqs := pa.next(); len(reqs) > 0 {
+		go p.admitPass(reqs)
+	} else {
+		pa.running.Store(false)
+	}
+	return hk.XactQueueIval
+}
+
+// retry pending requests one at a time, in order;
+// stop at the first one that is still conflicting (see "strictly so" above)
+func (p *proxy) admitPass(reqs []*admitReq) {
+	pa := &p.primary().admit
+	defer pa.running.Store(false)
+	for _, req := range reqs {
+		if !pa.dispatch(req, time.Now()) {
+			continue // canceled or expired in the meantime
+		}
+		xid, err := p.execInproc(req.handler, req.method, req.uri, req.body, req.ID)
+		e := pa.resolve(req, xid, err, time.Now())
+		switch e.State {
+		case cmn.XqStarted:
+			nlog.Infoln("started", e.String(), "xid:", e.Xid)
+		case cmn.XqFailed:
+			nlog.Errorln("failed to start", e.String(), "err:", e.Err)
+		case cmn.XqPending:
+			return
+		}
+	}
+}
+
+////////////
+// padmit //
+////////////
+
+func (pa *padmit) add(req *admitReq) error {
+	pa.mu.Lock()
+	defer pa.mu.Unlock()
+	if len(pa.pending) >= cmn.XactQueueMax {
+		return cmn.NewErrBusy("xaction admission queue", strconv.Itoa(len(pa.pending))+" pending requests")
+	}
+	i := sort.Search(len(pa.pending), func(i int) bool {
+		return pa.pending[i].Prio < req.Prio
+	})
+	pa.pending = append(pa.pending, nil)
+	copy(pa.pending[i+1:], pa.pending[i:])
+	pa.pending[i] = req
+	return nil
+}
+
+// (under lock)
+func (pa *padmit) _del(req *admitReq, state string, now time.Time) *cmn.XactQueueEntry {
+	for i, r := range pa.pending {
+		if r == req {
+			pa.pending = append(pa.pending[:i], pa.pending[i+1:]...)
+			break
+		}
+	}
+	req.State, req.Updated = state, now.UnixNano()
+	e := req.XactQueueEntry // (copy)
+	pa.done = append(pa.done, &e)
+	if l := len(pa.done); l > cmn.XactQueueHistory {
+		pa.done = append(pa.done[:0], pa.done[l-cmn.XactQueueHistory:]...)
+	}
+	return &e
+}
+
+func (pa *padmit) cancel(id string, now time.Time) error {
+	pa.mu.Lock()
+	defer pa.mu.Unlock()
+	for _, req := range pa.pending {
+		if req.ID != id {
+			continue
+		}
+		if req.dispatching {
+			return cmn.NewErrBusy("queued request", id, "being started")
+		}
+		pa._del(req, cmn.XqCanceled, now)
+		return nil
+	}
+	for _, e := range pa.done {
+		if e.ID == id {
+			return fmt.Errorf("cannot cancel queued request %s: already %s", id, e.State)
+		}
+	}
+	return cos.NewErrNotFound(nil, "queued request "+id)
+}
+
+func (pa *padmit) expire(now time.Time) (expired []*cmn.XactQueueEntry) {
+	pa.mu.Lock()
+	for i := 0; i < len(pa.pending); {
+		req := pa.pending[i]
+		if req.Deadline == 0 || req.dispatching || now.UnixNano() < req.Deadline {
+			i++
+			continue
+		}
+		expired = append(expired, pa._del(req, cmn.XqExpired, now))
+	}
+	pa.mu.Unlock()
+	return expired
+}
+
+// fail all pending requests (upon primary change)
+func (pa *padmit) abort(reason string, now time.Time) (n int) {
+	pa.mu.Lock()
+	for i := 0; i < len(pa.pending); {
+		req := pa.pending[i]
+		if req.dispatching {
+			i++
+			continue
+		}
+		req.Err = reason
+		pa._del(req, cmn.XqFailed, now)
+		n++
+	}
+	pa.mu.Unlock()
+	return n
+}
+
+// pending requests in admission order
+func (pa *padmit) next() []*admitReq {
+	pa.mu.Lock()
+	reqs := make([]*admitReq, 0, len(pa.pending))
+	for _, req := range pa.pending {
+		if !req.dispatching {
+			reqs = append(reqs, req)
+		}
+	}
+	pa.mu.Unlock()
+	return reqs
+}
+
+func (pa *padmit) dispatch(req *admitReq, now time.Time) bool {
+	pa.mu.Lock()
+	defer pa.mu.Unlock()
+	if req.State != cmn.XqPending || (req.Deadline != 0 && now.UnixNano() >= req.Deadline) {
+		return false
+	}
+	req.dispatching = true
+	return true
+}
+
+// still conflicting: remains pending
+// otherwise: started or failed
+func (pa *padmit) resolve(req *admitReq, xid string, err error, now time.Time) *cmn.XactQueueEntry {
+	pa.mu.Lock()
+	defer pa.mu.Unlock()
+	req.dispatching = false
+	req.Attempts++
+	if err != nil && cmn.IsErrLimitedCoexistence(err) {
+		req.Err, req.Updated = err.Error(), now.UnixNano()
+		e := req.XactQueueEntry
+		return &e
+	}
+	if err != nil {
+		req.Err = err.Error()
+		return pa._del(req, cmn.XqFailed, now)
+	}
+	req.Xid, req.Err = xid, ""
+	return pa._del(req, cmn.XqStarted, now)
+}
+
+func (pa *padmit) list() cmn.XactQueue {
+	pa.mu.Lock()
+	out := make(cmn.XactQueue, 0, len(pa.pending)+len(pa.done))
+	for _, req := range pa.pending {
+		e := req.XactQueueEntry
+		out = append(out, &e)
+	}
+	for i := len(pa.done) - 1; i >= 0; i-- {
+		e := *pa.done[i]
+		out = append(out, &e)
+	}
+	pa.mu.Unlock()
+	return out
+}
@@ -0,0 +1,103 @@
+// Package ais: internal unit tests
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func newAdmitReq(id string, prio int, deadline time.Time) *admitReq {
+	req := &admitReq{XactQueueEntry: cmn.XactQueueEntry{ID: id, Action: "copy-bck", Prio: prio, State: cmn.XqPending}}
+	if !deadline.IsZero() {
+		req.Deadline = deadline.UnixNano()
+	}
+	return req
+}
+
+func admitIDs(reqs []*admitReq) (ids []string) {
+	for _, req := range reqs {
+		ids = append(ids, req.ID)
+	}
+	return ids
+}
+
+func TestAdmitQueueOrder(t *testing.T) {
+	var pa padmit
+	for _, req := range []*admitReq{
+		newAdmitReq("a", 0, time.Time{}),
+		newAdmitReq("b", 10, time.Time{}),
+		newAdmitReq("c", 0, time.Time{}),
+		newAdmitReq("d", -5, time.Time{}),
+		newAdmitReq("e", 10, time.Time{}),
+	} {
+		tassert.CheckFatal(t, pa.add(req))
+	}
+	ids := admitIDs(pa.next())
+	tassert.Fatalf(t, len(ids) == 5 && ids[0] == "b" && ids[1] == "e" && ids[2] == "a" && ids[3] == "c" && ids[4] == "d",
+		"expecting priority (higher first), then FIFO order, got %v", ids)
+
+	// still conflicting: remains pending, in place
+	reqs := pa.next()
+	now := time.Now()
+	tassert.Fatalf(t, pa.dispatch(reqs[0], now), "expecting to dispatch %s", reqs[0].ID)
+	tassert.Fatalf(t, len(pa.next()) == 4, "expecting dispatching request to be skipped")
+	limco := cmn.NewErrLimitedCoexistence("t[abc]", "rebalance[xyz]", "copy-bck", "ais://src")
+	e := pa.resolve(reqs[0], "", limco, now)
+	tassert.Fatalf(t, e.State == cmn.XqPending && e.Attempts == 1, "expecting pending after 1 attempt, got %+v", e)
+	ids = admitIDs(pa.next())
+	tassert.Fatalf(t, len(ids) == 5 && ids[0] == "b", "expecting %q to remain first, got %v", "b", ids)
+
+	// started and failed
+	tassert.Fatalf(t, pa.dispatch(reqs[0], now), "expecting to dispatch %s", reqs[0].ID)
+	e = pa.resolve(reqs[0], "xid-b", nil, now)
+	tassert.Fatalf(t, e.State == cmn.XqStarted && e.Xid == "xid-b", "expecting started, got %+v", e)
+	tassert.Fatalf(t, pa.dispatch(reqs[1], now), "expecting to dispatch %s", reqs[1].ID)
+	e = pa.resolve(reqs[1], "", errors.New("bucket does not exist"), now)
+	tassert.Fatalf(t, e.State == cmn.XqFailed, "expecting failed, got %+v", e)
+	tassert.Fatalf(t, !pa.dispatch(reqs[1], now), "resolved request must not be dispatched")
+
+	// listing: pending first, then resolved (most recent first)
+	xq := pa.list()
+	tassert.Fatalf(t, len(xq) == 5, "expecting 3 pending + 2 resolved, got %d", len(xq))
+	tassert.Fatalf(t, xq[0].ID == "a" && xq[3].ID == "e" && xq[4].ID == "b", "unexpected listing order")
+}
+
+func TestAdmitQueueCancelExpire(t *testing.T) {
+	var (
+		pa  padmit
+		now = time.Now()
+	)
+	tassert.CheckFatal(t, pa.add(newAdmitReq("a", 0, now.Add(time.Minute))))
+	tassert.CheckFatal(t, pa.add(newAdmitReq("b", 0, time.Time{})))
+	tassert.CheckFatal(t, pa.add(newAdmitReq("c", 0, time.Time{})))
+
+	tassert.CheckFatal(t, pa.cancel("b", now))
+	tassert.Fatalf(t, pa.cancel("b", now) != nil, "expecting error canceling already canceled request")
+	tassert.Fatalf(t, cos.IsNotExist(pa.cancel("x", now)), "expecting not-found")
+
+	reqs := pa.next()
+	tassert.Fatalf(t, len(reqs) == 2, "expecting 2 pending, got %d", len(reqs))
+	tassert.Fatalf(t, pa.dispatch(reqs[1], now), "expecting to dispatch %s", reqs[1].ID)
+	tassert.Fatalf(t, cmn.IsErrBusy(pa.cancel("c", now)), "expecting busy canceling request that is being started")
+
+	expired := pa.expire(now.Add(time.Minute))
+	tassert.Fatalf(t, len(expired) == 1 && expired[0].ID == "a" && expired[0].State == cmn.XqExpired,
+		"expecting %q to expire, got %v", "a", expired)
+	tassert.Fatalf(t, len(pa.next()) == 0, "expecting no pending requests other than the one being dispatched")
+
+	// (dispatching requests are not aborted)
+	tassert.Fatalf(t, pa.abort("primary changed", now) == 0, "expecting nothing to abort")
+	pa.resolve(reqs[1], "", cmn.NewErrLimitedCoexistence("t[abc]", "x", "y", "z"), now)
+	tassert.Fatalf(t, pa.abort("primary changed", now) == 1, "expecting one aborted request")
+	xq := pa.list()
+	tassert.Fatalf(t, len(xq) == 3 && xq[0].ID == "c" && xq[0].State == cmn.XqFailed && xq[0].Err == "primary changed",
+		"unexpected listing %+v", xq)
+}
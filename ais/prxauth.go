@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -392,14 +393,38 @@ func aceErrToCode(err error) (status int) {
 // - read-only access to a bucket is always granted
 // - PATCH cannot be forbidden
 //
+// Bucket policy, if defined, is evaluated first (see cmn.PolicyConf):
+// - explicit deny always wins (except for admins)
+// - allow bypasses the user's (token) permissions, but not bucket ACL
+//
 // NOTE:
 // - access() is reached  only from pub-net handlers; intra-cluster auth lives in htrun checkIntra/parseReq.
-func (p *proxy) access(r *http.Request, bck *meta.Bck, ace apc.AccessAttrs) (err error) {
+func (p *proxy) access(r *http.Request, bck *meta.Bck, ace apc.AccessAttrs) error {
+	return p.accessScope(r, bck, ace, nil)
+}
+
+// same as above for list-objects and multi-object requests (to evaluate prefix-scoped bucket policies)
+func (p *proxy) accessScope(r *http.Request, bck *meta.Bck, ace apc.AccessAttrs, scope *cmn.PolicyScope) (err error) {
+	// scheduled job or queued xaction (executed by the primary in-process) - access was checked
+	// when adding the former (admin) and admitting the latter
+	if isSchedJob(r) {
+		return nil
+	}
+	var policy *cmn.PolicyConf
+	if bck != nil && bck.Props != nil && bck.Props.Policy.IsActive() && !bck.Bucket().IsSystem() {
+		policy = &bck.Props.Policy
+	}
+
 	// auth is not enabled: check bucket properties
 	if !cmn.Rom.ClientAuthRequired() {
 		if bck == nil || bck.Props == nil {
 			return nil
 		}
+		if policy != nil {
+			if verdict, err := p.evalPolicy(r, bck, policy, "" /*anonymous*/, ace, scope); verdict == cmn.PolicyDenied {
+				return err
+			}
+		}
 		// With Auth disabled, always allow read-only access, PATCH, and ACL
 		ace &^= apc.AcePATCH | apc.AceBckSetACL | apc.AccessRO
 		err = bck.Allow(ace)
@@ -418,12 +443,112 @@ func (p *proxy) access(r *http.Request, bck *meta.Bck, ace apc.AccessAttrs) (err
 	}
 	claims, err := p.validateToken(r.Context(), r.Header)
 	if err != nil {
+		// anonymous access: explicitly allowed by bucket policy
+		if policy != nil && errors.Is(err, tok.ErrNoToken) {
+			if verdict, _ := p.evalPolicy(r, bck, policy, "", ace, scope); verdict == cmn.PolicyAllowed {
+				return bck.Allow(ace)
+			}
+		}
 		nlog.Warningln("token validation failed:", err)
 		return err
 	}
+	if policy != nil && !claims.IsAdmin {
+		sub, _ := claims.GetSubject()
+		verdict, err := p.evalPolicy(r, bck, policy, sub, ace, scope)
+		switch verdict {
+		case cmn.PolicyDenied:
+			p.statsT.Inc(stats.ACLTotalCount)
+			p.statsT.Inc(stats.ACLDeniedCount)
+			return err
+		case cmn.PolicyAllowed:
+			p.statsT.Inc(stats.ACLTotalCount)
+			if err = bck.Allow(ace); err != nil {
+				p.statsT.Inc(stats.ACLDeniedCount)
+			}
+			return err
+		}
+	}
 	return p.checkTokenAccess(claims, bck, ace)
 }
 
+// resource: objects selected by list-objects or multi-object request (scope), or
+// object name from the request URL path (native and S3 APIs)
+func (*proxy) evalPolicy(r *http.Request, bck *meta.Bck, policy *cmn.PolicyConf, principal string, ace apc.AccessAttrs,
+	scope *cmn.PolicyScope) (int, error) {
+	var (
+		verdict  int
+		sid      string
+		resource string
+	)
+	if scope != nil {
+		resource = scope.String()
+		verdict, sid = policy.EvalScope(principal, ace, scope)
+	} else {
+		resource = policyObjName(r.URL.Path)
+		verdict, sid = policy.Eval(principal, ace, resource)
+	}
+	if verdict != cmn.PolicyDenied {
+		return verdict, nil
+	}
+	if principal == "" {
+		principal = "anonymous"
+	}
+	err := fmt.Errorf("%s: %s access denied to %q by bucket policy (statement %s)",
+		bck.Cname(resource), ace.Describe(false), principal, sid)
+	nlog.Warningln(err)
+	return verdict, err
+}
+
+// multi-object and bucket-to-bucket actions => objects they select;
+// when the selection cannot be determined, fail closed (entire bucket)
+func policyScope(msg *apc.ActMsg) *cmn.PolicyScope {
+	var (
+		lr  apc.ListRange
+		all = &cmn.PolicyScope{Recursive: true}
+	)
+	switch msg.Action {
+	case apc.ActDeleteObjects, apc.ActEvictObjects, apc.ActPrefetchObjects,
+		apc.ActCopyObjects, apc.ActETLObjects, apc.ActArchive:
+		// (all embed apc.ListRange)
+		if err := cos.MorphMarshal(msg.Value, &lr); err != nil {
+			return all
+		}
+	case apc.ActCopyBck, apc.ActETLBck:
+		var tcbmsg apc.TCBMsg
+		if err := cos.MorphMarshal(msg.Value, &tcbmsg); err != nil {
+			return all
+		}
+		return &cmn.PolicyScope{Prefix: tcbmsg.Prefix, Recursive: true}
+	default:
+		return nil
+	}
+	if len(lr.ObjNames) > 0 {
+		return &cmn.PolicyScope{Names: lr.ObjNames}
+	}
+	pt, err := cos.NewParsedTemplate(lr.Template)
+	if err != nil {
+		return all // including empty (match-all) template
+	}
+	return &cmn.PolicyScope{Prefix: pt.Prefix, Recursive: true}
+}
+
+// "/v1/objects/<bucket>/<object>" or "/s3/<bucket>/<object>" => "<object>"
+func policyObjName(path string) string {
+	var rest string
+	switch {
+	case strings.HasPrefix(path, apc.URLPathObjects.S+"/"):
+		rest = path[len(apc.URLPathObjects.S)+1:]
+	case strings.HasPrefix(path, apc.URLPathS3.S+"/"):
+		rest = path[len(apc.URLPathS3.S)+1:]
+	default:
+		return ""
+	}
+	if i := strings.IndexByte(rest, '/'); i > 0 {
+		return rest[i+1:]
+	}
+	return ""
+}
+
 func (p *proxy) checkTokenAccess(claims *tok.AISClaims, bck *meta.Bck, ace apc.AccessAttrs) (err error) {
 	if bck == nil {
 		err = p.checkClaimPermissions(claims, nil, ace)
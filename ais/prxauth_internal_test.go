@@ -400,3 +400,31 @@ func TestAuth_ShardedTokenMap_Concurrency(_ *testing.T) {
 	}
 	wg.Wait()
 }
+
+// multi-object actions => bucket policy scope (fail closed when not determined)
+func TestAuth_PolicyScope(t *testing.T) {
+	tests := []struct {
+		msg    apc.ActMsg
+		prefix string
+		names  []string
+	}{
+		{msg: apc.ActMsg{Action: apc.ActDeleteObjects, Value: map[string]any{"objnames": []string{"a", "b"}}}, names: []string{"a", "b"}},
+		{msg: apc.ActMsg{Action: apc.ActEvictObjects, Value: map[string]any{"template": "data/shard-{001..999}.tar"}}, prefix: "data/shard-"},
+		{msg: apc.ActMsg{Action: apc.ActPrefetchObjects, Value: map[string]any{"template": "logs/"}}, prefix: "logs/"},
+		{msg: apc.ActMsg{Action: apc.ActArchive, Value: map[string]any{"tobck": map[string]any{"name": "dst"}, "template": ""}}},
+		{msg: apc.ActMsg{Action: apc.ActCopyObjects, Value: "garbage"}},
+		{msg: apc.ActMsg{Action: apc.ActCopyBck, Value: map[string]any{"prefix": "images/", "prepend": "x/"}}, prefix: "images/"},
+		{msg: apc.ActMsg{Action: apc.ActETLBck, Value: map[string]any{"id": "etl"}}},
+	}
+	for _, test := range tests {
+		scope := policyScope(&test.msg)
+		tassert.Fatalf(t, scope != nil, "%s: expecting scope", test.msg.Action)
+		if test.names != nil {
+			tassert.Fatalf(t, slices.Equal(scope.Names, test.names), "%s: expecting names %v, got %+v", test.msg.Action, test.names, scope)
+			continue
+		}
+		tassert.Fatalf(t, scope.Names == nil && scope.Recursive && scope.Prefix == test.prefix,
+			"%s: expecting recursive prefix %q, got %+v", test.msg.Action, test.prefix, scope)
+	}
+	tassert.Fatalf(t, policyScope(&apc.ActMsg{Action: apc.ActMakeNCopies}) == nil, "expecting no scope")
+}
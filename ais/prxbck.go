@@ -35,8 +35,9 @@ type bctx struct {
 	query url.Values
 	dpq   *dpq
 
-	reqBody []byte          // request body of original request
-	perms   apc.AccessAttrs // apc.AceGET, apc.AcePATCH etc.
+	reqBody []byte           // request body of original request
+	perms   apc.AccessAttrs  // apc.AceGET, apc.AcePATCH etc.
+	scope   *cmn.PolicyScope // objects selected by list-objects or multi-object request (bucket policy)
 
 	// 5 user or caller-provided control flags followed by
 	// 3 result flags
@@ -220,7 +221,11 @@ rerr:
 
 // (compare w/ accessSupported)
 func (bctx *bctx) accessAllowed(bck *meta.Bck) (ecode int, err error) {
-	err = bctx.p.access(bctx.r, bck, bctx.perms)
+	scope := bctx.scope
+	if scope == nil && bctx.msg != nil && bck.Props != nil && bck.Props.Policy.IsActive() {
+		scope = policyScope(bctx.msg)
+	}
+	err = bctx.p.accessScope(bctx.r, bck, bctx.perms, scope)
 	ecode = aceErrToCode(err)
 	return ecode, err
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/xact"
 )
@@ -75,7 +76,7 @@ func (p *proxy) bsummAct(w http.ResponseWriter, r *http.Request, qbck *cmn.Query
 
 // bsummCollect keeps bucket-specific aggregation on top of summCollect.
 // TODO: make it a summCtx[cmn.AllBsummResults] method once Go 1.27 lands.
-func (*proxy) bsummCollect(ctx *summCtx[cmn.AllBsummResults]) (_ cmn.AllBsummResults, status int, err error) {
+func (p *proxy) bsummCollect(ctx *summCtx[cmn.AllBsummResults]) (_ cmn.AllBsummResults, status int, err error) {
 	results, status, err := ctx.summCollect()
 	if err != nil {
 		return nil, 0, err
@@ -94,9 +95,50 @@ func (*proxy) bsummCollect(ctx *summCtx[cmn.AllBsummResults]) (_ cmn.AllBsummRes
 		}
 	}
 	summaries.Finalize(dsize, cmn.Rom.TestingEnv())
+	p.bsummQuota(summaries)
 	return summaries, status, nil
 }
 
+// usage vs. quota (see cmn/quota) - for buckets that have quotas or belong to namespaces that do
+func (p *proxy) bsummQuota(summaries cmn.AllBsummResults) {
+	var (
+		stats *cmn.QuotaStats
+		bmd   = p.owner.bmd.get()
+	)
+	for _, summ := range summaries {
+		var (
+			bck    = meta.CloneBck(&summ.Bck)
+			nq     = bmd.NsQuota(&bck.Ns)
+			bq     *cmn.QuotaConf
+			bprops *cmn.Bprops
+			ok     bool
+		)
+		if bprops, ok = bmd.Get(bck); ok {
+			bq = &bprops.Quota
+		}
+		if (bq == nil || !bq.IsSet()) && nq == nil {
+			continue
+		}
+		if stats == nil {
+			var err error
+			if stats, err = p.getQuotaStats(); err != nil {
+				nlog.Warningln(p.String(), "bucket summary: failed to get quota usage:", err)
+				return
+			}
+		}
+		summ.Quota = &cmn.BsummQuota{
+			BckUsed: stats.Bcks[string(bck.MakeUname(""))],
+			NsUsed:  stats.Nss[bck.Ns.Uname()],
+		}
+		if bq != nil {
+			summ.Quota.Bck = *bq
+		}
+		if nq != nil {
+			summ.Quota.Ns = *nq
+		}
+	}
+}
+
 // fully reuse bsummAct impl.
 func (p *proxy) bsummHead(bck *meta.Bck, msg *apc.BsummCtrlMsg) (info *cmn.BsummResult, status int, err error) {
 	var (
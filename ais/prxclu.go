@@ -104,6 +104,27 @@ func (p *proxy) httpcluget(w http.ResponseWriter, r *http.Request, isPub bool) {
 			out = append(out, b)
 		}
 		p.writeJSON(w, r, out, what)
+	case apc.WhatQuota:
+		stats, err := p.getQuotaStats()
+		if err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		p.writeJSON(w, r, stats, what)
+	case apc.WhatJobSchedules:
+		scheds, err := p.getJobSchedules()
+		if err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		p.writeJSON(w, r, scheds, what)
+	case apc.WhatXactQueue:
+		xq, err := p.getXactQueue()
+		if err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		p.writeJSON(w, r, xq, what)
 	case apc.WhatRemoteAIS:
 		all, err := p.getRemAisVec(true /*refresh*/)
 		if err != nil {
@@ -144,6 +165,28 @@ func (p *proxy) httpcluget(w http.ResponseWriter, r *http.Request, isPub bool) {
 	}
 }
 
+// GET /v1/cluster?what=<what> - from the primary, locally or otherwise
+func fromPrimary[T any](p *proxy, what string, local func() T) (v T, _ error) {
+	smap := p.owner.smap.get()
+	if smap.isPrimary(p.si) {
+		return local(), nil
+	}
+	cargs := allocCargs()
+	{
+		cargs.si = smap.Primary
+		cargs.req = cmn.HreqArgs{Method: http.MethodGet, Path: apc.URLPathClu.S, Query: url.Values{apc.QparamWhat: []string{what}}}
+		cargs.timeout = apc.DefaultTimeout
+		cargs.cresv = cresjGeneric[T]{}
+	}
+	res := p.call(cargs, smap)
+	freeCargs(cargs)
+	defer freeCR(res)
+	if res.err != nil {
+		return v, res.toErr()
+	}
+	return *res.v.(*T), nil
+}
+
 // apc.WhatQueryXactStats (NOTE: may poll for quiescence)
 func (p *proxy) xquery(w http.ResponseWriter, r *http.Request, what string, query url.Values) {
 	if !p.ClusterStarted() {
@@ -423,7 +466,7 @@ func _rawResWithTimeout(results sliceResults) (cos.JSONRawMsgs, error, bool /*ti
 // - cluster membership, including maintenance and decommission
 // - rebalance
 // - set-primary
-// +gen:endpoint PUT /v1/cluster[apc.QparamTransient=bool] action=[apc.ActSetConfig=cmn.ConfigToSet|apc.ActResetConfig=apc.ActMsg|apc.ActRotateLogs=apc.ActMsg|apc.ActShutdownCluster=apc.ActMsg|apc.ActDecommissionCluster=apc.ActValRmNode|apc.ActStartMaintenance=apc.ActValRmNode|apc.ActDecommissionNode=apc.ActValRmNode|apc.ActShutdownNode=apc.ActValRmNode|apc.ActRmNodeUnsafe=apc.ActValRmNode|apc.ActStopMaintenance=apc.ActValRmNode|apc.ActResetStats=apc.ActMsg|apc.ActClearLcache=apc.ActMsg|apc.ActXactStart=apc.ActMsg|apc.ActXactStop=apc.ActMsg|apc.ActReloadBackendCreds=apc.ActMsg|apc.ActBumpMetasync=apc.ActMsg]
+// +gen:endpoint PUT /v1/cluster[apc.QparamTransient=bool,apc.QparamAdmitPrio=int,apc.QparamAdmitDeadline=string] action=[apc.ActSetConfig=cmn.ConfigToSet|apc.ActResetConfig=apc.ActMsg|apc.ActRotateLogs=apc.ActMsg|apc.ActShutdownCluster=apc.ActMsg|apc.ActDecommissionCluster=apc.ActValRmNode|apc.ActStartMaintenance=apc.ActValRmNode|apc.ActDecommissionNode=apc.ActValRmNode|apc.ActShutdownNode=apc.ActValRmNode|apc.ActRmNodeUnsafe=apc.ActValRmNode|apc.ActStopMaintenance=apc.ActValRmNode|apc.ActResetStats=apc.ActMsg|apc.ActClearLcache=apc.ActMsg|apc.ActXactStart=apc.ActMsg|apc.ActXactStop=apc.ActMsg|apc.ActReloadBackendCreds=apc.ActMsg|apc.ActSetNsQuota=cmn.QuotaConf|apc.ActAddJobSchedule=cmn.JobSchedule|apc.ActRmJobSchedule=apc.ActMsg|apc.ActXactDequeue=apc.ActMsg|apc.ActBumpMetasync=apc.ActMsg]
 // +gen:payload apc.ActDecommissionCluster={"action": "decommission", "value": {"sid": "target_id", "skip_rebalance": false, "rm_user_data": true}}
 // +gen:payload apc.ActResetStats={"action": "reset-stats", "value": false}
 // Administrative cluster operations: configuration changes, node management, log rotation, shutdown/decommission operations.
@@ -552,7 +595,9 @@ func (p *proxy) cluputMsg(w http.ResponseWriter, r *http.Request) {
 		freeBcArgs(args)
 
 	case apc.ActXactStart:
-		p.xstart(w, r, msg)
+		if !p.admitXact(w, r, msg, "") {
+			p.xstart(w, r, msg)
+		}
 	case apc.ActXactStop:
 		p.xstop(w, r, msg)
 
@@ -572,9 +617,20 @@ func (p *proxy) cluputMsg(w http.ResponseWriter, r *http.Request) {
 		}
 		p.reloadCreds(w, r, msg)
 
+	case apc.ActSetNsQuota:
+		p.setNsQuota(w, r, msg)
+	case apc.ActAddJobSchedule:
+		p.addJobSchedule(w, r, msg)
+	case apc.ActRmJobSchedule:
+		p.rmJobSchedule(w, r, msg)
+	case apc.ActXactDequeue:
+		p.dequeueXact(w, r, msg)
+
 	// internal
 	case apc.ActBumpMetasync:
 		p.msyncForceAll(w, r, msg)
+	case apc.ActQuotaReport:
+		p.quotaReport(w, r, msg)
 
 	// fail
 	default:
@@ -1259,6 +1315,15 @@ func (p *proxy) actBackend(w http.ResponseWriter, r *http.Request, tag string, u
 	nlog.Infoln("done:", tag, provider)
 }
 
+func splitRemAisURLs(s string) (urls []string) {
+	for u := range strings.SplitSeq(s, ",") {
+		if u = strings.TrimSpace(u); u != "" && !slices.Contains(urls, u) {
+			urls = append(urls, u)
+		}
+	}
+	return urls
+}
+
 // the flow: attach/detach remais => modify cluster config => _remaisConf as the pre phase
 // of the transaction
 func (p *proxy) _remaisConf(ctx *configModifier, config *globalConfig) (bool, error) {
@@ -1289,20 +1354,29 @@ func (p *proxy) _remaisConf(ctx *configModifier, config *globalConfig) (bool, er
 		}
 	} else {
 		debug.Assert(action == apc.ActAttachRemAis)
-		u := ctx.hdr.Get(apc.HdrRemAisURL)
-		detail := fmt.Sprintf("remote cluster [alias %s => %v]", alias, u)
+		// one or more (comma-separated) URLs of the remote cluster's proxies
+		newURLs := splitRemAisURLs(ctx.hdr.Get(apc.HdrRemAisURL))
+		detail := fmt.Sprintf("remote cluster [alias %s => %v]", alias, newURLs)
+		if len(newURLs) == 0 {
+			return false, cmn.NewErrFailedTo(p, action, detail, errors.New("missing URL"))
+		}
 
 		// validation rules:
-		// rule #1: no two remote ais clusters can share the same alias (TODO: allow configuring multiple URLs per)
-		for a, urls := range aisConf {
-			if a != alias {
-				continue
-			}
-			errmsg := fmt.Sprintf("%s: %s is already attached", p.si, detail)
+		// rule #1: no two remote ais clusters can share the same alias;
+		// attaching an already attached alias adds URLs (to fail over to) -
+		// targets then make sure that all the URLs reference the same cluster
+		urls := aisConf[alias]
+		for _, u := range newURLs {
 			if !slices.Contains(urls, u) {
-				return false, errors.New(errmsg)
+				urls = append(urls, u)
+			}
+		}
+		if len(aisConf[alias]) > 0 {
+			if len(urls) == len(aisConf[alias]) {
+				nlog.Warningf("%s: %s is already attached - proceeding anyway", p.si, detail)
+			} else {
+				nlog.Infof("%s: %s is already attached - adding URL(s)", p.si, detail)
 			}
-			nlog.Warningln(errmsg + " - proceeding anyway")
 		}
 		// rule #2: aliases and UUIDs are two distinct non-overlapping sets
 		p.remais.mu.RLock()
@@ -1316,15 +1390,17 @@ func (p *proxy) _remaisConf(ctx *configModifier, config *globalConfig) (bool, er
 		}
 		p.remais.mu.RUnlock()
 
-		parsed, err := url.ParseRequestURI(u)
-		if err != nil {
-			return false, cmn.NewErrFailedTo(p, action, detail, err)
-		}
-		if parsed.Scheme != "http" && parsed.Scheme != "https" {
-			return false, cmn.NewErrFailedTo(p, action, detail, errors.New("invalid URL scheme"))
+		for _, u := range newURLs {
+			parsed, err := url.ParseRequestURI(u)
+			if err != nil {
+				return false, cmn.NewErrFailedTo(p, action, detail, err)
+			}
+			if parsed.Scheme != "http" && parsed.Scheme != "https" {
+				return false, cmn.NewErrFailedTo(p, action, detail, errors.New("invalid URL scheme"))
+			}
 		}
 		nlog.Infof("%s: %s %s", p, action, detail)
-		aisConf[alias] = []string{u}
+		aisConf[alias] = urls
 	}
 	config.Backend.Set(apc.AIS, aisConf)
 
@@ -504,6 +504,12 @@ func mustRebalance(ctx *smapModifier, cur *smapX) bool {
 		if !tsi.InMaintOrDecomm() && prev.GetActiveNode(tsi.ID()) == nil {
 			return true
 		}
+		// weighted HRW: changed weight; EC placement: changed failure domain
+		if osi := prev.GetTarget(tsi.ID()); osi != nil {
+			if osi.HrwWeight != tsi.HrwWeight || osi.FailureDomain != tsi.FailureDomain {
+				return true
+			}
+		}
 	}
 	for _, tsi := range prev.Tmap {
 		// removed an active one or deactivated previously active
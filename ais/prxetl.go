@@ -147,6 +147,10 @@ func (p *proxy) httpetlput(w http.ResponseWriter, r *http.Request) {
 		p.writeErr(w, r, err)
 		return
 	}
+	if err := etl.CheckLocal(); err != nil {
+		p.writeErr(w, r, err, http.StatusForbidden)
+		return
+	}
 	if err := initMsg.Validate(); err != nil {
 		p.writeErr(w, r, err)
 		return
@@ -541,9 +545,6 @@ func (p *proxy) stopETL(w http.ResponseWriter, r *http.Request, msg etl.InitMsg)
 }
 
 func (p *proxy) etlExists(etlName string) error {
-	if !k8s.IsK8s() {
-		return k8s.ErrK8sRequired
-	}
 	if err := k8s.ValidateEtlName(etlName); err != nil {
 		return err
 	}
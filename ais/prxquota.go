@@ -0,0 +1,211 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+)
+
+// Capacity and object-count quotas (see cmn/quota):
+// - namespace quotas are stored in the BMD (apc.ActSetNsQuota);
+// - targets periodically report local usage of the buckets that have quotas (apc.ActQuotaReport);
+// - primary aggregates the reports and responds with cluster-wide per-bucket
+//   and per-namespace usage (that targets then use to enforce hard quotas);
+// - primary also logs soft quota violations.
+
+// disregard reports from targets that stopped reporting
+const quotaReportAge = 3 * hk.QuotaIval
+
+type (
+	quotaReport struct {
+		bcks map[string]cmn.QuotaUsage // bucket uname => local usage
+		ts   int64                     // mono
+	}
+	pquota struct {
+		reports map[string]*quotaReport // target ID => the most recent report
+		soft    map[string]string       // bucket or namespace => soft quota exceeded (to log transitions)
+		mu      sync.Mutex
+	}
+)
+
+// PUT {action: set-ns-quota, name: namespace, value: cmn.QuotaConf}
+// (zero quota removes the namespace's quota)
+// +gen:payload apc.ActSetNsQuota={"action": "set-ns-quota", "name": "@#ns", "value": {"soft_size": "1TiB", "hard_size": "2TiB", "hard_objs": 1000000}}
+func (p *proxy) setNsQuota(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
+	ns := cmn.ParseNsUname(msg.Name)
+	if err := ns.Validate(); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	quota := &cmn.QuotaConf{}
+	if err := cos.MorphMarshal(msg.Value, quota); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return
+	}
+	if err := quota.ValidateAsProps(); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	msg.Name, msg.Value = ns.Uname(), quota
+	ctx := &bmdModifier{
+		pre:   bmodSetNsQuota,
+		final: p.bmodSync,
+		msg:   msg,
+		wait:  true,
+	}
+	if _, err := p.owner.bmd.modify(ctx); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	nlog.Infoln(msg.Action, "namespace", ns.Uname(), "[", quota.String(), "]")
+}
+
+func bmodSetNsQuota(ctx *bmdModifier, clone *bucketMD) error {
+	var (
+		uname = ctx.msg.Name
+		quota = ctx.msg.Value.(*cmn.QuotaConf)
+		prev  = clone.NsQuotas[uname]
+	)
+	switch {
+	case !quota.IsSet():
+		if prev == nil {
+			ctx.terminate = true // nothing to do
+			return nil
+		}
+		delete(clone.NsQuotas, uname)
+		if len(clone.NsQuotas) == 0 {
+			clone.NsQuotas = nil
+		}
+	case prev != nil && *prev == *quota:
+		ctx.terminate = true
+		return nil
+	default:
+		if clone.NsQuotas == nil {
+			clone.NsQuotas = make(map[string]*cmn.QuotaConf, 1)
+		}
+		clone.NsQuotas[uname] = quota
+	}
+	clone.Version++
+	return nil
+}
+
+// PUT {action: quota-report, value: cmn.QuotaStats} (target => primary)
+// responds with cluster-wide usage
+func (p *proxy) quotaReport(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
+	var (
+		tid  = r.Header.Get(apc.HdrSenderID)
+		smap = p.owner.smap.get()
+	)
+	if smap.GetTarget(tid) == nil {
+		p.writeErrf(w, r, "%s: unknown or invalid caller %q", msg.Action, tid)
+		return
+	}
+	in := &cmn.QuotaStats{}
+	if err := cos.MorphMarshal(msg.Value, in); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return
+	}
+	pq := &p.prim.quota
+	pq.mu.Lock()
+	if pq.reports == nil {
+		pq.reports = make(map[string]*quotaReport, smap.CountTargets())
+	}
+	pq.reports[tid] = &quotaReport{bcks: in.Bcks, ts: mono.NanoTime()}
+	out := pq.aggregate(smap)
+	pq.checkSoft(p.owner.bmd.get(), out)
+	pq.mu.Unlock()
+
+	p.writeJSON(w, r, out, msg.Action)
+}
+
+// cluster-wide usage as per the most recent target reports (primary only)
+func (p *proxy) quotaStats() *cmn.QuotaStats {
+	pq := &p.prim.quota
+	pq.mu.Lock()
+	out := pq.aggregate(p.owner.smap.get())
+	pq.mu.Unlock()
+	return out
+}
+
+// (for bucket summary) from the primary - locally or otherwise
+func (p *proxy) getQuotaStats() (*cmn.QuotaStats, error) {
+	return fromPrimary(p, apc.WhatQuota, p.quotaStats)
+}
+
+////////////
+// pquota //
+////////////
+
+// under lock
+func (pq *pquota) aggregate(smap *smapX) *cmn.QuotaStats {
+	var (
+		now = mono.NanoTime()
+		out = &cmn.QuotaStats{
+			Bcks: make(map[string]cmn.QuotaUsage, 8),
+			Nss:  make(map[string]cmn.QuotaUsage, 4),
+		}
+	)
+	for tid, report := range pq.reports {
+		if smap.GetTarget(tid) == nil || time.Duration(now-report.ts) > quotaReportAge {
+			delete(pq.reports, tid)
+			continue
+		}
+		for uname, u := range report.bcks {
+			bu := out.Bcks[uname]
+			bu.Add(u.Size, u.Objs)
+			out.Bcks[uname] = bu
+
+			b, _ := cmn.ParseUname(uname)
+			nsUname := b.Ns.Uname()
+			nu := out.Nss[nsUname]
+			nu.Add(u.Size, u.Objs)
+			out.Nss[nsUname] = nu
+		}
+	}
+	return out
+}
+
+// log soft quota violations (once per transition)
+func (pq *pquota) checkSoft(bmd *bucketMD, stats *cmn.QuotaStats) {
+	exceeded := make(map[string]string, 2)
+	for uname, used := range stats.Bcks {
+		b, _ := cmn.ParseUname(uname)
+		props, present := bmd.Get((*meta.Bck)(&b))
+		if !present {
+			continue
+		}
+		if s := props.Quota.SoftExceeded(&used); s != "" {
+			exceeded[b.Cname("")] = s
+		}
+	}
+	for uname, used := range stats.Nss {
+		if quota := bmd.NsQuotas[uname]; quota != nil {
+			if s := quota.SoftExceeded(&used); s != "" {
+				exceeded["namespace "+uname] = s
+			}
+		}
+	}
+	for name, s := range exceeded {
+		if _, ok := pq.soft[name]; !ok {
+			nlog.Warningln(name, "exceeds", s, "quota")
+		}
+	}
+	for name := range pq.soft {
+		if _, ok := exceeded[name]; !ok {
+			nlog.Infoln(name, "is now within soft quota")
+		}
+	}
+	pq.soft = exceeded
+}
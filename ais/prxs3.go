@@ -47,6 +47,10 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		return
 	}
+	// CORS: preflight (OPTIONS) and cross-origin requests
+	if len(apiItems) > 0 && s3.HandleCORS(w, r, apiItems[0], p.owner.bmd) {
+		return
+	}
 
 	switch r.Method {
 	case http.MethodHead:
@@ -81,8 +85,43 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 			_, policy    = q[s3.QparamPolicy]
 			_, cors      = q[s3.QparamCORS]
 			_, acl       = q[s3.QparamACL]
+			_, tagging   = q[s3.QparamTagging]
+			_, objLock   = q[s3.QparamObjectLock]
+			_, retention = q[s3.QparamRetention]
+			_, legalHold = q[s3.QparamLegalHold]
 		)
-		if lifecycle || policy || cors || acl {
+		if len(apiItems) == 1 {
+			switch {
+			case lifecycle:
+				p.getBckLifecycleS3(w, r, apiItems[0])
+				return
+			case policy:
+				p.getBckPolicyS3(w, r, apiItems[0])
+				return
+			case acl:
+				p.getBckACLS3(w, r, apiItems[0])
+				return
+			case cors:
+				p.getBckCORSS3(w, r, apiItems[0])
+				return
+			case objLock:
+				p.getBckObjLockS3(w, r, apiItems[0])
+				return
+			}
+		}
+		if len(apiItems) > 1 {
+			switch {
+			case tagging:
+				// perms: apc.AceObjHEAD
+				p.tagsObjS3(w, r, apiItems)
+				return
+			case retention || legalHold:
+				// perms: apc.AceObjHEAD
+				p.objLockS3(w, r, apiItems)
+				return
+			}
+		}
+		if lifecycle || policy || cors || acl || tagging || objLock || retention || legalHold {
 			p.unsupported(w, r, apiItems[0])
 			return
 		}
@@ -93,6 +132,11 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 				p.getBckVersioningS3(w, r, apiItems[0])
 				return
 			}
+			if q.Has(s3.QparamVersions) {
+				// perms: apc.AceObjLIST
+				p.listObjectVersionsS3(w, r, apiItems[0], q)
+				return
+			}
 			// perms: apc.AceObjLIST
 			p.listObjectsS3(w, r, apiItems[0], q)
 			return
@@ -112,12 +156,49 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 				p.putBckVersioningS3(w, r, apiItems[0])
 				return
 			}
+			if _, lifecycle := q[s3.QparamLifecycle]; lifecycle {
+				p.putBckLifecycleS3(w, r, apiItems[0])
+				return
+			}
+			if _, policy := q[s3.QparamPolicy]; policy {
+				// perms: apc.AceBckSetACL
+				p.putBckPolicyS3(w, r, apiItems[0])
+				return
+			}
+			if _, acl := q[s3.QparamACL]; acl {
+				// perms: apc.AceBckSetACL
+				p.putBckACLS3(w, r, apiItems[0])
+				return
+			}
+			if _, cors := q[s3.QparamCORS]; cors {
+				p.putBckCORSS3(w, r, apiItems[0])
+				return
+			}
+			if _, objLock := q[s3.QparamObjectLock]; objLock {
+				// perms: apc.AceBckSetACL
+				p.putBckObjLockS3(w, r, apiItems[0])
+				return
+			}
+			if q.Has(s3.QparamTagging) || q.Has(s3.QparamRetention) || q.Has(s3.QparamLegalHold) {
+				p.unsupported(w, r, apiItems[0])
+				return
+			}
 			// perms: apc.AceCreateBucket
 			p.putBckS3(w, r, apiItems[0])
 			return
 		}
-		// perms: apc.AcePUT
-		p.putObjS3(w, r, apiItems)
+		q := r.URL.Query()
+		switch {
+		case q.Has(s3.QparamTagging):
+			// perms: apc.AcePUT
+			p.tagsObjS3(w, r, apiItems)
+		case q.Has(s3.QparamRetention) || q.Has(s3.QparamLegalHold):
+			// perms: apc.AcePUT (and apc.AceBckSetACL to bypass governance retention)
+			p.objLockS3(w, r, apiItems)
+		default:
+			// perms: apc.AcePUT
+			p.putObjS3(w, r, apiItems)
+		}
 	case http.MethodPost:
 		q := r.URL.Query()
 		if q.Has(s3.QparamMptUploadID) || q.Has(s3.QparamMptUploads) {
@@ -146,10 +227,32 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 				p.delMultipleObjs(w, r, apiItems[0])
 				return
 			}
+			if _, lifecycle := q[s3.QparamLifecycle]; lifecycle {
+				p.delBckLifecycleS3(w, r, apiItems[0])
+				return
+			}
+			if _, policy := q[s3.QparamPolicy]; policy {
+				// perms: apc.AceBckSetACL
+				p.delBckPolicyS3(w, r, apiItems[0])
+				return
+			}
+			if _, cors := q[s3.QparamCORS]; cors {
+				p.delBckCORSS3(w, r, apiItems[0])
+				return
+			}
+			if _, tagging := q[s3.QparamTagging]; tagging {
+				p.unsupported(w, r, apiItems[0])
+				return
+			}
 			// perms: apc.AceDestroyBucket
 			p.delBckS3(w, r, apiItems[0])
 			return
 		}
+		if r.URL.Query().Has(s3.QparamTagging) {
+			// perms: apc.AcePUT
+			p.tagsObjS3(w, r, apiItems)
+			return
+		}
 		// perms: apc.AceObjDELETE
 		p.delObjS3(w, r, apiItems)
 	default:
@@ -271,10 +374,6 @@ func (p *proxy) delMultipleObjs(w http.ResponseWriter, r *http.Request, bucket s
 	if bck == nil {
 		return
 	}
-	if err := p.access(r, bck, apc.AceObjDELETE); err != nil {
-		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
-		return
-	}
 	decoder := xml.NewDecoder(r.Body)
 	lst := &s3.Delete{}
 	if err := decoder.Decode(lst); err != nil {
@@ -297,6 +396,10 @@ func (p *proxy) delMultipleObjs(w http.ResponseWriter, r *http.Request, bucket s
 		}
 		objNames = append(objNames, obj.Key)
 	}
+	if err := p.accessScope(r, bck, apc.AceObjDELETE, &cmn.PolicyScope{Names: objNames}); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
+		return
+	}
 
 	evdMsg.ObjNames = objNames
 	msg.Value = evdMsg
@@ -357,14 +460,15 @@ func (p *proxy) headBckS3(w http.ResponseWriter, r *http.Request, bucket string)
 	w.Header().Set(cos.S3HdrBckRegion, s3.AISRegion)
 }
 
-// +gen:endpoint GET /s3/{bucket-name} [s3.QparamMaxKeys=string,s3.QparamPrefix=string,s3.QparamContinuationToken=string,s3.QparamStartAfter=string,s3.QparamDelimiter=string]
-// List objects in an S3 bucket
+// +gen:endpoint GET /s3/{bucket-name} [s3.QparamMaxKeys=string,s3.QparamPrefix=string,s3.QparamContinuationToken=string,s3.QparamStartAfter=string,s3.QparamDelimiter=string,s3.QparamMarker=string,s3.QparamListType=string,s3.QparamEncodingType=string]
+// List objects in an S3 bucket (ListObjects V1 and V2)
 func (p *proxy) listObjectsS3(w http.ResponseWriter, r *http.Request, bucket string, q url.Values) {
 	bck := p.initByNameOnly(w, r, bucket)
 	if bck == nil {
 		return
 	}
-	if err := p.access(r, bck, apc.AceObjLIST); err != nil {
+	scope := &cmn.PolicyScope{Prefix: q.Get(s3.QparamPrefix), Recursive: q.Get(s3.QparamDelimiter) == ""}
+	if err := p.accessScope(r, bck, apc.AceObjLIST, scope); err != nil {
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
 		return
 	}
@@ -384,30 +488,34 @@ func (p *proxy) listObjectsS3(w http.ResponseWriter, r *http.Request, bucket str
 	lsmsg.AddProps(apc.GetPropsSize, apc.GetPropsChecksum, apc.GetPropsAtime, apc.GetPropsCustom)
 	amsg.Value = lsmsg
 
-	// as per API_ListObjectsV2.html, optional:
+	// as per API_ListObjectsV2.html and API_ListObjects.html, optional:
 	// - "max-keys"
 	// - "prefix"
-	// - "start-after"
-	// - "delimiter" (TODO: limited support: no recursion)
-	// - "continuation-token" (NOTE: base64 encoded, as in: base64.StdEncoding.DecodeString(token)
+	// - "start-after" (V2) or "marker" (V1)
+	// - "delimiter" (any; '/' with a directory-like prefix is natively non-recursive,
+	//   otherwise common prefixes are rolled up from the recursive listing)
+	// - "encoding-type" (url)
+	// - "continuation-token" (V2; opaque - see s3.LsoQuery)
 	// TODO:
 	// - "fetch-owner"
-	// - "encoding-type"
-	s3.FillLsoMsg(q, lsmsg)
+	lsq, err := s3.NewLsoQuery(q, lsmsg)
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: s3.ErrCodeInvalidArgument})
+		return
+	}
 
 	// via NBI
 	if err := _setupNBI(r.Header, lsmsg); err != nil {
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
 		return
 	}
-
-	lst, err := p.lsAllPagesS3(bck, amsg, lsmsg, r.Header)
-	if cmn.Rom.V(5, cos.ModS3) {
-		nlog.Infoln("lsoS3", bck.Cname(""), len(lst.Entries), err)
-	}
-	if err != nil {
-		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
-		return
+	// skip (what's going to be skipped anyway) at the source, when supported;
+	// otherwise, resume from the native page (V2 continuation token), if any
+	// - remote buckets do not support `lsmsg.StartAfter` (see lsPage), and so V1 marker
+	//   (or V2 start-after) implies listing from the beginning and skipping on the fly
+	if bck.IsAIS() && !lsmsg.IsFlagSet(apc.LsNBI) {
+		lsmsg.StartAfter = lsq.After()
+		lsmsg.ContinuationToken = ""
 	}
 
 	// NOTE:
@@ -418,19 +526,64 @@ func (p *proxy) listObjectsS3(w http.ResponseWriter, r *http.Request, bucket str
 	// - the implication: if, when working with very large remote datasets, list-objects performance
 	//   becomes an issue - consider using native API.
 
-	resp := s3.NewListObjectResult(bucket)
-	resp.ContinuationToken = lsmsg.ContinuationToken
-	resp.FromLsoResult(lst)
+	resp := s3.NewListObjectResult(bucket, lsq)
+	if lsq.V2 {
+		resp.ContinuationToken = q.Get(s3.QparamContinuationToken)
+	}
+	if err := p.lsPagesS3(bck, amsg, lsmsg, r.Header, resp, lsq); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+		return
+	}
+	resp.Fini(lsq)
+
 	sgl := p.gmm.NewSGL(0)
 	resp.MustMarshal(sgl)
 	w.Header().Set(cos.HdrContentType, cos.ContentXML)
 	sgl.WriteTo2(w)
 	sgl.Free()
+}
+
+// +gen:endpoint GET /s3/{bucket-name} [s3.QparamVersions=string,s3.QparamMaxKeys=string,s3.QparamPrefix=string,s3.QparamKeyMarker=string]
+// List object versions and delete markers (ais:// buckets with version history)
+func (p *proxy) listObjectVersionsS3(w http.ResponseWriter, r *http.Request, bucket string, q url.Values) {
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	scope := &cmn.PolicyScope{Prefix: q.Get(s3.QparamPrefix), Recursive: true}
+	if err := p.accessScope(r, bck, apc.AceObjLIST, scope); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
+		return
+	}
+	if !bck.IsAIS() {
+		err := fmt.Errorf("listing object versions is only supported for ais:// buckets (have %s)", bck.Cname(""))
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: s3.ErrCodeInvalidRequest})
+		return
+	}
+	amsg := &apc.ActMsg{Action: apc.ActList}
+	if p.forwardCP(w, r, amsg, lsotag+" "+bck.String()) {
+		return
+	}
+
+	lsmsg := &apc.LsoMsg{TimeFormat: time.RFC3339, Flags: apc.LsIsS3 | apc.LsVersions}
+	lsmsg.AddProps(apc.GetPropsSize, apc.GetPropsChecksum, apc.GetPropsAtime, apc.GetPropsCustom, apc.GetPropsVersion)
+	s3.FillLsoMsg(q, lsmsg)
+	lsmsg.StartAfter = q.Get(s3.QparamKeyMarker)
+	amsg.Value = lsmsg
 
-	// GC
-	clear(lst.Entries)
-	lst.Entries = lst.Entries[:0]
-	lst.Entries = nil
+	lst, err := p.lsAllPagesS3(bck, amsg, lsmsg, r.Header)
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+		return
+	}
+	resp := s3.NewListVersionsResult(bucket)
+	resp.Prefix, resp.KeyMarker = lsmsg.Prefix, q.Get(s3.QparamKeyMarker)
+	resp.FromLsoResult(lst)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
 }
 
 func _setupNBI(hdr http.Header, lsmsg *apc.LsoMsg) error {
@@ -459,6 +612,40 @@ func _setupNBI(hdr http.Header, lsmsg *apc.LsoMsg) error {
 	return nil
 }
 
+// list native pages until the result is full (max-keys) or there's nothing left to list
+func (p *proxy) lsPagesS3(bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg, hdr http.Header,
+	resp *s3.ListObjectResult, lsq *s3.LsoQuery) error {
+	var (
+		smap = p.owner.smap.get()
+		cnt  int
+	)
+	for {
+		beg := mono.NanoTime()
+		native := lsmsg.ContinuationToken
+		page, err := p.lsPage(bck, amsg, lsmsg, hdr, smap)
+		if err != nil {
+			return err
+		}
+
+		vlabs := map[string]string{stats.VlabBucket: bck.Cname("")}
+		p.statsT.IncWith(stats.ListCount, vlabs)
+		p.statsT.AddWith(
+			cos.NamedVal64{Name: stats.ListLatency, Value: mono.SinceNano(beg), VarLabs: vlabs},
+		)
+		cnt += len(page.Entries)
+		full := resp.Add(page.Entries, lsq, native)
+		if cmn.Rom.V(5, cos.ModS3) {
+			nlog.Infoln("lsoS3", bck.Cname(""), cnt, full)
+		}
+		if full || page.ContinuationToken == "" {
+			return nil
+		}
+		lsmsg.UUID = page.UUID
+		lsmsg.ContinuationToken = page.ContinuationToken
+		amsg.Value = lsmsg
+	}
+}
+
 func (p *proxy) lsAllPagesS3(bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg, hdr http.Header) (lst *cmn.LsoRes, _ error) {
 	smap := p.owner.smap.get()
 	for pageNum := 1; ; pageNum++ {
@@ -730,7 +917,12 @@ func (p *proxy) delObjS3(w http.ResponseWriter, r *http.Request, items []string)
 	if bck == nil {
 		return
 	}
-	if err := p.access(r, bck, apc.AceObjDELETE); err != nil {
+	perms := apc.AceObjDELETE
+	if cos.IsParseBool(r.Header.Get(s3.HdrBypassGovernance)) {
+		// object lock: bypassing governance retention
+		perms |= apc.AceBckSetACL
+	}
+	if err := p.access(r, bck, perms); err != nil {
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
 		return
 	}
@@ -754,6 +946,69 @@ func (p *proxy) delObjS3(w http.ResponseWriter, r *http.Request, items []string)
 	p.s3Redirect(w, r, tsi, smap, redurl, bck.Name)
 }
 
+// +gen:endpoint GET /s3/{bucket-name}/{object-name} [s3.QparamTagging=string]
+// +gen:endpoint PUT /s3/{bucket-name}/{object-name} [s3.QparamTagging=string] payload=s3-tagging
+// +gen:endpoint DELETE /s3/{bucket-name}/{object-name} [s3.QparamTagging=string]
+// +gen:payload s3-tagging=<Tagging><TagSet><Tag><Key>class</Key><Value>pii</Value></Tag></TagSet></Tagging>
+// Get, replace, or remove S3 object tags
+func (p *proxy) tagsObjS3(w http.ResponseWriter, r *http.Request, items []string) {
+	bck := p.initByNameOnly(w, r, items[0] /*bucket*/)
+	if bck == nil {
+		return
+	}
+	perms := apc.AcePUT
+	if r.Method == http.MethodGet {
+		perms = apc.AceObjHEAD
+	}
+	p._redirectObjS3(w, r, bck, items, perms, s3.QparamTagging)
+}
+
+// +gen:endpoint GET /s3/{bucket-name}/{object-name} [s3.QparamRetention=string]
+// +gen:endpoint PUT /s3/{bucket-name}/{object-name} [s3.QparamRetention=string] payload=s3-retention
+// +gen:endpoint GET /s3/{bucket-name}/{object-name} [s3.QparamLegalHold=string]
+// +gen:endpoint PUT /s3/{bucket-name}/{object-name} [s3.QparamLegalHold=string] payload=s3-legal-hold
+// +gen:payload s3-retention=<Retention><Mode>GOVERNANCE</Mode><RetainUntilDate>2030-01-01T00:00:00Z</RetainUntilDate></Retention>
+// +gen:payload s3-legal-hold=<LegalHold><Status>ON</Status></LegalHold>
+// Get or set S3 object retention and legal hold (object lock)
+func (p *proxy) objLockS3(w http.ResponseWriter, r *http.Request, items []string) {
+	bck := p.initByNameOnly(w, r, items[0] /*bucket*/)
+	if bck == nil {
+		return
+	}
+	perms := apc.AcePUT
+	switch {
+	case r.Method == http.MethodGet:
+		perms = apc.AceObjHEAD
+	case cos.IsParseBool(r.Header.Get(s3.HdrBypassGovernance)):
+		perms |= apc.AceBckSetACL
+	}
+	p._redirectObjS3(w, r, bck, items, perms, "object-lock")
+}
+
+func (p *proxy) _redirectObjS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, items []string, perms apc.AccessAttrs, tag string) {
+	if err := p.access(r, bck, perms); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
+		return
+	}
+	objName, errN := s3.JoinValidateOname(w, r, items)
+	if errN != nil {
+		return
+	}
+
+	smap := p.owner.smap.get()
+	tsi, err := smap.HrwName2T(bck.MakeUname(objName))
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+		return
+	}
+	if cmn.Rom.V(5, cos.ModS3) {
+		nlog.Infoln(r.Method, tag, bck.Cname(objName), "=>", tsi.StringEx())
+	}
+	// signed redirect (target /s3 on pub and intra-data)
+	redurl := p.redurl(r, tsi, smap.Version, cmn.NetIntraData, "")
+	p.s3Redirect(w, r, tsi, smap, redurl, bck.Name)
+}
+
 // +gen:endpoint GET /s3/{bucket-name} [s3.QparamVersioning=string]
 // Get S3 bucket versioning configuration
 func (p *proxy) getBckVersioningS3(w http.ResponseWriter, r *http.Request, bucket string) {
@@ -769,7 +1024,370 @@ func (p *proxy) getBckVersioningS3(w http.ResponseWriter, r *http.Request, bucke
 	sgl.Free()
 }
 
-// GET /s3/<bucket-name>?lifecycle|cors|policy|acl
+// +gen:endpoint GET /s3/{bucket-name} [s3.QparamLifecycle=string]
+// Get S3 bucket lifecycle configuration
+func (p *proxy) getBckLifecycleS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
+		return
+	}
+	lcy := &bck.Props.Lifecycle
+	if len(lcy.Rules) == 0 {
+		err := fmt.Errorf("bucket %s has no lifecycle configuration", bck.Cname(""))
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusNotFound, Code: s3.NoSuchLifecycleConfiguration})
+		return
+	}
+	resp := s3.NewLifecycleConfiguration(lcy)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// +gen:endpoint PUT /s3/{bucket-name} [s3.QparamLifecycle=string] payload=s3-lifecycle
+// +gen:payload s3-lifecycle=<LifecycleConfiguration><Rule><ID>expire-tmp</ID><Filter><Prefix>tmp/</Prefix></Filter><Status>Enabled</Status><Expiration><Days>7</Days></Expiration></Rule></LifecycleConfiguration>
+// Configure S3 bucket lifecycle rules (replaces existing rules, if any)
+func (p *proxy) putBckLifecycleS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	lconf := &s3.LifecycleConfiguration{}
+	if err := xml.NewDecoder(r.Body).Decode(lconf); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: s3.ErrCodeMalformedXML})
+		return
+	}
+	rules, err := lconf.ToRules()
+	if err != nil {
+		if s3.IsErrLcyNotImpl(err) {
+			s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusNotImplemented, Code: s3.ErrCodeNotImplemented})
+		} else {
+			s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: s3.ErrCodeInvalidArgument})
+		}
+		return
+	}
+	p._setBckLifecycleS3(w, r, bucket, rules)
+}
+
+// +gen:endpoint DELETE /s3/{bucket-name} [s3.QparamLifecycle=string]
+// Remove S3 bucket lifecycle configuration
+func (p *proxy) delBckLifecycleS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	if p._setBckLifecycleS3(w, r, bucket, []cmn.LifecycleRule{}) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (p *proxy) _setBckLifecycleS3(w http.ResponseWriter, r *http.Request, bucket string, rules []cmn.LifecycleRule) bool {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return false
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return false
+	}
+	if err := p.access(r, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
+		return false
+	}
+	enabled := len(rules) > 0
+	propsToUpdate := cmn.BpropsToSet{
+		Lifecycle: &cmn.LifecycleConfToSet{Rules: &rules, Enabled: &enabled},
+	}
+	// make and validate new props
+	nprops, err := p.makeNewBckProps(bck, &propsToUpdate)
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: s3.ErrCodeInvalidArgument})
+		return false
+	}
+	if _, err := p.setBprops(msg, bck, nprops, "" /*rechunk*/); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+		return false
+	}
+	return true
+}
+
+// +gen:endpoint GET /s3/{bucket-name} [s3.QparamPolicy=string]
+// Get S3 bucket policy
+func (p *proxy) getBckPolicyS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
+		return
+	}
+	policy := &bck.Props.Policy
+	if !policy.IsActive() {
+		err := fmt.Errorf("bucket %s has no policy", bck.Cname(""))
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusNotFound, Code: s3.NoSuchBucketPolicy})
+		return
+	}
+	resp := s3.NewBucketPolicy(bucket, policy)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentJSON)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// +gen:endpoint PUT /s3/{bucket-name} [s3.QparamPolicy=string] payload=s3-policy
+// +gen:payload s3-policy={"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/public/*"}]}
+// Set S3 bucket policy (replaces existing policy, if any)
+func (p *proxy) putBckPolicyS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bpolicy := &s3.BucketPolicy{}
+	if err := jsoniter.NewDecoder(r.Body).Decode(bpolicy); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: s3.ErrCodeMalformedPolicy})
+		return
+	}
+	stmts, err := bpolicy.ToStatements(bucket)
+	if err != nil {
+		if s3.IsErrPolicyNotImpl(err) {
+			s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusNotImplemented, Code: s3.ErrCodeNotImplemented})
+		} else {
+			s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: s3.ErrCodeMalformedPolicy})
+		}
+		return
+	}
+	if len(stmts) == 0 {
+		err := errors.New("bucket policy: no statements")
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: s3.ErrCodeMalformedPolicy})
+		return
+	}
+	// preserve canned ACL, if any
+	upd := func(cur []cmn.PolicyStatement) []cmn.PolicyStatement {
+		if s3.CannedACL(stmts) != s3.ACLPrivate {
+			return stmts
+		}
+		return s3.ApplyCannedACL(stmts, s3.CannedACL(cur))
+	}
+	if p._setBckPolicyS3(w, r, bucket, upd) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// +gen:endpoint DELETE /s3/{bucket-name} [s3.QparamPolicy=string]
+// Remove S3 bucket policy (canned ACL, if any, remains in effect)
+func (p *proxy) delBckPolicyS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	upd := func(stmts []cmn.PolicyStatement) []cmn.PolicyStatement {
+		return s3.ApplyCannedACL([]cmn.PolicyStatement{}, s3.CannedACL(stmts))
+	}
+	if p._setBckPolicyS3(w, r, bucket, upd) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// +gen:endpoint GET /s3/{bucket-name} [s3.QparamACL=string]
+// Get S3 bucket ACL (as implied by the canned ACL in effect)
+func (p *proxy) getBckACLS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
+		return
+	}
+	resp := s3.NewAccessControlPolicy(bck.Props.Policy.Statements)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// +gen:endpoint PUT /s3/{bucket-name} [s3.QparamACL=string]
+// Set S3 bucket canned ACL (x-amz-acl header); explicit grants are not supported
+func (p *proxy) putBckACLS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	acl := r.Header.Get(s3.HdrACL)
+	if acl == "" {
+		err := fmt.Errorf("bucket ACL: explicit grants are not supported (use %q header)", s3.HdrACL)
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusNotImplemented, Code: s3.ErrCodeNotImplemented})
+		return
+	}
+	if !s3.IsCannedACL(acl) {
+		err := fmt.Errorf("bucket ACL: invalid or unsupported canned ACL %q", acl)
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: s3.ErrCodeInvalidArgument})
+		return
+	}
+	upd := func(stmts []cmn.PolicyStatement) []cmn.PolicyStatement { return s3.ApplyCannedACL(stmts, acl) }
+	p._setBckPolicyS3(w, r, bucket, upd)
+}
+
+func (p *proxy) _setBckPolicyS3(w http.ResponseWriter, r *http.Request, bucket string, upd func([]cmn.PolicyStatement) []cmn.PolicyStatement) bool {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return false
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return false
+	}
+	if err := p.access(r, bck, apc.AceBckSetACL); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
+		return false
+	}
+	stmts := upd(bck.Props.Policy.Statements)
+	propsToUpdate := cmn.BpropsToSet{
+		Policy: &cmn.PolicyConfToSet{Statements: &stmts},
+	}
+	// make and validate new props
+	nprops, err := p.makeNewBckProps(bck, &propsToUpdate)
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: s3.ErrCodeMalformedPolicy})
+		return false
+	}
+	if _, err := p.setBprops(msg, bck, nprops, "" /*rechunk*/); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+		return false
+	}
+	return true
+}
+
+// +gen:endpoint GET /s3/{bucket-name} [s3.QparamCORS=string]
+// Get S3 bucket CORS configuration
+func (p *proxy) getBckCORSS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
+		return
+	}
+	cors := &bck.Props.CORS
+	if !cors.IsActive() {
+		err := fmt.Errorf("bucket %s has no CORS configuration", bck.Cname(""))
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusNotFound, Code: s3.NoSuchCORSConfiguration})
+		return
+	}
+	resp := s3.NewCORSConfiguration(cors)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// +gen:endpoint PUT /s3/{bucket-name} [s3.QparamCORS=string] payload=s3-cors
+// +gen:payload s3-cors=<CORSConfiguration><CORSRule><AllowedOrigin>https://app.example.com</AllowedOrigin><AllowedMethod>GET</AllowedMethod><AllowedMethod>PUT</AllowedMethod><AllowedHeader>*</AllowedHeader></CORSRule></CORSConfiguration>
+// Configure S3 bucket CORS rules (replaces existing rules, if any)
+func (p *proxy) putBckCORSS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	cconf := &s3.CORSConfiguration{}
+	if err := xml.NewDecoder(r.Body).Decode(cconf); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: s3.ErrCodeMalformedXML})
+		return
+	}
+	rules := cconf.ToRules()
+	if len(rules) == 0 {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: errors.New("CORS configuration: no rules"), Code: s3.ErrCodeMalformedXML})
+		return
+	}
+	p._setBckCORSS3(w, r, bucket, rules)
+}
+
+// +gen:endpoint DELETE /s3/{bucket-name} [s3.QparamCORS=string]
+// Remove S3 bucket CORS configuration
+func (p *proxy) delBckCORSS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	if p._setBckCORSS3(w, r, bucket, []cmn.CORSRule{}) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (p *proxy) _setBckCORSS3(w http.ResponseWriter, r *http.Request, bucket string, rules []cmn.CORSRule) bool {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return false
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return false
+	}
+	if err := p.access(r, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
+		return false
+	}
+	propsToUpdate := cmn.BpropsToSet{
+		CORS: &cmn.CORSConfToSet{Rules: &rules},
+	}
+	// make and validate new props
+	nprops, err := p.makeNewBckProps(bck, &propsToUpdate)
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: s3.ErrCodeInvalidArgument})
+		return false
+	}
+	if _, err := p.setBprops(msg, bck, nprops, "" /*rechunk*/); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+		return false
+	}
+	return true
+}
+
+// +gen:endpoint GET /s3/{bucket-name} [s3.QparamObjectLock=string]
+// Get S3 bucket object lock configuration
+func (p *proxy) getBckObjLockS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
+		return
+	}
+	conf := &bck.Props.ObjectLock
+	if !conf.Enabled {
+		err := fmt.Errorf("object lock configuration does not exist for bucket %s", bck.Cname(""))
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusNotFound, Code: s3.ObjectLockConfigurationNotFound})
+		return
+	}
+	resp := s3.NewObjectLockConfiguration(conf)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// +gen:endpoint PUT /s3/{bucket-name} [s3.QparamObjectLock=string] payload=s3-object-lock
+// +gen:payload s3-object-lock=<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled><Rule><DefaultRetention><Mode>GOVERNANCE</Mode><Days>30</Days></DefaultRetention></Rule></ObjectLockConfiguration>
+// Enable S3 bucket object lock and/or configure default retention (object lock cannot be disabled)
+func (p *proxy) putBckObjLockS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	lconf := &s3.ObjectLockConfiguration{}
+	if err := xml.NewDecoder(r.Body).Decode(lconf); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: s3.ErrCodeMalformedXML})
+		return
+	}
+	toSet, err := lconf.ToConf()
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: s3.ErrCodeMalformedXML})
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r, bck, apc.AceBckSetACL); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden})
+		return
+	}
+	// make and validate new props
+	nprops, err := p.makeNewBckProps(bck, &cmn.BpropsToSet{ObjectLock: toSet})
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: s3.ErrCodeInvalidArgument})
+		return
+	}
+	if _, err := p.setBprops(msg, bck, nprops, "" /*rechunk*/); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+	}
+}
+
+// GET /s3/<bucket-name>?tagging, and ?lifecycle|policy|acl|cors on objects
 func (p *proxy) unsupported(w http.ResponseWriter, r *http.Request, bucket string) {
 	if _, ecode, err := meta.InitByNameOnly(bucket, p.owner.bmd); err != nil {
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: ecode})
@@ -811,7 +1429,7 @@ func (p *proxy) putBckVersioningS3(w http.ResponseWriter, r *http.Request, bucke
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
 		return
 	}
-	if _, err := p.setBprops(msg, bck, nprops); err != nil {
+	if _, err := p.setBprops(msg, bck, nprops, "" /*rechunk*/); err != nil {
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
 	}
 }
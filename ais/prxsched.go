@@ -0,0 +1,418 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+// Recurring jobs (see cmn/schedule):
+// - schedules are stored in the BMD (apc.ActAddJobSchedule, apc.ActRmJobSchedule);
+// - the primary evaluates them once a minute (schedHK) and executes due jobs
+//   in-process, via its own public API handlers (so that all the regular validation applies);
+// - scheduled requests are not subject to token validation: adding a schedule requires admin access;
+// - run history and overlap state are kept in memory (and don't survive primary change).
+
+const maxJobResp = 4 * cos.KiB
+
+type (
+	schedState struct {
+		xid         string       // most recent run's xaction ID
+		runs        []cmn.JobRun // most recent last
+		pending     bool         // overlap=queue: due while the previous run was still running
+		dispatching bool
+	}
+	psched struct {
+		states map[string]*schedState // schedule name => state
+		last   time.Time              // most recent evaluation
+		mu     sync.Mutex
+	}
+
+	// (in-process execution of a scheduled or queued request)
+	jobWriter struct {
+		hdr      http.Header
+		body     bytes.Buffer
+		status   int
+		passthru bool // keep the entire response (see admitXact)
+	}
+
+	ctxSchedJob struct{}
+)
+
+// identifies scheduled and queued (in-process) requests - see accessPrefix
+var keySchedJob ctxSchedJob
+
+func isSchedJob(r *http.Request) bool {
+	_, ok := r.Context().Value(keySchedJob).(string)
+	return ok
+}
+
+// PUT {action: add-job-schedule, value: cmn.JobSchedule}
+// +gen:payload apc.ActAddJobSchedule={"action": "add-job-schedule", "value": {"name": "nightly-lru", "cron": "0 2 * * *", "msg": {"action": "start", "value": {"kind": "lru"}}}}
+func (p *proxy) addJobSchedule(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
+	sched := &cmn.JobSchedule{}
+	if err := cos.MorphMarshal(msg.Value, sched); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return
+	}
+	if sched.Name == "" {
+		sched.Name = msg.Name
+	}
+	if err := p.validateJobSchedule(sched); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	msg.Name, msg.Value = sched.Name, sched
+	ctx := &bmdModifier{
+		pre:   p.bmodAddJobSchedule,
+		final: p.bmodSync,
+		msg:   msg,
+		wait:  true,
+	}
+	if _, err := p.owner.bmd.modify(ctx); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	nlog.Infoln(msg.Action, sched.String())
+}
+
+func (p *proxy) validateJobSchedule(sched *cmn.JobSchedule) error {
+	if err := sched.Validate(); err != nil {
+		return err
+	}
+	if sched.IsBckAction() {
+		for _, bck := range []*cmn.Bck{sched.Bck, sched.BckTo} {
+			if bck == nil {
+				continue
+			}
+			normp, err := cmn.NormalizeProvider(bck.Provider)
+			if err != nil {
+				return err
+			}
+			bck.Provider = normp
+		}
+		return nil
+	}
+	var xargs xact.ArgsMsg
+	if sched.Msg.Value != nil {
+		if err := cos.MorphMarshal(sched.Msg.Value, &xargs); err != nil {
+			return fmt.Errorf(cmn.FmtErrMorphUnmarshal, p.si, sched.Msg.Action, sched.Msg.Value, err)
+		}
+	}
+	kind, dtor, err := xact.GetDescriptor(xargs.Kind)
+	if err != nil {
+		return fmt.Errorf("job schedule %q: %w", sched.Name, err)
+	}
+	if !dtor.Startable {
+		return fmt.Errorf("job schedule %q: %q cannot be started", sched.Name, kind)
+	}
+	if xargs.ID != "" {
+		return fmt.Errorf("job schedule %q: xaction ID must be empty (got %q)", sched.Name, xargs.ID)
+	}
+	xargs.Kind = kind
+	sched.Msg.Value = xargs
+	return nil
+}
+
+func (p *proxy) bmodAddJobSchedule(ctx *bmdModifier, clone *bucketMD) error {
+	sched := ctx.msg.Value.(*cmn.JobSchedule)
+	if _, ok := clone.Schedules[sched.Name]; ok {
+		return cos.NewErrAlreadyExists(p, "job schedule "+sched.Name)
+	}
+	if clone.Schedules == nil {
+		clone.Schedules = make(map[string]*cmn.JobSchedule, 1)
+	}
+	clone.Schedules[sched.Name] = sched
+	clone.Version++
+	return nil
+}
+
+// PUT {action: rm-job-schedule, name: schedule name}
+// +gen:payload apc.ActRmJobSchedule={"action": "rm-job-schedule", "name": "nightly-lru"}
+func (p *proxy) rmJobSchedule(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
+	if msg.Name == "" {
+		p.writeErrf(w, r, "%s: missing job schedule name", msg.Action)
+		return
+	}
+	ctx := &bmdModifier{
+		pre:   p.bmodRmJobSchedule,
+		final: p.bmodSync,
+		msg:   msg,
+		wait:  true,
+	}
+	if _, err := p.owner.bmd.modify(ctx); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	nlog.Infoln(msg.Action, msg.Name)
+}
+
+func (p *proxy) bmodRmJobSchedule(ctx *bmdModifier, clone *bucketMD) error {
+	name := ctx.msg.Name
+	if _, ok := clone.Schedules[name]; !ok {
+		return cos.NewErrNotFound(p, "job schedule "+name)
+	}
+	delete(clone.Schedules, name)
+	if len(clone.Schedules) == 0 {
+		clone.Schedules = nil
+	}
+	clone.Version++
+	return nil
+}
+
+// GET /v1/cluster?what=job-schedules - from the primary, locally or otherwise
+func (p *proxy) getJobSchedules() (cmn.JobSchedules, error) {
+	return fromPrimary(p, apc.WhatJobSchedules, p.jobSchedules)
+}
+
+// (primary only)
+func (p *proxy) jobSchedules() cmn.JobSchedules {
+	var (
+		bmd = p.owner.bmd.get()
+		now = time.Now()
+		ps  = &p.primary().sched
+		out = make(cmn.JobSchedules, 0, len(bmd.Schedules))
+	)
+	ps.mu.Lock()
+	for name, sched := range bmd.Schedules {
+		info := &cmn.JobScheduleInfo{JobSchedule: *sched}
+		if next := sched.Next(now); !next.IsZero() {
+			info.Next = next.UnixNano()
+		}
+		if st := ps.states[name]; st != nil {
+			info.Runs = append(info.Runs, st.runs...)
+			info.Pending = st.pending
+		}
+		out = append(out, info)
+	}
+	ps.mu.Unlock()
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// evaluate schedules and run due jobs (primary only)
+func (p *proxy) schedHK(int64) time.Duration {
+	if !p.ClusterStarted() || nlog.Stopping() {
+		return hk.JobSchedIval
+	}
+	smap := p.owner.smap.get()
+	if !smap.isPrimary(p.si) {
+		return hk.JobSchedIval
+	}
+	var (
+		bmd = p.owner.bmd.get()
+		now = time.Now()
+		ps  = &p.primary().sched
+		due []*cmn.JobSchedule
+	)
+	ps.mu.Lock()
+	if ps.states == nil {
+		ps.states = make(map[string]*schedState, len(bmd.Schedules))
+	}
+	for name := range ps.states {
+		if _, ok := bmd.Schedules[name]; !ok {
+			delete(ps.states, name)
+		}
+	}
+	last := ps.last
+	if last.IsZero() || now.Sub(last) > 2*hk.JobSchedIval {
+		last = now // (just became primary, or the clock jumped - not catching up)
+	}
+	for name, sched := range bmd.Schedules {
+		st, ok := ps.states[name]
+		if !ok {
+			st = &schedState{}
+			ps.states[name] = st
+		}
+		next := sched.Next(last)
+		isDue := !next.IsZero() && !next.After(now)
+		if !isDue && !st.pending {
+			continue
+		}
+		if st.dispatching || p.jobRunning(st.xid) {
+			if !isDue {
+				continue
+			}
+			if sched.Overlap == cmn.SchedOverlapQueue {
+				st.pending = true
+			} else {
+				reason := "previous run " + cos.Ternary(st.xid == "", "is still starting", st.xid+" is still running")
+				st.add(cmn.JobRun{State: cmn.JobRunSkipped, Err: reason, Time: now.UnixNano()})
+				nlog.Infoln(sched.String(), "skipped:", reason)
+			}
+			continue
+		}
+		st.pending, st.dispatching = false, true
+		due = append(due, sched)
+	}
+	ps.last = now
+	ps.mu.Unlock()
+
+	for _, sched := range due {
+		go p.runJob(sched)
+	}
+
+	// shortly after the next minute boundary
+	return now.Truncate(time.Minute).Add(time.Minute + time.Second).Sub(now)
+}
+
+func (p *proxy) jobRunning(xid string) bool {
+	if xid == "" {
+		return false
+	}
+	nl := p.notifs.entry(xid)
+	return nl != nil && !nl.IsFinished()
+}
+
+func (p *proxy) runJob(sched *cmn.JobSchedule) {
+	var (
+		started = time.Now()
+		run     = cmn.JobRun{State: cmn.JobRunStarted, Time: started.UnixNano()}
+		ps      = &p.primary().sched
+	)
+	xid, err := p.execJob(sched)
+	if err != nil {
+		run.State, run.Err = cmn.JobRunFailed, err.Error()
+		nlog.Errorln(sched.String(), "failed to start:", err)
+	} else {
+		run.Xid = xid
+		nlog.Infoln(sched.String(), "started", xid)
+	}
+
+	ps.mu.Lock()
+	if st := ps.states[sched.Name]; st != nil {
+		st.dispatching = false
+		st.xid = xid
+		st.add(run)
+	}
+	ps.mu.Unlock()
+}
+
+// execute scheduled request via (this primary's) public API handler;
+// return xaction ID, if any
+func (p *proxy) execJob(sched *cmn.JobSchedule) (string, error) {
+	var (
+		handler func(http.ResponseWriter, *http.Request)
+		method  string
+		u       url.URL
+	)
+	if sched.IsBckAction() {
+		handler, method = p.bucketHandler, http.MethodPost
+		u.Path = apc.URLPathBuckets.Join(sched.Bck.Name)
+		query := sched.Bck.AddToQuery(nil)
+		if sched.BckTo != nil {
+			query = sched.BckTo.AddUnameToQuery(query, apc.QparamBckTo, "")
+		}
+		u.RawQuery = query.Encode()
+	} else {
+		handler, method = p.cluPubHandler, http.MethodPut
+		u.Path = apc.URLPathClu.S
+	}
+	return p.execInproc(handler, method, u.String(), cos.MustMarshal(&sched.Msg), sched.Name)
+}
+
+// execute request via (this primary's) public API handler on behalf of
+// a given scheduled or queued job (tag); return xaction ID, if any
+func (p *proxy) execInproc(handler func(http.ResponseWriter, *http.Request), method, uri string, body []byte, tag string) (string, error) {
+	ctx := context.WithValue(context.Background(), keyReqNet, reqNetPub)
+	ctx = context.WithValue(ctx, keySchedJob, tag)
+	r, err := http.NewRequestWithContext(ctx, method, uri, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	r.Header.Set(cos.HdrContentType, cos.ContentJSON)
+
+	w := newJobWriter(false)
+	handler(w, r)
+	return w.result()
+}
+
+////////////////
+// schedState //
+////////////////
+
+func (st *schedState) add(run cmn.JobRun) {
+	st.runs = append(st.runs, run)
+	if l := len(st.runs); l > cmn.JobRunHistory {
+		st.runs = append(st.runs[:0], st.runs[l-cmn.JobRunHistory:]...)
+	}
+}
+
+///////////////
+// jobWriter //
+///////////////
+
+var _ http.ResponseWriter = (*jobWriter)(nil)
+
+func newJobWriter(passthru bool) *jobWriter {
+	return &jobWriter{hdr: make(http.Header, 2), passthru: passthru}
+}
+
+func (w *jobWriter) Header() http.Header { return w.hdr }
+
+func (w *jobWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *jobWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	if n := maxJobResp - w.body.Len(); n < len(b) && !w.passthru {
+		w.body.Write(b[:max(n, 0)]) // (only interested in xaction ID or error)
+		return len(b), nil
+	}
+	return w.body.Write(b)
+}
+
+func (w *jobWriter) err() error {
+	if w.status < http.StatusBadRequest {
+		return nil
+	}
+	if herr := cmn.Str2HTTPErr(w.body.String()); herr != nil {
+		return herr
+	}
+	return fmt.Errorf("status %d: %s", w.status, w.body.String())
+}
+
+func (w *jobWriter) result() (string, error) {
+	if err := w.err(); err != nil {
+		return "", err
+	}
+	xid := strings.TrimSpace(w.body.String())
+	if !xact.IsValidUUID(xid) {
+		xid = "" // (not every action responds with xaction ID)
+	}
+	return xid, nil
+}
+
+// write captured response
+func (w *jobWriter) copyTo(rw http.ResponseWriter) {
+	maps.Copy(rw.Header(), w.hdr)
+	if w.status != 0 {
+		rw.WriteHeader(w.status)
+	}
+	if w.body.Len() > 0 {
+		rw.Write(w.body.Bytes())
+	}
+}
@@ -0,0 +1,125 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// Bucket snapshots are recorded in the BMD (see cmn.BckSnapshot);
+// preserved content is later reclaimed by space cleanup
+
+// POST {action: create-snapshot, name}
+func (p *proxy) createSnapshot(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg, bck *meta.Bck) {
+	if err := cmn.ValidateSnapshot(bck.Bucket(), bck.Props, msg.Name); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if err := p.checkAccess(w, r, bck, apc.AcePATCH); err != nil {
+		return
+	}
+	if p.forwardCP(w, r, msg, bck.Name) {
+		return
+	}
+	ctx := &bmdModifier{
+		pre:   bmodCreateSnap,
+		final: p.bmodSync,
+		msg:   msg,
+		bcks:  []*meta.Bck{bck},
+		wait:  true,
+	}
+	if _, err := p.owner.bmd.modify(ctx); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	nlog.Infoln(msg.Action, bck.Cname(""), "[", msg.Name, "]")
+}
+
+func bmodCreateSnap(ctx *bmdModifier, clone *bucketMD) error {
+	var (
+		bck             = ctx.bcks[0]
+		name            = ctx.msg.Name
+		bprops, present = clone.Get(bck)
+	)
+	if !present {
+		return cmn.NewErrBckNotFound(bck.Bucket())
+	}
+	if bprops.Snapshot(name) != nil {
+		return fmt.Errorf("%s: snapshot %q already exists", bck.Cname(""), name)
+	}
+	nprops := bprops.Clone()
+	nprops.Snapshots = make([]cmn.BckSnapshot, 0, len(bprops.Snapshots)+1)
+	nprops.Snapshots = append(nprops.Snapshots, bprops.Snapshots...)
+	nprops.Snapshots = append(nprops.Snapshots, cmn.BckSnapshot{Name: name, Created: time.Now().UnixNano()})
+	clone.set(bck, nprops)
+	return nil
+}
+
+// DELETE {action: destroy-snapshot, name}
+func (p *proxy) destroySnapshot(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg, bck *meta.Bck) {
+	if bck.Props.Snapshot(msg.Name) == nil {
+		p.writeErr(w, r, cmn.NewErrSnapshotNotFound(bck.Bucket(), msg.Name), http.StatusNotFound)
+		return
+	}
+	if p.forwardCP(w, r, msg, bck.Name) {
+		return
+	}
+	ctx := &bmdModifier{
+		pre:   bmodDestroySnap,
+		final: p.bmodSync,
+		msg:   msg,
+		bcks:  []*meta.Bck{bck},
+		wait:  true,
+	}
+	if _, err := p.owner.bmd.modify(ctx); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	nlog.Infoln(msg.Action, bck.Cname(""), "[", msg.Name, "]")
+}
+
+func bmodDestroySnap(ctx *bmdModifier, clone *bucketMD) error {
+	var (
+		bck             = ctx.bcks[0]
+		name            = ctx.msg.Name
+		bprops, present = clone.Get(bck)
+	)
+	if !present {
+		return cmn.NewErrBckNotFound(bck.Bucket())
+	}
+	if bprops.Snapshot(name) == nil {
+		return cmn.NewErrSnapshotNotFound(bck.Bucket(), name)
+	}
+	nprops := bprops.Clone()
+	nprops.Snapshots = make([]cmn.BckSnapshot, 0, len(bprops.Snapshots)-1)
+	for _, snap := range bprops.Snapshots {
+		if snap.Name != name {
+			nprops.Snapshots = append(nprops.Snapshots, snap)
+		}
+	}
+	if len(nprops.Snapshots) == 0 {
+		nprops.Snapshots = nil
+	}
+	clone.set(bck, nprops)
+	return nil
+}
+
+// clone snapshot: copy-bck to a new bucket
+func _checkCopySnap(bckFrom *meta.Bck, msg *apc.ActMsg, tcbmsg *apc.TCBMsg) error {
+	if bckFrom.Props.Snapshot(tcbmsg.Snapshot) == nil {
+		return cmn.NewErrSnapshotNotFound(bckFrom.Bucket(), tcbmsg.Snapshot)
+	}
+	if msg.Action != apc.ActCopyBck || tcbmsg.Sync {
+		return fmt.Errorf("%s snapshot %q: only plain copy (%q) to a new bucket is supported", bckFrom.Cname(""), tcbmsg.Snapshot, apc.ActCopyBck)
+	}
+	return nil
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/kms"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
@@ -438,7 +439,8 @@ func bmodMirror(ctx *bmdModifier, clone *bucketMD) error {
 }
 
 // set-bucket-props: { confirm existence -- begin -- apply props -- metasync -- commit }
-func (p *proxy) setBprops(msg *apc.ActMsg, bck *meta.Bck, nprops *cmn.Bprops) (string /*xid*/, error) {
+// non-empty `rechunk` (apc.QparamRechunk) requests rechunking existing objects if `chunks` props change
+func (p *proxy) setBprops(msg *apc.ActMsg, bck *meta.Bck, nprops *cmn.Bprops, rechunk string) (string /*xid*/, error) {
 	// 1. confirm existence
 	bprops, present := p.owner.bmd.get().Get(bck)
 	if !present {
@@ -482,6 +484,10 @@ func (p *proxy) setBprops(msg *apc.ActMsg, bck *meta.Bck, nprops *cmn.Bprops) (s
 		c         = &txnCln{p: p}
 	)
 	c.init(&nmsg, bck, "" /*uuid*/, waitmsync)
+	if rechunk != "" {
+		c.req.Query.Set(apc.QparamRechunk, rechunk)
+	}
+	needReChunk := rechunk != "" && _reChunk(bprops, nprops)
 	if err := c.begin(bck); err != nil {
 		return "", err
 	}
@@ -503,10 +509,18 @@ func (p *proxy) setBprops(msg *apc.ActMsg, bck *meta.Bck, nprops *cmn.Bprops) (s
 	}
 	c.msg.BMDVersion = bmd.version()
 
-	// 4. if remirror|re-EC|TBD-storage-svc
+	// 4. if remirror|re-EC|rechunk
 	// NOTE: setting up IC listening prior to committing (and confirming xid) here and elsewhere
-	if ctx.needReMirror || ctx.needReEC {
-		action := cos.Ternary(ctx.needReEC, apc.ActECEncode, apc.ActMakeNCopies)
+	if ctx.needReMirror || ctx.needReEC || needReChunk {
+		var action string
+		switch {
+		case ctx.needReEC:
+			action = apc.ActECEncode
+		case ctx.needReMirror:
+			action = apc.ActMakeNCopies
+		default:
+			action = apc.ActRechunk
+		}
 		nl := xact.NewXactNL(c.uuid, action, &c.smap.Smap, nil, bck.Bucket())
 		nl.SetOwner(equalIC)
 		p.ic.registerEqual(regIC{nl: nl, smap: c.smap, query: c.req.Query})
@@ -531,6 +545,16 @@ func (p *proxy) bmodSetProps(ctx *bmdModifier, clone *bucketMD) (err error) {
 	if ctx.msg.Action == apc.ActSetBprops {
 		bck.Props = bprops
 	}
+	if enc := &bprops.Encryption; enc.Key != "" {
+		// the data key is immutable (reset or concurrent enablement notwithstanding)
+		ctx.setProps.Encryption.Key, ctx.setProps.Encryption.KekID = enc.Key, enc.KekID
+	}
+	if bprops.ObjectLock.Enabled && !ctx.setProps.ObjectLock.Enabled {
+		// object lock is irreversible (reset notwithstanding)
+		ctx.setProps.ObjectLock = bprops.ObjectLock
+	}
+	// snapshots are not props (see createSnapshot)
+	ctx.setProps.Snapshots = bprops.Snapshots
 	ctx.needReMirror = _reMirror(bprops, ctx.setProps)
 	targetCnt, ctx.needReEC = _reEC(bprops, ctx.setProps, bck, p.owner.smap.get())
 	debug.Assert(!ctx.needReEC || ctx.setProps.Validate(targetCnt) == nil)
@@ -605,6 +629,7 @@ func bmodMv(ctx *bmdModifier, clone *bucketMD) error {
 	debug.Assert(present)
 	bckFrom.Props = bprops.Clone()
 	bckTo.Props = bprops.Clone()
+	bckTo.Props.Snapshots = nil // (preserved content is not migrated)
 	added := clone.add(bckTo, bckTo.Props)
 	debug.Assert(added)
 	bckFrom.Props.Renamed = apc.ActMoveBck // NOTE: state until `BMDVersionFixup` by renaming xaction
@@ -1075,6 +1100,7 @@ func bmodCpProps(ctx *bmdModifier, clone *bucketMD) error {
 	// replicate bucket props - but only if the source is ais as well
 	if bckFrom.IsAIS() || bckFrom.IsRemoteAIS() {
 		bckTo.Props = bprops.Clone()
+		bckTo.Props.Snapshots = nil // (not copied)
 	} else {
 		bargs := bckPropsArgs{bck: bckTo}
 		bckTo.Props = bargs.inheritMerge()
@@ -1138,6 +1164,10 @@ func (p *proxy) makeNewBckProps(bck *meta.Bck, propsToUpdate *cmn.BpropsToSet, c
 		}
 	}
 
+	if bprops.ObjectLock.Enabled && !nprops.ObjectLock.Enabled {
+		return nil, fmt.Errorf("%s: once enabled, object lock cannot be disabled", bck.Cname(""))
+	}
+
 	oldEC, newEC := &bprops.EC, &nprops.EC
 	if oldEC.Enabled && newEC.Enabled {
 		if oldEC.DataSlices != newEC.DataSlices || oldEC.ParitySlices != newEC.ParitySlices {
@@ -1190,6 +1220,15 @@ func (p *proxy) makeNewBckProps(bck *meta.Bck, propsToUpdate *cmn.BpropsToSet, c
 		}
 	}
 
+	if enc := &nprops.Encryption; enc.Enabled && enc.Key == "" {
+		// first-time enablement: generate the bucket's data key
+		kekID, key, err := kms.NewDataKey()
+		if err != nil {
+			return nil, fmt.Errorf("%s: failed to enable encryption: %w", bck.Cname(""), err)
+		}
+		enc.KekID, enc.Key = kekID, key
+	}
+
 	err := nprops.Validate(targetCnt)
 	if err == nil {
 		return nprops, nil // ok
@@ -17,12 +17,19 @@ const (
 	QparamCORS              = "cors"
 	QparamPolicy            = "policy"
 	QparamACL               = "acl"
+	QparamTagging           = "tagging"            // Get, put, or delete object tags
+	QparamObjectLock        = "object-lock"        // Bucket object lock configuration
+	QparamRetention         = "retention"          // Object retention (object lock)
+	QparamLegalHold         = "legal-hold"         // Object legal hold (object lock)
 	QparamMultiDelete       = "delete"             // Delete multiple objects in a single request
 	QparamMaxKeys           = "max-keys"           // Maximum number of objects to return in listing
 	QparamPrefix            = "prefix"             // Filter objects by key prefix
 	QparamContinuationToken = "continuation-token" // Pagination token for continued listing
 	QparamStartAfter        = "start-after"        // Start listing after this object key
 	QparamDelimiter         = "delimiter"          // Delimiter for grouping object keys
+	QparamMarker            = "marker"             // ListObjects (V1): start listing after this object key
+	QparamListType          = "list-type"          // "2" for ListObjectsV2
+	QparamEncodingType      = "encoding-type"      // Encode object keys in the response ("url" is the only valid value)
 
 	// AIS native multipart APIs use
 	// canonical S3 constants
@@ -46,6 +53,8 @@ const (
 	HeaderCredentials   = "X-Amz-Credential"     //nolint:gosec // This is just a header name definition...
 	HeaderSecurityToken = "X-Amz-Security-Token" // AWS temporary security token (used for JWT in compatibility mode)
 
+	EncodingTypeURL = "url"
+
 	versioningEnabled  = "Enabled"
 	versioningDisabled = "Suspended"
 
@@ -0,0 +1,160 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Bucket CORS: S3 XML <=> cmn.CORSConf, and CORS request handling
+// See https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketCors.html
+
+const NoSuchCORSConfiguration = "NoSuchCORSConfiguration"
+
+var errCORSNotAllowed = errors.New("CORSResponse: this CORS request is not allowed")
+
+type (
+	CORSConfiguration struct {
+		XMLName xml.Name   `xml:"CORSConfiguration"`
+		Ns      string     `xml:"xmlns,attr,omitempty"`
+		Rules   []CORSRule `xml:"CORSRule"`
+	}
+	CORSRule struct {
+		ID             string   `xml:"ID,omitempty"`
+		AllowedHeaders []string `xml:"AllowedHeader"`
+		AllowedMethods []string `xml:"AllowedMethod"`
+		AllowedOrigins []string `xml:"AllowedOrigin"`
+		ExposeHeaders  []string `xml:"ExposeHeader"`
+		MaxAgeSeconds  int      `xml:"MaxAgeSeconds,omitempty"`
+	}
+)
+
+func NewCORSConfiguration(conf *cmn.CORSConf) *CORSConfiguration {
+	r := &CORSConfiguration{Ns: s3Namespace, Rules: make([]CORSRule, 0, len(conf.Rules))}
+	for i := range conf.Rules {
+		src := &conf.Rules[i]
+		r.Rules = append(r.Rules, CORSRule{
+			ID:             src.ID,
+			AllowedHeaders: src.AllowedHeaders,
+			AllowedMethods: src.AllowedMethods,
+			AllowedOrigins: src.AllowedOrigins,
+			ExposeHeaders:  src.ExposeHeaders,
+			MaxAgeSeconds:  src.MaxAgeSeconds,
+		})
+	}
+	return r
+}
+
+func (r *CORSConfiguration) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write(cos.UnsafeB(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+// ToRules converts S3 CORS configuration to AIS rules;
+// validation is done by cmn.CORSConf.
+func (r *CORSConfiguration) ToRules() []cmn.CORSRule {
+	rules := make([]cmn.CORSRule, 0, len(r.Rules))
+	for i := range r.Rules {
+		src := &r.Rules[i]
+		rules = append(rules, cmn.CORSRule{
+			ID:             src.ID,
+			AllowedOrigins: src.AllowedOrigins,
+			AllowedMethods: src.AllowedMethods,
+			AllowedHeaders: src.AllowedHeaders,
+			ExposeHeaders:  src.ExposeHeaders,
+			MaxAgeSeconds:  src.MaxAgeSeconds,
+		})
+	}
+	return rules
+}
+
+//
+// CORS request handling (gateways and targets)
+//
+
+// HandleCORS adds `Access-Control-*` response headers when the request carries
+// Origin that is allowed by the bucket's CORS configuration; responds to preflight
+// (OPTIONS) requests - in which case the caller is done.
+func HandleCORS(w http.ResponseWriter, r *http.Request, bucket string, bowner meta.Bowner) (done bool) {
+	preflight := r.Method == http.MethodOptions
+	origin := r.Header.Get(cos.HdrOrigin)
+	if origin == "" {
+		if preflight {
+			WriteErr(w, r, ErrInfo{Err: errors.New("CORS preflight: missing Origin header"), Code: ErrCodeInvalidRequest})
+		}
+		return preflight
+	}
+	var conf *cmn.CORSConf
+	if bck, _, err := meta.InitByNameOnly(bucket, bowner); err == nil {
+		conf = &bck.Props.CORS
+	} else if preflight {
+		WriteErr(w, r, ErrInfo{Err: err})
+		return true
+	}
+	if !preflight {
+		if conf != nil {
+			if rule := conf.Match(origin, r.Method, nil); rule != nil {
+				setCORSHeaders(w.Header(), rule, origin)
+			}
+		}
+		return false
+	}
+
+	// preflight
+	method := r.Header.Get(cos.HdrACRequestMethod)
+	if method == "" {
+		WriteErr(w, r, ErrInfo{Err: errors.New("CORS preflight: missing Access-Control-Request-Method header"),
+			Code: ErrCodeInvalidRequest})
+		return true
+	}
+	var headers []string
+	if s := r.Header.Get(cos.HdrACRequestHeaders); s != "" {
+		for hdr := range strings.SplitSeq(s, ",") {
+			if hdr = strings.TrimSpace(hdr); hdr != "" {
+				headers = append(headers, hdr)
+			}
+		}
+	}
+	rule := conf.Match(origin, method, headers)
+	if rule == nil {
+		WriteErr(w, r, ErrInfo{Err: errCORSNotAllowed, Status: http.StatusForbidden, Code: ErrCodeAccessDenied})
+		return true
+	}
+	hdr := w.Header()
+	setCORSHeaders(hdr, rule, origin)
+	if len(headers) > 0 {
+		hdr.Set(cos.HdrACAllowHeaders, strings.Join(headers, ", "))
+	}
+	if rule.MaxAgeSeconds > 0 {
+		hdr.Set(cos.HdrACMaxAge, strconv.Itoa(rule.MaxAgeSeconds))
+	}
+	w.WriteHeader(http.StatusOK)
+	return true
+}
+
+func setCORSHeaders(hdr http.Header, rule *cmn.CORSRule, origin string) {
+	if rule.AnyOrigin() {
+		hdr.Set(cos.HdrACAllowOrigin, "*")
+	} else {
+		hdr.Set(cos.HdrACAllowOrigin, origin)
+		hdr.Set(cos.HdrACAllowCredentials, "true")
+	}
+	hdr.Set(cos.HdrACAllowMethods, strings.Join(rule.AllowedMethods, ", "))
+	if len(rule.ExposeHeaders) > 0 {
+		hdr.Set(cos.HdrACExposeHeaders, strings.Join(rule.ExposeHeaders, ", "))
+	}
+	hdr.Set(cos.HdrVary, cos.HdrOrigin+", "+cos.HdrACRequestHeaders+", "+cos.HdrACRequestMethod)
+}
@@ -0,0 +1,95 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/memsys"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CORS", func() {
+	const bucket = "labels"
+
+	It("round-trips CORS configuration", func() {
+		conf := &cmn.CORSConf{Rules: []cmn.CORSRule{
+			{ID: "app", AllowedOrigins: []string{"https://app.example.com"}, AllowedMethods: []string{"GET", "PUT"},
+				AllowedHeaders: []string{"*"}, ExposeHeaders: []string{"ETag"}, MaxAgeSeconds: 600},
+		}}
+		sgl := memsys.PageMM().NewSGL(0)
+		defer sgl.Free()
+		s3.NewCORSConfiguration(conf).MustMarshal(sgl)
+		b, err := io.ReadAll(sgl)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(b)).To(ContainSubstring("<AllowedMethod>PUT</AllowedMethod>"))
+
+		cconf := &s3.CORSConfiguration{}
+		Expect(xml.Unmarshal(b, cconf)).To(Succeed())
+		Expect(cconf.ToRules()).To(Equal(conf.Rules))
+	})
+
+	Describe("HandleCORS", func() {
+		var bowner meta.Bowner
+
+		BeforeEach(func() {
+			props := &cmn.Bprops{CORS: cmn.CORSConf{Rules: []cmn.CORSRule{
+				{AllowedOrigins: []string{"https://app.example.com"}, AllowedMethods: []string{"PUT"},
+					AllowedHeaders: []string{"content-type"}, ExposeHeaders: []string{"ETag"}, MaxAgeSeconds: 600},
+			}}}
+			bowner = mock.NewBaseBownerMock(meta.NewBck(bucket, apc.AIS, cmn.NsGlobal, props))
+		})
+
+		It("responds to allowed preflight", func() {
+			r := httptest.NewRequest(http.MethodOptions, "/s3/"+bucket+"/obj", http.NoBody)
+			r.Header.Set(cos.HdrOrigin, "https://app.example.com")
+			r.Header.Set(cos.HdrACRequestMethod, http.MethodPut)
+			r.Header.Set(cos.HdrACRequestHeaders, "Content-Type")
+			w := httptest.NewRecorder()
+			Expect(s3.HandleCORS(w, r, bucket, bowner)).To(BeTrue())
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Header().Get(cos.HdrACAllowOrigin)).To(Equal("https://app.example.com"))
+			Expect(w.Header().Get(cos.HdrACAllowMethods)).To(Equal("PUT"))
+			Expect(w.Header().Get(cos.HdrACAllowHeaders)).To(Equal("Content-Type"))
+			Expect(w.Header().Get(cos.HdrACMaxAge)).To(Equal("600"))
+		})
+
+		It("rejects disallowed preflight", func() {
+			r := httptest.NewRequest(http.MethodOptions, "/s3/"+bucket+"/obj", http.NoBody)
+			r.Header.Set(cos.HdrOrigin, "https://evil.com")
+			r.Header.Set(cos.HdrACRequestMethod, http.MethodPut)
+			w := httptest.NewRecorder()
+			Expect(s3.HandleCORS(w, r, bucket, bowner)).To(BeTrue())
+			Expect(w.Code).To(Equal(http.StatusForbidden))
+			Expect(w.Header().Get(cos.HdrACAllowOrigin)).To(BeEmpty())
+		})
+
+		It("adds headers to cross-origin requests", func() {
+			r := httptest.NewRequest(http.MethodPut, "/s3/"+bucket+"/obj", http.NoBody)
+			r.Header.Set(cos.HdrOrigin, "https://app.example.com")
+			w := httptest.NewRecorder()
+			Expect(s3.HandleCORS(w, r, bucket, bowner)).To(BeFalse())
+			Expect(w.Header().Get(cos.HdrACAllowOrigin)).To(Equal("https://app.example.com"))
+			Expect(w.Header().Get(cos.HdrACExposeHeaders)).To(Equal("ETag"))
+
+			r = httptest.NewRequest(http.MethodGet, "/s3/"+bucket+"/obj", http.NoBody)
+			r.Header.Set(cos.HdrOrigin, "https://app.example.com")
+			w = httptest.NewRecorder()
+			Expect(s3.HandleCORS(w, r, bucket, bowner)).To(BeFalse())
+			Expect(w.Header().Get(cos.HdrACAllowOrigin)).To(BeEmpty())
+		})
+	})
+})
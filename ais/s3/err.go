@@ -23,15 +23,18 @@ const ErrPrefix = "aws-error"
 
 // assorted popular codes
 const (
-	NoSuchKey    = "NoSuchKey"
-	NoSuchBucket = "NoSuchBucket"
-	NoSuchUpload = "NoSuchUpload"
+	NoSuchKey     = "NoSuchKey"
+	NoSuchBucket  = "NoSuchBucket"
+	NoSuchUpload  = "NoSuchUpload"
+	NoSuchVersion = "NoSuchVersion"
 )
 
 const (
 	ErrCodeAccessDenied    = "AccessDenied"    // 403 (S3 has no 401)
 	ErrCodeInvalidArgument = "InvalidArgument" // 400 (malformed query)
 	ErrCodeInvalidRequest  = "InvalidRequest"  // catch-all
+	ErrCodeInvalidTag      = "InvalidTag"      // 400 (object tagging)
+	ErrCodeQuotaExceeded   = "QuotaExceeded"   // 403 (bucket or namespace hard quota; see cmn.QuotaConf)
 )
 
 // See https://docs.aws.amazon.com/AmazonS3/latest/API/ErrorResponses.html
@@ -134,6 +137,8 @@ func WriteErr(w http.ResponseWriter, r *http.Request, errInfo ErrInfo) {
 		}
 	case isErrNoSuchUpload(err):
 		out.Code = NoSuchUpload
+	case cmn.IsErrPrecondFailed(err):
+		out.Code = "PreconditionFailed"
 	case in.TypeCode != "":
 		out.Code = in.TypeCode
 	default:
@@ -0,0 +1,88 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Bucket event notifications (see cmn/events): S3 event message structure, version 2.1
+// See https://docs.aws.amazon.com/AmazonS3/latest/userguide/notification-content-structure.html
+
+const (
+	eventVersion    = "2.1"
+	eventSource     = "ais:s3"
+	eventSchemaVers = "1.0"
+	eventArnPrefix  = "arn:aws:s3:::"
+)
+
+type (
+	// (the message that's delivered to the webhook or stored in the queue)
+	EventMessage struct {
+		Records []EventRecord `json:"Records"`
+	}
+	EventRecord struct {
+		EventVersion      string            `json:"eventVersion"`
+		EventSource       string            `json:"eventSource"`
+		AwsRegion         string            `json:"awsRegion"`
+		EventTime         string            `json:"eventTime"`
+		EventName         string            `json:"eventName"` // e.g. "ObjectCreated:Put" (no "s3:" prefix)
+		UserIdentity      EventIdentity     `json:"userIdentity"`
+		RequestParameters map[string]string `json:"requestParameters"`
+		ResponseElements  map[string]string `json:"responseElements"`
+		S3                EventEntity       `json:"s3"`
+	}
+	EventIdentity struct {
+		PrincipalID string `json:"principalId"`
+	}
+	EventEntity struct {
+		SchemaVersion   string      `json:"s3SchemaVersion"`
+		ConfigurationID string      `json:"configurationId"`
+		Bucket          EventBucket `json:"bucket"`
+		Object          EventObject `json:"object"`
+	}
+	EventBucket struct {
+		Name          string        `json:"name"`
+		OwnerIdentity EventIdentity `json:"ownerIdentity"`
+		Arn           string        `json:"arn"`
+	}
+	EventObject struct {
+		Key       string `json:"key"` // URL-encoded
+		Size      int64  `json:"size,omitempty"`
+		ETag      string `json:"eTag,omitempty"`
+		VersionID string `json:"versionId,omitempty"`
+		Sequencer string `json:"sequencer"`
+	}
+)
+
+// NewEventMessage returns single-record message given event type (e.g. cmn.EventObjCreatedPut),
+// rule (configuration) ID, and object; `sourceIP` is optional
+func NewEventMessage(event, ruleID, bucket string, obj *EventObject, sourceIP string, now time.Time) *EventMessage {
+	rec := EventRecord{
+		EventVersion:      eventVersion,
+		EventSource:       eventSource,
+		AwsRegion:         AISRegion,
+		EventTime:         now.UTC().Format(cos.ISO8601),
+		EventName:         strings.TrimPrefix(event, "s3:"),
+		RequestParameters: map[string]string{"sourceIPAddress": sourceIP},
+		ResponseElements:  map[string]string{},
+		S3: EventEntity{
+			SchemaVersion:   eventSchemaVers,
+			ConfigurationID: ruleID,
+			Bucket:          EventBucket{Name: bucket, Arn: eventArnPrefix + bucket},
+			Object:          *obj,
+		},
+	}
+	rec.S3.Object.Key = url.QueryEscape(obj.Key)
+	if rec.S3.Object.Sequencer == "" {
+		rec.S3.Object.Sequencer = strings.ToUpper(strconv.FormatInt(now.UnixNano(), 16))
+	}
+	return &EventMessage{Records: []EventRecord{rec}}
+}
@@ -0,0 +1,260 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Bucket lifecycle configuration: S3 XML <=> cmn.LifecycleConf
+// Supported subset:
+// - Expiration/Days
+// - AbortIncompleteMultipartUpload/DaysAfterInitiation
+// - Filter: Prefix, Tag, And(Prefix, Tag...); also the legacy (top-level) Prefix
+// See https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketLifecycleConfiguration.html
+
+const (
+	NoSuchLifecycleConfiguration = "NoSuchLifecycleConfiguration"
+	ErrCodeMalformedXML          = "MalformedXML"
+	ErrCodeNotImplemented        = "NotImplemented"
+)
+
+const (
+	lcyStatusEnabled  = "Enabled"
+	lcyStatusDisabled = "Disabled"
+)
+
+type (
+	LifecycleConfiguration struct {
+		XMLName xml.Name        `xml:"LifecycleConfiguration"`
+		Ns      string          `xml:"xmlns,attr,omitempty"`
+		Rules   []LifecycleRule `xml:"Rule"`
+	}
+	LifecycleRule struct {
+		Filter     *LifecycleFilter     `xml:"Filter,omitempty"`
+		Expiration *LifecycleExpiration `xml:"Expiration,omitempty"`
+		AbortMpt   *LifecycleAbortMpt   `xml:"AbortIncompleteMultipartUpload,omitempty"`
+		Prefix     *string              `xml:"Prefix,omitempty"` // legacy
+		ID         string               `xml:"ID,omitempty"`
+		Status     string               `xml:"Status"`
+
+		// not supported (presence is detected and rejected)
+		Transitions  []lcyUnsupported `xml:"Transition"`
+		NoncurExp    []lcyUnsupported `xml:"NoncurrentVersionExpiration"`
+		NoncurTransi []lcyUnsupported `xml:"NoncurrentVersionTransition"`
+	}
+	LifecycleFilter struct {
+		Prefix *string       `xml:"Prefix,omitempty"`
+		Tag    *LifecycleTag `xml:"Tag,omitempty"`
+		And    *LifecycleAnd `xml:"And,omitempty"`
+
+		// not supported
+		SizeGT []lcyUnsupported `xml:"ObjectSizeGreaterThan"`
+		SizeLT []lcyUnsupported `xml:"ObjectSizeLessThan"`
+	}
+	LifecycleAnd struct {
+		Prefix string         `xml:"Prefix,omitempty"`
+		Tags   []LifecycleTag `xml:"Tag"`
+
+		// not supported
+		SizeGT []lcyUnsupported `xml:"ObjectSizeGreaterThan"`
+		SizeLT []lcyUnsupported `xml:"ObjectSizeLessThan"`
+	}
+	LifecycleTag struct {
+		Key   string `xml:"Key"`
+		Value string `xml:"Value"`
+	}
+	LifecycleExpiration struct {
+		Days *int `xml:"Days,omitempty"`
+
+		// not supported
+		Date         []lcyUnsupported `xml:"Date"`
+		DeleteMarker []lcyUnsupported `xml:"ExpiredObjectDeleteMarker"`
+	}
+	LifecycleAbortMpt struct {
+		Days int `xml:"DaysAfterInitiation"`
+	}
+
+	lcyUnsupported struct {
+		XMLName xml.Name
+	}
+)
+
+// ErrLcyNotImpl is returned by ToRules() when the configuration contains
+// valid S3 elements that AIS does not (yet) support.
+type ErrLcyNotImpl struct {
+	what string
+}
+
+func (e *ErrLcyNotImpl) Error() string {
+	return "lifecycle: " + e.what + " is not supported"
+}
+
+func IsErrLcyNotImpl(err error) bool {
+	var e *ErrLcyNotImpl
+	return errors.As(err, &e)
+}
+
+func NewLifecycleConfiguration(lcy *cmn.LifecycleConf) *LifecycleConfiguration {
+	r := &LifecycleConfiguration{Ns: s3Namespace, Rules: make([]LifecycleRule, 0, len(lcy.Rules))}
+	for i := range lcy.Rules {
+		var (
+			src  = &lcy.Rules[i]
+			rule = LifecycleRule{ID: src.ID, Status: lcyStatusEnabled, Filter: &LifecycleFilter{}}
+		)
+		if src.Disabled || !lcy.Enabled {
+			rule.Status = lcyStatusDisabled
+		}
+		switch {
+		case len(src.Tags) == 0:
+			prefix := src.Prefix
+			rule.Filter.Prefix = &prefix
+		case len(src.Tags) == 1 && src.Prefix == "":
+			for k, v := range src.Tags {
+				rule.Filter.Tag = &LifecycleTag{Key: k, Value: v}
+			}
+		default:
+			and := &LifecycleAnd{Prefix: src.Prefix, Tags: make([]LifecycleTag, 0, len(src.Tags))}
+			for k, v := range src.Tags {
+				and.Tags = append(and.Tags, LifecycleTag{Key: k, Value: v})
+			}
+			sort.Slice(and.Tags, func(i, j int) bool { return and.Tags[i].Key < and.Tags[j].Key })
+			rule.Filter.And = and
+		}
+		if src.ExpirationDays > 0 {
+			days := src.ExpirationDays
+			rule.Expiration = &LifecycleExpiration{Days: &days}
+		}
+		if src.AbortMptDays > 0 {
+			rule.AbortMpt = &LifecycleAbortMpt{Days: src.AbortMptDays}
+		}
+		r.Rules = append(r.Rules, rule)
+	}
+	return r
+}
+
+func (r *LifecycleConfiguration) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+// ToRules converts S3 lifecycle configuration to AIS lifecycle rules;
+// semantic validation (ranges, duplicates, etc.) is done by cmn.LifecycleConf.
+func (r *LifecycleConfiguration) ToRules() ([]cmn.LifecycleRule, error) {
+	if len(r.Rules) == 0 {
+		return nil, errors.New("lifecycle: configuration must contain at least one rule")
+	}
+	rules := make([]cmn.LifecycleRule, 0, len(r.Rules))
+	for i := range r.Rules {
+		rule, err := r.Rules[i].toRule(i)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+func (src *LifecycleRule) toRule(idx int) (rule cmn.LifecycleRule, _ error) {
+	switch {
+	case len(src.Transitions) > 0:
+		return rule, &ErrLcyNotImpl{"Transition"}
+	case len(src.NoncurExp) > 0:
+		return rule, &ErrLcyNotImpl{"NoncurrentVersionExpiration"}
+	case len(src.NoncurTransi) > 0:
+		return rule, &ErrLcyNotImpl{"NoncurrentVersionTransition"}
+	}
+
+	rule.ID = src.ID
+	if rule.ID == "" {
+		// S3 assigns a random ID; we make it deterministic
+		rule.ID = fmt.Sprintf("rule-%d", idx+1)
+	}
+	switch src.Status {
+	case lcyStatusEnabled:
+	case lcyStatusDisabled:
+		rule.Disabled = true
+	default:
+		return rule, fmt.Errorf("lifecycle rule %q: invalid status %q (expecting %q or %q)",
+			rule.ID, src.Status, lcyStatusEnabled, lcyStatusDisabled)
+	}
+
+	if src.Prefix != nil && src.Filter != nil {
+		return rule, fmt.Errorf("lifecycle rule %q: Prefix and Filter are mutually exclusive", rule.ID)
+	}
+	if src.Prefix != nil {
+		rule.Prefix = *src.Prefix
+	}
+	if f := src.Filter; f != nil {
+		if err := f.apply(&rule); err != nil {
+			return rule, err
+		}
+	}
+
+	if e := src.Expiration; e != nil {
+		switch {
+		case len(e.Date) > 0:
+			return rule, &ErrLcyNotImpl{"Expiration/Date"}
+		case len(e.DeleteMarker) > 0:
+			return rule, &ErrLcyNotImpl{"Expiration/ExpiredObjectDeleteMarker"}
+		case e.Days == nil:
+			return rule, fmt.Errorf("lifecycle rule %q: Expiration requires Days", rule.ID)
+		case *e.Days <= 0:
+			return rule, fmt.Errorf("lifecycle rule %q: Expiration/Days must be a positive integer, got %d", rule.ID, *e.Days)
+		}
+		rule.ExpirationDays = *e.Days
+	}
+	if a := src.AbortMpt; a != nil {
+		if a.Days <= 0 {
+			return rule, fmt.Errorf("lifecycle rule %q: DaysAfterInitiation must be a positive integer, got %d", rule.ID, a.Days)
+		}
+		rule.AbortMptDays = a.Days
+	}
+	return rule, nil
+}
+
+func (f *LifecycleFilter) apply(rule *cmn.LifecycleRule) error {
+	if len(f.SizeGT) > 0 || len(f.SizeLT) > 0 {
+		return &ErrLcyNotImpl{"Filter/ObjectSize*"}
+	}
+	var n int
+	if f.Prefix != nil {
+		rule.Prefix = *f.Prefix
+		n++
+	}
+	if f.Tag != nil {
+		rule.Tags = cos.StrKVs{f.Tag.Key: f.Tag.Value}
+		n++
+	}
+	if and := f.And; and != nil {
+		if len(and.SizeGT) > 0 || len(and.SizeLT) > 0 {
+			return &ErrLcyNotImpl{"Filter/And/ObjectSize*"}
+		}
+		rule.Prefix = and.Prefix
+		if len(and.Tags) > 0 {
+			rule.Tags = make(cos.StrKVs, len(and.Tags))
+			for _, tag := range and.Tags {
+				if _, ok := rule.Tags[tag.Key]; ok {
+					return fmt.Errorf("lifecycle rule %q: duplicate tag key %q", rule.ID, tag.Key)
+				}
+				rule.Tags[tag.Key] = tag.Value
+			}
+		}
+		n++
+	}
+	if n > 1 {
+		return fmt.Errorf("lifecycle rule %q: Filter must specify exactly one of Prefix, Tag, or And", rule.ID)
+	}
+	return nil
+}
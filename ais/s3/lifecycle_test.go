@@ -0,0 +1,84 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"encoding/xml"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Lifecycle", func() {
+	decode := func(body string) ([]cmn.LifecycleRule, error) {
+		lconf := &s3.LifecycleConfiguration{}
+		Expect(xml.Unmarshal([]byte(body), lconf)).To(Succeed())
+		return lconf.ToRules()
+	}
+
+	It("converts supported rules", func() {
+		rules, err := decode(`<LifecycleConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <Rule>
+    <ID>tmp</ID>
+    <Filter><Prefix>tmp/</Prefix></Filter>
+    <Status>Enabled</Status>
+    <Expiration><Days>7</Days></Expiration>
+    <AbortIncompleteMultipartUpload><DaysAfterInitiation>2</DaysAfterInitiation></AbortIncompleteMultipartUpload>
+  </Rule>
+  <Rule>
+    <Filter><And><Prefix>logs/</Prefix><Tag><Key>a</Key><Value>1</Value></Tag><Tag><Key>b</Key><Value>2</Value></Tag></And></Filter>
+    <Status>Disabled</Status>
+    <Expiration><Days>1</Days></Expiration>
+  </Rule>
+  <Rule>
+    <Prefix>legacy/</Prefix>
+    <Status>Enabled</Status>
+    <Expiration><Days>3</Days></Expiration>
+  </Rule>
+</LifecycleConfiguration>`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rules).To(Equal([]cmn.LifecycleRule{
+			{ID: "tmp", Prefix: "tmp/", ExpirationDays: 7, AbortMptDays: 2},
+			{ID: "rule-2", Prefix: "logs/", Tags: cos.StrKVs{"a": "1", "b": "2"}, ExpirationDays: 1, Disabled: true},
+			{ID: "rule-3", Prefix: "legacy/", ExpirationDays: 3},
+		}))
+
+		// round trip
+		lcy := &cmn.LifecycleConf{Rules: rules, Enabled: true}
+		b, err := xml.Marshal(s3.NewLifecycleConfiguration(lcy))
+		Expect(err).NotTo(HaveOccurred())
+		again, err := decode(string(b))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(again).To(Equal(rules))
+	})
+
+	DescribeTable("rejects unsupported elements",
+		func(rule string) {
+			_, err := decode("<LifecycleConfiguration><Rule>" + rule + "</Rule></LifecycleConfiguration>")
+			Expect(err).To(HaveOccurred())
+			Expect(s3.IsErrLcyNotImpl(err)).To(BeTrue())
+		},
+		Entry("transition", `<Status>Enabled</Status><Transition><Days>1</Days><StorageClass>GLACIER</StorageClass></Transition>`),
+		Entry("expiration date", `<Status>Enabled</Status><Expiration><Date>2030-01-01T00:00:00Z</Date></Expiration>`),
+		Entry("noncurrent", `<Status>Enabled</Status><NoncurrentVersionExpiration><NoncurrentDays>1</NoncurrentDays></NoncurrentVersionExpiration>`),
+		Entry("size filter", `<Status>Enabled</Status><Filter><ObjectSizeGreaterThan>1</ObjectSizeGreaterThan></Filter><Expiration><Days>1</Days></Expiration>`),
+	)
+
+	DescribeTable("rejects invalid rules",
+		func(body string) {
+			_, err := decode(body)
+			Expect(err).To(HaveOccurred())
+			Expect(s3.IsErrLcyNotImpl(err)).To(BeFalse())
+		},
+		Entry("no rules", `<LifecycleConfiguration></LifecycleConfiguration>`),
+		Entry("bad status", `<LifecycleConfiguration><Rule><Status>On</Status><Expiration><Days>1</Days></Expiration></Rule></LifecycleConfiguration>`),
+		Entry("zero days", `<LifecycleConfiguration><Rule><Status>Enabled</Status><Expiration><Days>0</Days></Expiration></Rule></LifecycleConfiguration>`),
+		Entry("prefix and filter", `<LifecycleConfiguration><Rule><Prefix>a</Prefix><Filter><Prefix>b</Prefix></Filter><Status>Enabled</Status><Expiration><Days>1</Days></Expiration></Rule></LifecycleConfiguration>`),
+	)
+})
@@ -0,0 +1,226 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Object lock: S3 XML and headers <=> cmn.ObjectLockConf (bucket) and cmn.ObjRetention (object)
+// See https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectLockConfiguration.html
+
+const (
+	HdrObjLockMode        = "x-amz-object-lock-mode"              // PUT, GET, and HEAD object
+	HdrObjLockRetainUntil = "x-amz-object-lock-retain-until-date" // ditto
+	HdrObjLockLegalHold   = "x-amz-object-lock-legal-hold"        // ditto ("ON" | "OFF")
+	HdrBypassGovernance   = "x-amz-bypass-governance-retention"   // DELETE object, PUT retention
+)
+
+const (
+	ObjLockEnabled = "Enabled"
+	LegalHoldON    = "ON"
+	LegalHoldOFF   = "OFF"
+
+	ObjectLockConfigurationNotFound = "ObjectLockConfigurationNotFoundError"
+	NoSuchObjectLockConfiguration   = "NoSuchObjectLockConfiguration"
+)
+
+type (
+	ObjectLockConfiguration struct {
+		XMLName           xml.Name        `xml:"ObjectLockConfiguration"`
+		Ns                string          `xml:"xmlns,attr,omitempty"`
+		ObjectLockEnabled string          `xml:"ObjectLockEnabled,omitempty"`
+		Rule              *ObjectLockRule `xml:"Rule,omitempty"`
+	}
+	ObjectLockRule struct {
+		DefaultRetention DefaultRetention `xml:"DefaultRetention"`
+	}
+	DefaultRetention struct {
+		Mode  string `xml:"Mode"`
+		Days  int    `xml:"Days,omitempty"`
+		Years int    `xml:"Years,omitempty"`
+	}
+
+	Retention struct {
+		XMLName         xml.Name `xml:"Retention"`
+		Ns              string   `xml:"xmlns,attr,omitempty"`
+		Mode            string   `xml:"Mode,omitempty"`
+		RetainUntilDate string   `xml:"RetainUntilDate,omitempty"`
+	}
+
+	LegalHold struct {
+		XMLName xml.Name `xml:"LegalHold"`
+		Ns      string   `xml:"xmlns,attr,omitempty"`
+		Status  string   `xml:"Status"`
+	}
+)
+
+/////////////////////////////
+// ObjectLockConfiguration //
+/////////////////////////////
+
+func NewObjectLockConfiguration(conf *cmn.ObjectLockConf) *ObjectLockConfiguration {
+	r := &ObjectLockConfiguration{Ns: s3Namespace, ObjectLockEnabled: ObjLockEnabled}
+	if conf.Days > 0 {
+		r.Rule = &ObjectLockRule{DefaultRetention{Mode: strings.ToUpper(conf.Mode), Days: conf.Days}}
+	}
+	return r
+}
+
+func (r *ObjectLockConfiguration) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write(cos.UnsafeB(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+// ToConf converts S3 configuration to AIS bucket props (to set);
+// validation is done by cmn.ObjectLockConf.
+func (r *ObjectLockConfiguration) ToConf() (*cmn.ObjectLockConfToSet, error) {
+	if r.ObjectLockEnabled != ObjLockEnabled {
+		return nil, fmt.Errorf("invalid ObjectLockEnabled %q (expecting %q)", r.ObjectLockEnabled, ObjLockEnabled)
+	}
+	var (
+		enabled = true
+		mode    string
+		days    int
+	)
+	if r.Rule != nil {
+		dr := &r.Rule.DefaultRetention
+		if (dr.Days == 0) == (dr.Years == 0) {
+			return nil, errors.New("default retention must specify either Days or Years")
+		}
+		mode, days = strings.ToLower(dr.Mode), dr.Days
+		if dr.Years > 0 {
+			days = dr.Years * 365
+		}
+	}
+	return &cmn.ObjectLockConfToSet{Enabled: &enabled, Mode: &mode, Days: &days}, nil
+}
+
+///////////////
+// Retention //
+///////////////
+
+func NewRetention(ret *cmn.ObjRetention) *Retention {
+	r := &Retention{Ns: s3Namespace}
+	if !ret.RetainUntil.IsZero() {
+		r.Mode = strings.ToUpper(ret.Mode)
+		r.RetainUntilDate = ret.RetainUntil.UTC().Format(time.RFC3339)
+	}
+	return r
+}
+
+func (r *Retention) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write(cos.UnsafeB(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+// ToRetention converts; empty retention (no date) removes it
+func (r *Retention) ToRetention() (*cmn.ObjRetention, error) {
+	ret := &cmn.ObjRetention{Mode: strings.ToLower(r.Mode)}
+	if r.RetainUntilDate == "" {
+		return ret, nil
+	}
+	until, err := cmn.ParseRetainUntil(r.RetainUntilDate)
+	if err != nil {
+		return nil, err
+	}
+	ret.RetainUntil = until
+	return ret, nil
+}
+
+///////////////
+// LegalHold //
+///////////////
+
+func NewLegalHold(on bool) *LegalHold {
+	r := &LegalHold{Ns: s3Namespace, Status: LegalHoldOFF}
+	if on {
+		r.Status = LegalHoldON
+	}
+	return r
+}
+
+func (r *LegalHold) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write(cos.UnsafeB(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+func (r *LegalHold) On() (bool, error) { return parseLegalHold(r.Status) }
+
+func parseLegalHold(s string) (bool, error) {
+	switch s {
+	case LegalHoldON:
+		return true, nil
+	case LegalHoldOFF:
+		return false, nil
+	default:
+		return false, fmt.Errorf("invalid legal hold status %q (expecting %q or %q)", s, LegalHoldON, LegalHoldOFF)
+	}
+}
+
+/////////////
+// headers //
+/////////////
+
+// ObjLockFromHeaders parses PUT object's retention and legal hold (custom metadata), if any
+func ObjLockFromHeaders(hdr http.Header) (cos.StrKVs, error) {
+	var (
+		mode  = hdr.Get(HdrObjLockMode)
+		until = hdr.Get(HdrObjLockRetainUntil)
+		hold  = hdr.Get(HdrObjLockLegalHold)
+	)
+	if mode == "" && until == "" && hold == "" {
+		return nil, nil
+	}
+	custom := make(cos.StrKVs, 3)
+	if mode != "" || until != "" {
+		if mode == "" || until == "" {
+			return nil, fmt.Errorf("%s and %s must be specified together", HdrObjLockMode, HdrObjLockRetainUntil)
+		}
+		to, err := (&Retention{Mode: mode, RetainUntilDate: until}).ToRetention()
+		if err != nil {
+			return nil, err
+		}
+		var cur cmn.ObjRetention
+		if err := cur.ValidateUpdate(to, time.Now(), false); err != nil {
+			return nil, err
+		}
+		to.ToMD(custom)
+	}
+	if hold != "" {
+		on, err := parseLegalHold(hold)
+		if err != nil {
+			return nil, err
+		}
+		if on {
+			custom[cmn.LegalHoldObjMD] = cmn.LegalHoldOn
+		}
+	}
+	return custom, nil
+}
+
+func setObjLockHeaders(hdr http.Header, custom cos.StrKVs) {
+	ret := cmn.ObjRetentionFromMD(custom)
+	if !ret.RetainUntil.IsZero() {
+		hdr.Set(HdrObjLockMode, strings.ToUpper(ret.Mode))
+		hdr.Set(HdrObjLockRetainUntil, ret.RetainUntil.UTC().Format(time.RFC3339))
+	}
+	if ret.LegalHold {
+		hdr.Set(HdrObjLockLegalHold, LegalHoldON)
+	}
+}
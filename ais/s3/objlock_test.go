@@ -0,0 +1,95 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/memsys"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ObjectLock", func() {
+	It("converts bucket configuration", func() {
+		lconf := &s3.ObjectLockConfiguration{}
+		Expect(xml.Unmarshal([]byte(`<ObjectLockConfiguration>
+  <ObjectLockEnabled>Enabled</ObjectLockEnabled>
+  <Rule><DefaultRetention><Mode>COMPLIANCE</Mode><Years>2</Years></DefaultRetention></Rule>
+</ObjectLockConfiguration>`), lconf)).To(Succeed())
+		toSet, err := lconf.ToConf()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*toSet.Enabled).To(BeTrue())
+		Expect(*toSet.Mode).To(Equal(cmn.ObjLockCompliance))
+		Expect(*toSet.Days).To(Equal(730))
+
+		for _, body := range []string{
+			`<ObjectLockConfiguration><ObjectLockEnabled>Disabled</ObjectLockEnabled></ObjectLockConfiguration>`,
+			`<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled><Rule><DefaultRetention><Mode>GOVERNANCE</Mode>` +
+				`<Days>1</Days><Years>1</Years></DefaultRetention></Rule></ObjectLockConfiguration>`,
+		} {
+			lconf := &s3.ObjectLockConfiguration{}
+			Expect(xml.Unmarshal([]byte(body), lconf)).To(Succeed())
+			_, err := lconf.ToConf()
+			Expect(err).To(HaveOccurred())
+		}
+	})
+
+	It("round-trips retention", func() {
+		until := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+		sgl := memsys.PageMM().NewSGL(0)
+		defer sgl.Free()
+		s3.NewRetention(&cmn.ObjRetention{RetainUntil: until, Mode: cmn.ObjLockGovernance}).MustMarshal(sgl)
+		b, err := io.ReadAll(sgl)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(b)).To(ContainSubstring("<Mode>GOVERNANCE</Mode>"))
+
+		ret := &s3.Retention{}
+		Expect(xml.Unmarshal(b, ret)).To(Succeed())
+		parsed, err := ret.ToRetention()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parsed.Mode).To(Equal(cmn.ObjLockGovernance))
+		Expect(parsed.RetainUntil.Equal(until)).To(BeTrue())
+	})
+
+	It("parses legal hold", func() {
+		lh := &s3.LegalHold{}
+		Expect(xml.Unmarshal([]byte(`<LegalHold><Status>ON</Status></LegalHold>`), lh)).To(Succeed())
+		on, err := lh.On()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(on).To(BeTrue())
+
+		lh.Status = "maybe"
+		_, err = lh.On()
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("parses PUT object headers", func() {
+		until := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+		hdr := http.Header{}
+		hdr.Set(s3.HdrObjLockMode, "COMPLIANCE")
+		hdr.Set(s3.HdrObjLockRetainUntil, until)
+		hdr.Set(s3.HdrObjLockLegalHold, s3.LegalHoldON)
+		custom, err := s3.ObjLockFromHeaders(hdr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(custom[cmn.RetainModeObjMD]).To(Equal(cmn.ObjLockCompliance))
+		Expect(custom[cmn.RetainUntilObjMD]).To(Equal(until))
+		Expect(custom[cmn.LegalHoldObjMD]).To(Equal(cmn.LegalHoldOn))
+
+		hdr.Del(s3.HdrObjLockMode)
+		_, err = s3.ObjLockFromHeaders(hdr)
+		Expect(err).To(HaveOccurred())
+
+		custom, err = s3.ObjLockFromHeaders(http.Header{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(custom).To(BeNil())
+	})
+})
@@ -0,0 +1,325 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Bucket policy: S3 JSON <=> cmn.PolicyConf
+// Supported subset:
+// - Effect, Principal ("*" or {"AWS": user-ID(s)}), Action (see cmn.PolicyActionToAccess), Resource
+// - resources: "arn:aws:s3:::BUCKET" and "arn:aws:s3:::BUCKET/*" (entire bucket), "arn:aws:s3:::BUCKET/PREFIX*"
+// - principals: AIS user IDs (authN token subjects)
+// Not supported: NotPrincipal, NotAction, NotResource, and Condition.
+// See https://docs.aws.amazon.com/AmazonS3/latest/userguide/bucket-policies.html
+//
+// Bucket ACL: canned ACLs only (x-amz-acl header)
+// - private:                      removes canned-ACL statements from the bucket policy
+// - public-read, public-read-write: grant "ro" and "rw", respectively, to all principals including anonymous
+// - authenticated-read:           "ro" to all authenticated principals
+// See https://docs.aws.amazon.com/AmazonS3/latest/userguide/acl-overview.html#canned-acl
+
+const (
+	NoSuchBucketPolicy     = "NoSuchBucketPolicy"
+	ErrCodeMalformedPolicy = "MalformedPolicy"
+
+	policyVersion = "2012-10-17"
+	arnPrefix     = "arn:aws:s3:::"
+)
+
+const (
+	HdrACL = "x-amz-acl"
+
+	ACLPrivate           = "private"
+	ACLPublicRead        = "public-read"
+	ACLPublicReadWrite   = "public-read-write"
+	ACLAuthenticatedRead = "authenticated-read"
+
+	// policy statements that implement canned ACLs
+	aclSidPrefix = "acl:"
+
+	// ACL grantees
+	allUsersURI  = "http://acs.amazonaws.com/groups/global/AllUsers"
+	authUsersURI = "http://acs.amazonaws.com/groups/global/AuthenticatedUsers"
+)
+
+type (
+	BucketPolicy struct {
+		Version   string       `json:"Version,omitempty"`
+		ID        string       `json:"Id,omitempty"`
+		Statement []PolicyStmt `json:"Statement"`
+	}
+	PolicyStmt struct {
+		Sid       string          `json:"Sid,omitempty"`
+		Effect    string          `json:"Effect"`
+		Principal PolicyPrincipal `json:"Principal"`
+		Action    strList         `json:"Action"`
+		Resource  strList         `json:"Resource"`
+
+		// not supported (presence is detected and rejected)
+		NotPrincipal json.RawMessage `json:"NotPrincipal,omitempty"`
+		NotAction    json.RawMessage `json:"NotAction,omitempty"`
+		NotResource  json.RawMessage `json:"NotResource,omitempty"`
+		Condition    json.RawMessage `json:"Condition,omitempty"`
+	}
+
+	// "*" or {"AWS": "user" | ["user", ...]}
+	PolicyPrincipal struct {
+		users       []string
+		unsupported string // principal type other than "AWS"
+	}
+
+	// string or list of strings
+	strList []string
+)
+
+type (
+	AccessControlPolicy struct {
+		XMLName xml.Name  `xml:"AccessControlPolicy"`
+		Ns      string    `xml:"xmlns,attr,omitempty"`
+		Owner   BckOwner  `xml:"Owner"`
+		Grants  []ACLGrnt `xml:"AccessControlList>Grant"`
+	}
+	ACLGrnt struct {
+		Grantee    ACLGrantee `xml:"Grantee"`
+		Permission string     `xml:"Permission"`
+	}
+	ACLGrantee struct {
+		XMLNS       string `xml:"xmlns:xsi,attr"`
+		Type        string `xml:"xsi:type,attr"`
+		ID          string `xml:"ID,omitempty"`
+		DisplayName string `xml:"DisplayName,omitempty"`
+		URI         string `xml:"URI,omitempty"`
+	}
+)
+
+// ErrPolicyNotImpl is returned when the policy contains valid S3 elements
+// that AIS does not (yet) support.
+type ErrPolicyNotImpl struct {
+	what string
+}
+
+func (e *ErrPolicyNotImpl) Error() string {
+	return "bucket policy: " + e.what + " is not supported"
+}
+
+func IsErrPolicyNotImpl(err error) bool {
+	var e *ErrPolicyNotImpl
+	return errors.As(err, &e)
+}
+
+func (l *strList) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*l = strList{s}
+		return nil
+	}
+	var ls []string
+	if err := json.Unmarshal(b, &ls); err != nil {
+		return errors.New("expecting string or list of strings")
+	}
+	*l = ls
+	return nil
+}
+
+func (pp *PolicyPrincipal) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		if s != cmn.PolicyAnyPrincipal {
+			return fmt.Errorf("invalid principal %q (expecting %q or {\"AWS\": ...})", s, cmn.PolicyAnyPrincipal)
+		}
+		pp.users = []string{s}
+		return nil
+	}
+	var m map[string]strList
+	if err := json.Unmarshal(b, &m); err != nil {
+		return errors.New("invalid principal: expecting \"*\" or {\"AWS\": ...}")
+	}
+	for k, v := range m {
+		if k != "AWS" {
+			pp.unsupported = k // (reported by ToStatements)
+			continue
+		}
+		pp.users = append(pp.users, v...)
+	}
+	return nil
+}
+
+func (pp PolicyPrincipal) MarshalJSON() ([]byte, error) {
+	if len(pp.users) == 1 && pp.users[0] == cmn.PolicyAnyPrincipal {
+		return json.Marshal(pp.users[0])
+	}
+	return json.Marshal(map[string][]string{"AWS": pp.users})
+}
+
+func NewBucketPolicy(bucket string, conf *cmn.PolicyConf) *BucketPolicy {
+	r := &BucketPolicy{Version: policyVersion, Statement: make([]PolicyStmt, 0, len(conf.Statements))}
+	for i := range conf.Statements {
+		src := &conf.Statements[i]
+		stmt := PolicyStmt{
+			Sid:       src.Sid,
+			Effect:    strings.ToUpper(src.Effect[:1]) + strings.ToLower(src.Effect[1:]),
+			Principal: PolicyPrincipal{users: src.Principals},
+			Action:    src.Actions,
+			Resource:  make(strList, 0, len(src.Resources)),
+		}
+		for _, res := range src.Resources {
+			stmt.Resource = append(stmt.Resource, arnPrefix+bucket+"/"+res)
+		}
+		r.Statement = append(r.Statement, stmt)
+	}
+	return r
+}
+
+func (r *BucketPolicy) MustMarshal(sgl *memsys.SGL) {
+	err := json.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+// ToStatements converts S3 bucket policy to AIS policy statements;
+// semantic validation is done by cmn.PolicyConf.
+func (r *BucketPolicy) ToStatements(bucket string) ([]cmn.PolicyStatement, error) {
+	stmts := make([]cmn.PolicyStatement, 0, len(r.Statement))
+	for i := range r.Statement {
+		src := &r.Statement[i]
+		switch {
+		case len(src.NotPrincipal) > 0:
+			return nil, &ErrPolicyNotImpl{what: "NotPrincipal"}
+		case len(src.NotAction) > 0:
+			return nil, &ErrPolicyNotImpl{what: "NotAction"}
+		case len(src.NotResource) > 0:
+			return nil, &ErrPolicyNotImpl{what: "NotResource"}
+		case len(src.Condition) > 0:
+			return nil, &ErrPolicyNotImpl{what: "Condition"}
+		case src.Principal.unsupported != "":
+			return nil, &ErrPolicyNotImpl{what: "principal type " + src.Principal.unsupported}
+		}
+		stmt := cmn.PolicyStatement{
+			Sid:        src.Sid,
+			Effect:     strings.ToLower(src.Effect),
+			Principals: src.Principal.users,
+			Actions:    src.Action,
+			Resources:  make([]string, 0, len(src.Resource)),
+		}
+		for _, arn := range src.Resource {
+			res, err := arnToResource(bucket, arn)
+			if err != nil {
+				return nil, err
+			}
+			stmt.Resources = append(stmt.Resources, res)
+		}
+		stmts = append(stmts, stmt)
+	}
+	return stmts, nil
+}
+
+func arnToResource(bucket, arn string) (string, error) {
+	s, ok := strings.CutPrefix(arn, arnPrefix)
+	if !ok {
+		return "", fmt.Errorf("invalid resource %q: expecting %q prefix", arn, arnPrefix)
+	}
+	name, res, _ := strings.Cut(s, "/")
+	if name != bucket {
+		return "", fmt.Errorf("invalid resource %q: bucket name does not match %q", arn, bucket)
+	}
+	if res == "" {
+		res = cmn.PolicyWildcard // bucket itself
+	}
+	return res, nil
+}
+
+//
+// canned ACL
+//
+
+func IsCannedACL(acl string) bool {
+	switch acl {
+	case ACLPrivate, ACLPublicRead, ACLPublicReadWrite, ACLAuthenticatedRead:
+		return true
+	}
+	return false
+}
+
+// ApplyCannedACL replaces canned-ACL statements (if any) in the given
+// policy statements, and returns the result.
+func ApplyCannedACL(stmts []cmn.PolicyStatement, acl string) []cmn.PolicyStatement {
+	debug.Assert(IsCannedACL(acl), acl)
+	out := make([]cmn.PolicyStatement, 0, len(stmts)+1)
+	for i := range stmts {
+		if !strings.HasPrefix(stmts[i].Sid, aclSidPrefix) {
+			out = append(out, stmts[i])
+		}
+	}
+	var (
+		action    = "ro"
+		principal = cmn.PolicyAnyPrincipal
+	)
+	switch acl {
+	case ACLPrivate:
+		return out
+	case ACLPublicReadWrite:
+		action = "rw"
+	case ACLAuthenticatedRead:
+		principal = cmn.PolicyAuthPrincipal
+	}
+	return append(out, cmn.PolicyStatement{
+		Sid:        aclSidPrefix + acl,
+		Effect:     cmn.PolicyAllow,
+		Principals: []string{principal},
+		Actions:    []string{action},
+		Resources:  []string{cmn.PolicyWildcard},
+	})
+}
+
+// returns canned ACL currently in effect (see ApplyCannedACL)
+func CannedACL(stmts []cmn.PolicyStatement) string {
+	for i := range stmts {
+		if acl, ok := strings.CutPrefix(stmts[i].Sid, aclSidPrefix); ok {
+			return acl
+		}
+	}
+	return ACLPrivate
+}
+
+// NOTE: the owner is the cluster itself (compare with NewListBucketResult)
+func NewAccessControlPolicy(stmts []cmn.PolicyStatement) *AccessControlPolicy {
+	const xsi = "http://www.w3.org/2001/XMLSchema-instance"
+	r := &AccessControlPolicy{
+		Ns:    s3Namespace,
+		Owner: BckOwner{ID: "1", Name: AISServer},
+	}
+	r.Grants = append(r.Grants, ACLGrnt{
+		Grantee:    ACLGrantee{XMLNS: xsi, Type: "CanonicalUser", ID: r.Owner.ID, DisplayName: r.Owner.Name},
+		Permission: "FULL_CONTROL",
+	})
+	switch CannedACL(stmts) {
+	case ACLPublicRead:
+		r.Grants = append(r.Grants, ACLGrnt{Grantee: ACLGrantee{XMLNS: xsi, Type: "Group", URI: allUsersURI}, Permission: "READ"})
+	case ACLPublicReadWrite:
+		r.Grants = append(r.Grants,
+			ACLGrnt{Grantee: ACLGrantee{XMLNS: xsi, Type: "Group", URI: allUsersURI}, Permission: "READ"},
+			ACLGrnt{Grantee: ACLGrantee{XMLNS: xsi, Type: "Group", URI: allUsersURI}, Permission: "WRITE"},
+		)
+	case ACLAuthenticatedRead:
+		r.Grants = append(r.Grants, ACLGrnt{Grantee: ACLGrantee{XMLNS: xsi, Type: "Group", URI: authUsersURI}, Permission: "READ"})
+	}
+	return r
+}
+
+func (r *AccessControlPolicy) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write(cos.UnsafeB(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
@@ -0,0 +1,108 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/memsys"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BucketPolicy", func() {
+	const bucket = "abc"
+
+	It("converts S3 bucket policy", func() {
+		bpolicy := &s3.BucketPolicy{}
+		Expect(json.Unmarshal([]byte(`{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Sid": "public", "Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::abc/public/*"},
+    {"Effect": "Deny", "Principal": {"AWS": ["alice", "bob"]}, "Action": ["s3:DeleteObject", "s3:PutObject"],
+     "Resource": ["arn:aws:s3:::abc", "arn:aws:s3:::abc/*"]}
+  ]
+}`), bpolicy)).To(Succeed())
+		stmts, err := bpolicy.ToStatements(bucket)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stmts).To(HaveLen(2))
+		Expect(stmts[0]).To(Equal(cmn.PolicyStatement{
+			Sid: "public", Effect: "allow", Principals: []string{"*"}, Actions: []string{"s3:GetObject"}, Resources: []string{"public/*"},
+		}))
+		Expect(stmts[1].Effect).To(Equal("deny"))
+		Expect(stmts[1].Principals).To(ConsistOf("alice", "bob"))
+		Expect(stmts[1].Resources).To(Equal([]string{"*", "*"}))
+
+		conf := &cmn.PolicyConf{Statements: stmts}
+		Expect(conf.ValidateAsProps()).NotTo(HaveOccurred())
+	})
+
+	It("rejects unsupported elements and foreign resources", func() {
+		for _, body := range []string{
+			`{"Statement": [{"Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::abc/*", "Condition": {"Bool": {"aws:SecureTransport": "false"}}}]}`,
+			`{"Statement": [{"Effect": "Allow", "NotPrincipal": {"AWS": "x"}, "Action": "s3:GetObject", "Resource": "arn:aws:s3:::abc/*"}]}`,
+			`{"Statement": [{"Effect": "Allow", "Principal": {"Service": "x"}, "Action": "s3:GetObject", "Resource": "arn:aws:s3:::abc/*"}]}`,
+		} {
+			bpolicy := &s3.BucketPolicy{}
+			Expect(json.Unmarshal([]byte(body), bpolicy)).To(Succeed())
+			_, err := bpolicy.ToStatements(bucket)
+			Expect(s3.IsErrPolicyNotImpl(err)).To(BeTrue())
+		}
+
+		bpolicy := &s3.BucketPolicy{}
+		Expect(json.Unmarshal([]byte(`{"Statement": [{"Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::xyz/*"}]}`), bpolicy)).To(Succeed())
+		_, err := bpolicy.ToStatements(bucket)
+		Expect(err).To(HaveOccurred())
+		Expect(s3.IsErrPolicyNotImpl(err)).To(BeFalse())
+	})
+
+	It("round-trips", func() {
+		conf := &cmn.PolicyConf{Statements: []cmn.PolicyStatement{
+			{Effect: "allow", Principals: []string{"*"}, Actions: []string{"ro"}, Resources: []string{"*"}},
+			{Effect: "deny", Principals: []string{"bob"}, Actions: []string{"s3:DeleteObject"}, Resources: []string{"logs/*"}},
+		}}
+		sgl := memsys.PageMM().NewSGL(0)
+		defer sgl.Free()
+		s3.NewBucketPolicy(bucket, conf).MustMarshal(sgl)
+		b, err := io.ReadAll(sgl)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(b)).To(ContainSubstring(`"arn:aws:s3:::abc/logs/*"`))
+
+		bpolicy := &s3.BucketPolicy{}
+		Expect(json.Unmarshal(b, bpolicy)).To(Succeed())
+		stmts, err := bpolicy.ToStatements(bucket)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stmts).To(Equal(conf.Statements))
+	})
+})
+
+var _ = Describe("Canned ACL", func() {
+	It("replaces canned-ACL statements and keeps the rest", func() {
+		stmts := []cmn.PolicyStatement{
+			{Effect: "deny", Principals: []string{"bob"}, Actions: []string{"GET"}, Resources: []string{"*"}},
+		}
+		Expect(s3.CannedACL(stmts)).To(Equal(s3.ACLPrivate))
+
+		stmts = s3.ApplyCannedACL(stmts, s3.ACLPublicRead)
+		Expect(stmts).To(HaveLen(2))
+		Expect(s3.CannedACL(stmts)).To(Equal(s3.ACLPublicRead))
+
+		stmts = s3.ApplyCannedACL(stmts, s3.ACLPublicReadWrite)
+		Expect(stmts).To(HaveLen(2))
+		Expect(s3.CannedACL(stmts)).To(Equal(s3.ACLPublicReadWrite))
+		conf := &cmn.PolicyConf{Statements: stmts}
+		Expect(conf.ValidateAsProps()).NotTo(HaveOccurred())
+
+		stmts = s3.ApplyCannedACL(stmts, s3.ACLPrivate)
+		Expect(stmts).To(HaveLen(1))
+		Expect(stmts[0].Principals).To(Equal([]string{"bob"}))
+
+		Expect(s3.IsCannedACL("bucket-owner-full-control")).To(BeFalse())
+	})
+})
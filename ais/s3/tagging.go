@@ -0,0 +1,66 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"fmt"
+	"sort"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Object tagging: S3 XML <=> object tags (cmn.TagsObjMD)
+// See https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectTagging.html
+
+const (
+	HdrTagging      = "x-amz-tagging"       // PUT object: URL-query encoded tags
+	HdrTaggingCount = "x-amz-tagging-count" // GET and HEAD object: number of tags, if any
+)
+
+type (
+	Tagging struct {
+		XMLName xml.Name `xml:"Tagging"`
+		Ns      string   `xml:"xmlns,attr,omitempty"`
+		TagSet  TagSet   `xml:"TagSet"`
+	}
+	TagSet struct {
+		Tags []Tag `xml:"Tag"`
+	}
+	Tag struct {
+		Key   string `xml:"Key"`
+		Value string `xml:"Value"`
+	}
+)
+
+func NewTagging(tags cos.StrKVs) *Tagging {
+	r := &Tagging{Ns: s3Namespace, TagSet: TagSet{Tags: make([]Tag, 0, len(tags))}}
+	for k, v := range tags {
+		r.TagSet.Tags = append(r.TagSet.Tags, Tag{Key: k, Value: v})
+	}
+	sort.Slice(r.TagSet.Tags, func(i, j int) bool { return r.TagSet.Tags[i].Key < r.TagSet.Tags[j].Key })
+	return r
+}
+
+func (r *Tagging) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write(cos.UnsafeB(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+// ToTags converts and validates
+func (r *Tagging) ToTags() (cos.StrKVs, error) {
+	tags := make(cos.StrKVs, len(r.TagSet.Tags))
+	for _, tag := range r.TagSet.Tags {
+		if _, ok := tags[tag.Key]; ok {
+			return nil, fmt.Errorf("object tags: duplicate key %q", tag.Key)
+		}
+		tags[tag.Key] = tag.Value
+	}
+	return tags, cmn.ValidateObjTags(tags)
+}
@@ -0,0 +1,60 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"encoding/xml"
+	"io"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/memsys"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Tagging", func() {
+	It("converts S3 tag set", func() {
+		tagging := &s3.Tagging{}
+		Expect(xml.Unmarshal([]byte(`<Tagging xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <TagSet>
+    <Tag><Key>class</Key><Value>pii</Value></Tag>
+    <Tag><Key>retention</Key><Value>7y</Value></Tag>
+  </TagSet>
+</Tagging>`), tagging)).To(Succeed())
+		tags, err := tagging.ToTags()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tags).To(Equal(cos.StrKVs{"class": "pii", "retention": "7y"}))
+	})
+
+	It("rejects duplicate and empty keys", func() {
+		for _, body := range []string{
+			`<Tagging><TagSet><Tag><Key>a</Key><Value>1</Value></Tag><Tag><Key>a</Key><Value>2</Value></Tag></TagSet></Tagging>`,
+			`<Tagging><TagSet><Tag><Key></Key><Value>1</Value></Tag></TagSet></Tagging>`,
+		} {
+			tagging := &s3.Tagging{}
+			Expect(xml.Unmarshal([]byte(body), tagging)).To(Succeed())
+			_, err := tagging.ToTags()
+			Expect(err).To(HaveOccurred())
+		}
+	})
+
+	It("round-trips", func() {
+		tags := cos.StrKVs{"b": "2", "a": "1"}
+		sgl := memsys.PageMM().NewSGL(0)
+		defer sgl.Free()
+		s3.NewTagging(tags).MustMarshal(sgl)
+		b, err := io.ReadAll(sgl)
+		Expect(err).NotTo(HaveOccurred())
+
+		tagging := &s3.Tagging{}
+		Expect(xml.Unmarshal(b, tagging)).To(Succeed())
+		Expect(tagging.TagSet.Tags[0].Key).To(Equal("a"))
+		out, err := tagging.ToTags()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(Equal(tags))
+	})
+})
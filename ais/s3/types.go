@@ -5,10 +5,14 @@
 package s3
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"encoding/xml"
+	"fmt"
 	"net/http"
 	"net/url"
 	"path"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -34,18 +38,26 @@ import (
 type (
 	// List objects response — emits <ListBucketResult> per AWS S3 ListObjectsV2 spec
 	// https://docs.aws.amazon.com/AmazonS3/latest/API/API_ListObjectsV2.html#API_ListObjectsV2_ResponseSyntax
+	// (and ListObjects V1 - the `Marker` variant of the same)
 	ListObjectResult struct {
 		XMLName               xml.Name        `xml:"ListBucketResult"`
 		Name                  string          `xml:"Name"`
 		Ns                    string          `xml:"xmlns,attr"`
 		Prefix                string          `xml:"Prefix"`
-		ContinuationToken     string          `xml:"ContinuationToken"`               // original
+		Delimiter             string          `xml:"Delimiter,omitempty"`
+		EncodingType          string          `xml:"EncodingType,omitempty"`
+		Marker                string          `xml:"Marker,omitempty"`                // V1
+		NextMarker            string          `xml:"NextMarker,omitempty"`            // V1: to read the next page (with delimiter)
+		StartAfter            string          `xml:"StartAfter,omitempty"`            // V2
+		ContinuationToken     string          `xml:"ContinuationToken,omitempty"`     // original (V2)
 		NextContinuationToken string          `xml:"NextContinuationToken,omitempty"` // to read the next page
 		Contents              []*ObjInfo      `xml:"Contents"`                        // list of object
-		CommonPrefixes        []*CommonPrefix `xml:"CommonPrefixes,omitempty"`        // list of dirs (used with `apc.LsNoRecursion`)
-		KeyCount              int             `xml:"KeyCount"`                        // number of object names in the response
+		CommonPrefixes        []*CommonPrefix `xml:"CommonPrefixes,omitempty"`        // keys rolled up by delimiter
+		KeyCount              int             `xml:"KeyCount"`                        // number of keys and common prefixes in the response
 		MaxKeys               int             `xml:"MaxKeys"`                         // "The maximum number of keys returned ..."
 		IsTruncated           bool            `xml:"IsTruncated"`                     // true if there are more pages to read
+		last                  string          // last key or common prefix added (see Add)
+		native                string          // native continuation token of the page that filled the result
 	}
 	ObjInfo struct {
 		Key          string `xml:"Key"`
@@ -58,6 +70,29 @@ type (
 		Prefix string `xml:"Prefix"`
 	}
 
+	// ListObjects(V2) query parameters that are applied on top of the native list-objects:
+	// - delimiter: roll up keys (that contain it past the prefix) into common prefixes, at any depth;
+	// - marker (V1) or start-after (V2): skip keys, and common prefixes, that sort at or before it;
+	// - max-keys: at most as many keys and common prefixes (combined) per response;
+	// - continuation-token (V2): resume past the previous response (see lsoToken);
+	// - encoding-type=url: URL-encode keys and prefixes in the response
+	LsoQuery struct {
+		Prefix    string
+		Delimiter string
+		Marker    string // V1 marker or V2 start-after, as specified
+		Encoding  string
+		after     string // normalized marker or continuation (see NewLsoQuery)
+		MaxKeys   int
+		V2        bool // list-type=2
+	}
+
+	// V2 continuation token (opaque to clients): resume listing past `After` - the last
+	// key or common prefix returned - with the native list-objects page `Native`, if any
+	lsoToken struct {
+		After  string `json:"a"`
+		Native string `json:"t,omitempty"`
+	}
+
 	// Response for object copy request — emits <CopyObjectResult> per AWS S3 CopyObject spec
 	// https://docs.aws.amazon.com/AmazonS3/latest/API/API_CopyObject.html#API_CopyObject_ResponseSyntax
 	CopyObjectResult struct {
@@ -156,37 +191,105 @@ func FillLsoMsg(query url.Values, msg *apc.LsoMsg) {
 	if after := query.Get(QparamStartAfter); after != "" && token == "" {
 		msg.StartAfter = after
 	}
-	// TODO: check that the delimiter is '/' and raise an error otherwise
-	if delimiter := query.Get(QparamDelimiter); delimiter != "" {
+	// native non-recursive listing is used only when it is exactly equivalent
+	// to rolling up with '/' - arbitrary delimiters are handled by LsoQuery
+	if query.Get(QparamDelimiter) == "/" && (msg.Prefix == "" || cos.IsLastB(msg.Prefix, '/')) {
 		msg.SetFlag(apc.LsNoRecursion)
 	}
 }
 
-func NewListObjectResult(bucket string) *ListObjectResult {
-	return &ListObjectResult{
-		Name:     bucket,
-		Ns:       s3Namespace,
-		MaxKeys:  apc.MaxPageSizeAWS,
-		Contents: make([]*ObjInfo, 0, apc.MaxPageSizeAWS),
+// parse and validate ListObjects(V2) query, and fill in the native list-objects message;
+// NOTE: the caller decides whether to also push `msg.StartAfter` down to the targets
+// (via LsoQuery.After) - the result is correct either way
+func NewLsoQuery(query url.Values, msg *apc.LsoMsg) (*LsoQuery, error) {
+	lsq := &LsoQuery{
+		Prefix:    query.Get(QparamPrefix),
+		Delimiter: query.Get(QparamDelimiter),
+		Encoding:  query.Get(QparamEncodingType),
+		MaxKeys:   apc.MaxPageSizeAWS,
+		V2:        query.Get(QparamListType) == "2",
+	}
+	if lsq.Encoding != "" && lsq.Encoding != EncodingTypeURL {
+		return nil, fmt.Errorf("invalid %s %q (expecting %q)", QparamEncodingType, lsq.Encoding, EncodingTypeURL)
+	}
+	if v := query.Get(QparamMaxKeys); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid %s %q", QparamMaxKeys, v)
+		}
+		lsq.MaxKeys = min(n, apc.MaxPageSizeAWS)
+	}
+	FillLsoMsg(query, msg)
+	if lsq.V2 {
+		lsq.Marker = query.Get(QparamStartAfter)
+	} else {
+		lsq.Marker = query.Get(QparamMarker)
+	}
+	msg.StartAfter, msg.ContinuationToken = "", ""
+	lsq.after = lsq.Marker
+
+	// continuation-token takes precedence over start-after
+	if token := query.Get(QparamContinuationToken); lsq.V2 && token != "" {
+		var tok lsoToken
+		b, err := base64.StdEncoding.DecodeString(token)
+		if err == nil {
+			err = json.Unmarshal(b, &tok)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q", QparamContinuationToken, token)
+		}
+		lsq.after, msg.ContinuationToken = tok.After, tok.Native
+	}
+
+	// marker that is itself a common prefix (e.g., V1 NextMarker) skips the entire prefix
+	if p := lsq.rollup(lsq.after); p != "" && p == lsq.after {
+		lsq.after += "\xff"
+	}
+	return lsq, nil
+}
+
+// to skip, natively, keys that sort at or before the marker
+func (lsq *LsoQuery) After() string { return lsq.after }
+
+// returns common prefix that the name rolls up into, or empty string
+func (lsq *LsoQuery) rollup(name string) string {
+	if lsq.Delimiter == "" || !strings.HasPrefix(name, lsq.Prefix) {
+		return ""
 	}
+	i := strings.Index(name[len(lsq.Prefix):], lsq.Delimiter)
+	if i < 0 {
+		return ""
+	}
+	return name[:len(lsq.Prefix)+i+len(lsq.Delimiter)]
 }
 
-func (r *ListObjectResult) MustMarshal(sgl *memsys.SGL) {
-	sgl.Write(cos.UnsafeB(xml.Header))
-	err := xml.NewEncoder(sgl).Encode(r)
-	debug.AssertNoErr(err)
+// directory (non-recursive listing) is listed as common prefix unless all its keys
+// sort at or before the marker (UTF-8 object names never contain 0xff)
+func (lsq *LsoQuery) keepPrefix(prefix string) bool {
+	return lsq.after == "" || prefix+"\xff" > lsq.after
 }
 
-func (r *ListObjectResult) add(entry *cmn.LsoEnt) {
-	if entry.Flags&apc.EntryIsDir == 0 {
-		r.Contents = append(r.Contents, entryToS3(entry))
+func NewListObjectResult(bucket string, lsq *LsoQuery) *ListObjectResult {
+	r := &ListObjectResult{
+		Name:      bucket,
+		Ns:        s3Namespace,
+		Prefix:    lsq.Prefix,
+		Delimiter: lsq.Delimiter,
+		MaxKeys:   lsq.MaxKeys,
+		Contents:  make([]*ObjInfo, 0, lsq.MaxKeys),
+	}
+	if lsq.V2 {
+		r.StartAfter = lsq.Marker
 	} else {
-		prefix := entry.Name
-		if !cos.IsLastB(entry.Name, '/') {
-			prefix += "/"
-		}
-		r.CommonPrefixes = append(r.CommonPrefixes, &CommonPrefix{Prefix: prefix})
+		r.Marker = lsq.Marker
 	}
+	return r
+}
+
+func (r *ListObjectResult) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write(cos.UnsafeB(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
 }
 
 // Note: in S3 listings, xs/wanted_lso populates entry.Custom with ETag/LastModified
@@ -207,13 +310,99 @@ func entryToS3(entry *cmn.LsoEnt) (oi *ObjInfo) {
 	return oi
 }
 
-func (r *ListObjectResult) FromLsoResult(lst *cmn.LsoRes) {
-	r.KeyCount = len(lst.Entries)
-	r.IsTruncated = lst.ContinuationToken != ""
-	r.NextContinuationToken = lst.ContinuationToken
-	for _, e := range lst.Entries {
-		r.add(e)
+// Add translates a page of native list-objects entries: filters by marker, rolls up
+// common prefixes, and adds up to MaxKeys keys and common prefixes (combined);
+// returns true when the result is full and there's more to list (IsTruncated)
+//   - `native` is the continuation token the page was listed with;
+//   - native pages follow one another in lexicographic order, while entries within
+//     a page may not (e.g., directories first) - hence, sorting;
+//   - directories (that is, `apc.EntryIsDir` entries) are never listed as keys -
+//     only as common prefixes
+func (r *ListObjectResult) Add(entries cmn.LsoEntries, lsq *LsoQuery, native string) (full bool) {
+	type item struct {
+		e    *cmn.LsoEnt // nil for common prefix
+		name string
+	}
+	items := make([]item, 0, len(entries))
+	for _, e := range entries {
+		var (
+			name  = e.Name
+			isDir = e.IsAnyFlagSet(apc.EntryIsDir)
+		)
+		if isDir && !cos.IsLastB(name, '/') {
+			name += "/"
+		}
+		if !isDir && name <= lsq.after {
+			continue
+		}
+		if prefix := lsq.rollup(name); prefix != "" {
+			if isDir && !lsq.keepPrefix(prefix) {
+				continue
+			}
+			items = append(items, item{name: prefix})
+			continue
+		}
+		if !isDir {
+			items = append(items, item{e: e, name: name})
+		}
+	}
+	slices.SortStableFunc(items, func(a, b item) int { return strings.Compare(a.name, b.name) })
+
+	for _, it := range items {
+		if it.e == nil && it.name == r.last {
+			continue // (same common prefix)
+		}
+		if len(r.Contents)+len(r.CommonPrefixes) >= lsq.MaxKeys {
+			r.IsTruncated, r.native = true, native
+			return true
+		}
+		if it.e == nil {
+			r.CommonPrefixes = append(r.CommonPrefixes, &CommonPrefix{Prefix: it.name})
+		} else {
+			r.Contents = append(r.Contents, entryToS3(it.e))
+		}
+		r.last = it.name
+	}
+	return false
+}
+
+// Fini completes the result: counts, next page to read (if truncated), and encoding
+// - V1: NextMarker (as per S3 spec, only with delimiter - otherwise, the last key is the marker)
+// - V2: NextContinuationToken (see lsoToken)
+func (r *ListObjectResult) Fini(lsq *LsoQuery) {
+	r.KeyCount = len(r.Contents) + len(r.CommonPrefixes)
+	if r.IsTruncated {
+		if lsq.V2 {
+			b, err := json.Marshal(&lsoToken{After: r.last, Native: r.native})
+			debug.AssertNoErr(err)
+			r.NextContinuationToken = base64.StdEncoding.EncodeToString(b)
+		} else if lsq.Delimiter != "" {
+			r.NextMarker = r.last
+		}
+	}
+	if lsq.Encoding == EncodingTypeURL {
+		r.encode()
+	}
+}
+
+func (r *ListObjectResult) encode() {
+	r.EncodingType = EncodingTypeURL
+	r.Prefix, r.Delimiter = urlEncode(r.Prefix), urlEncode(r.Delimiter)
+	r.Marker, r.StartAfter = urlEncode(r.Marker), urlEncode(r.StartAfter)
+	r.NextMarker = urlEncode(r.NextMarker)
+	for _, oi := range r.Contents {
+		oi.Key = urlEncode(oi.Key)
 	}
+	for _, cp := range r.CommonPrefixes {
+		cp.Prefix = urlEncode(cp.Prefix)
+	}
+}
+
+// as in: encoding-type=url (keeping '/' intact and encoding space as %20)
+func urlEncode(s string) string {
+	s = url.QueryEscape(s)
+	s = strings.ReplaceAll(s, "+", "%20")
+	return strings.ReplaceAll(s, "%2F", "/")
 }
 
 func SetS3Headers(hdr http.Header, lom *core.LOM) {
@@ -238,12 +427,24 @@ func SetS3Headers(hdr http.Header, lom *core.LOM) {
 
 	// 3. x-amz-version-id
 	if hdr.Get(cos.S3VersionHeader) == "" {
-		if v, ok := lom.GetCustomKey(cmn.VersionObjMD); ok {
+		if lom.Bck().IsAIS() && lom.Bprops().Versioning.KeepHistory() {
+			hdr.Set(cos.S3VersionHeader, lom.Version())
+		} else if v, ok := lom.GetCustomKey(cmn.VersionObjMD); ok {
 			hdr.Set(cos.S3VersionHeader, v)
 		}
 	}
 
-	// 4. finally, user metadata (X-Amz-Meta-...)
+	// 4. x-amz-tagging-count
+	if tags, ok := lom.GetCustomKey(cmn.TagsObjMD); ok && tags != "" {
+		hdr.Set(HdrTaggingCount, strconv.Itoa(strings.Count(tags, "&")+1))
+	}
+
+	// 5. object lock (retention and legal hold), if any
+	if lom.Bprops().ObjectLock.Enabled {
+		setObjLockHeaders(hdr, lom.GetCustomMD())
+	}
+
+	// 6. finally, user metadata (X-Amz-Meta-...)
 	for k, v := range lom.GetCustomMD() {
 		if strings.HasPrefix(k, HeaderMetaPrefix) {
 			hdr.Set(k, v)
@@ -0,0 +1,268 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ListObjects", func() {
+	// sorted, as in: native list-objects
+	names := []string{
+		"a.txt",
+		"logs/2026-01-01/x",
+		"logs/2026-01-02/y",
+		"logs/2026-02-01/z",
+		"logs/readme",
+		"photos/2025/jan/1.jpg",
+		"photos/2025/jan/2.jpg",
+		"photos/2026/feb/3.jpg",
+		"photos/my pic+1.jpg",
+	}
+	recursive := func() *cmn.LsoRes {
+		lst := &cmn.LsoRes{}
+		for _, name := range names {
+			lst.Entries = append(lst.Entries, &cmn.LsoEnt{Name: name, Size: 1})
+		}
+		return lst
+	}
+
+	keys := func(resp *s3.ListObjectResult) (out []string) {
+		for _, oi := range resp.Contents {
+			out = append(out, oi.Key)
+		}
+		return out
+	}
+	prefixes := func(resp *s3.ListObjectResult) (out []string) {
+		for _, cp := range resp.CommonPrefixes {
+			out = append(out, cp.Prefix)
+		}
+		return out
+	}
+
+	list := func(lst *cmn.LsoRes, params ...string) (*s3.ListObjectResult, *apc.LsoMsg) {
+		q := url.Values{}
+		for i := 0; i < len(params); i += 2 {
+			q.Set(params[i], params[i+1])
+		}
+		lsmsg := &apc.LsoMsg{}
+		lsq, err := s3.NewLsoQuery(q, lsmsg)
+		Expect(err).NotTo(HaveOccurred())
+		if lsmsg.Prefix != "" {
+			lst.Entries = slices.DeleteFunc(lst.Entries, func(en *cmn.LsoEnt) bool { return !strings.HasPrefix(en.Name, lsmsg.Prefix) })
+		}
+		resp := s3.NewListObjectResult("bck", lsq)
+		resp.Add(lst.Entries, lsq, "")
+		resp.Fini(lsq)
+		return resp, lsmsg
+	}
+	// list the way proxy does (see listObjectsS3), with native pages of `psize` entries
+	// and native continuation token being the page number; follow NextMarker (V1)
+	// or NextContinuationToken (V2) until done
+	listAll := func(psize int, params ...string) (out []string, calls int) {
+		var next string
+		for {
+			q := url.Values{}
+			for i := 0; i < len(params); i += 2 {
+				q.Set(params[i], params[i+1])
+			}
+			v2 := q.Get(s3.QparamListType) == "2"
+			if next != "" {
+				if v2 {
+					q.Set(s3.QparamContinuationToken, next)
+				} else {
+					q.Set(s3.QparamMarker, next)
+				}
+			}
+			lsmsg := &apc.LsoMsg{}
+			lsq, err := s3.NewLsoQuery(q, lsmsg)
+			Expect(err).NotTo(HaveOccurred())
+			resp := s3.NewListObjectResult("bck", lsq)
+			pnum, _ := strconv.Atoi(lsmsg.ContinuationToken)
+			matching := slices.DeleteFunc(slices.Clone(names), func(name string) bool { return !strings.HasPrefix(name, lsmsg.Prefix) })
+			for ; pnum*psize < len(matching); pnum++ {
+				var entries cmn.LsoEntries
+				for _, name := range matching[pnum*psize : min((pnum+1)*psize, len(matching))] {
+					entries = append(entries, &cmn.LsoEnt{Name: name})
+				}
+				if resp.Add(entries, lsq, strconv.Itoa(pnum)) {
+					break
+				}
+			}
+			resp.Fini(lsq)
+			calls++
+			Expect(resp.KeyCount).To(BeNumerically("<=", resp.MaxKeys))
+			out = append(out, keys(resp)...)
+			out = append(out, prefixes(resp)...)
+			if !resp.IsTruncated {
+				Expect(resp.NextMarker).To(BeEmpty())
+				Expect(resp.NextContinuationToken).To(BeEmpty())
+				return out, calls
+			}
+			switch {
+			case v2:
+				next = resp.NextContinuationToken
+			case resp.NextMarker != "":
+				next = resp.NextMarker
+			default:
+				next = resp.Contents[len(resp.Contents)-1].Key
+			}
+			Expect(next).NotTo(BeEmpty())
+		}
+	}
+	It("lists all keys without delimiter", func() {
+		resp, lsmsg := list(recursive(), s3.QparamListType, "2")
+		Expect(keys(resp)).To(Equal(names))
+		Expect(resp.CommonPrefixes).To(BeEmpty())
+		Expect(resp.KeyCount).To(Equal(len(names)))
+		Expect(lsmsg.IsFlagSet(apc.LsNoRecursion)).To(BeFalse())
+	})
+
+	It("rolls up common prefixes at any depth", func() {
+		resp, lsmsg := list(recursive(), s3.QparamDelimiter, "/")
+		Expect(lsmsg.IsFlagSet(apc.LsNoRecursion)).To(BeTrue())
+		Expect(keys(resp)).To(Equal([]string{"a.txt"}))
+		Expect(prefixes(resp)).To(Equal([]string{"logs/", "photos/"}))
+
+		resp, _ = list(recursive(), s3.QparamDelimiter, "/", s3.QparamPrefix, "photos/2025/")
+		Expect(keys(resp)).To(BeEmpty())
+		Expect(prefixes(resp)).To(Equal([]string{"photos/2025/jan/"}))
+		Expect(resp.KeyCount).To(Equal(1))
+	})
+
+	It("handles non-directory prefixes and arbitrary delimiters", func() {
+		resp, lsmsg := list(recursive(), s3.QparamDelimiter, "/", s3.QparamPrefix, "photos/20")
+		Expect(lsmsg.IsFlagSet(apc.LsNoRecursion)).To(BeFalse())
+		Expect(prefixes(resp)).To(Equal([]string{"photos/2025/", "photos/2026/"}))
+
+		resp, lsmsg = list(recursive(), s3.QparamDelimiter, "-", s3.QparamPrefix, "logs/")
+		Expect(lsmsg.IsFlagSet(apc.LsNoRecursion)).To(BeFalse())
+		Expect(keys(resp)).To(Equal([]string{"logs/readme"}))
+		Expect(prefixes(resp)).To(Equal([]string{"logs/2026-"}))
+
+		resp, _ = list(recursive(), s3.QparamDelimiter, "-0", s3.QparamPrefix, "logs/2026")
+		Expect(prefixes(resp)).To(Equal([]string{"logs/2026-0"}))
+
+		resp, _ = list(recursive(), s3.QparamDelimiter, "jan/")
+		Expect(prefixes(resp)).To(Equal([]string{"photos/2025/jan/"}))
+		Expect(keys(resp)).To(HaveLen(len(names) - 2))
+	})
+
+	It("combines start-after with prefixes", func() {
+		resp, _ := list(recursive(), s3.QparamListType, "2", s3.QparamDelimiter, "/", s3.QparamStartAfter, "logs/2026-01-02/y")
+		Expect(resp.StartAfter).To(Equal("logs/2026-01-02/y"))
+		Expect(keys(resp)).To(BeEmpty())
+		Expect(prefixes(resp)).To(Equal([]string{"logs/", "photos/"}))
+
+		resp, _ = list(recursive(), s3.QparamListType, "2", s3.QparamDelimiter, "/", s3.QparamStartAfter, "logs/zzz")
+		Expect(prefixes(resp)).To(Equal([]string{"photos/"}))
+	})
+
+	It("skips the entire common prefix given V1 marker", func() {
+		resp, lsmsg := list(recursive(), s3.QparamDelimiter, "/", s3.QparamMarker, "logs/")
+		Expect(lsmsg.StartAfter).To(BeEmpty())
+		Expect(resp.Marker).To(Equal("logs/"))
+		Expect(resp.StartAfter).To(BeEmpty())
+		Expect(keys(resp)).To(BeEmpty())
+		Expect(prefixes(resp)).To(Equal([]string{"photos/"}))
+
+		resp, _ = list(recursive(), s3.QparamMarker, "logs/readme")
+		Expect(keys(resp)).To(Equal(names[5:]))
+	})
+
+	It("rolls up directories (non-recursive listing)", func() {
+		lst := &cmn.LsoRes{Entries: cmn.LsoEntries{
+			{Name: "a.txt"},
+			{Name: "logs", Flags: apc.EntryIsDir},
+			{Name: "photos/", Flags: apc.EntryIsDir},
+		}}
+		resp, _ := list(lst, s3.QparamDelimiter, "/", s3.QparamMarker, "logs/2026-01-02/y")
+		Expect(keys(resp)).To(BeEmpty())
+		Expect(prefixes(resp)).To(Equal([]string{"logs/", "photos/"}))
+
+		// never listed as keys
+		resp, _ = list(lst)
+		Expect(keys(resp)).To(Equal([]string{"a.txt"}))
+		Expect(resp.CommonPrefixes).To(BeEmpty())
+	})
+
+	It("counts keys and common prefixes against max-keys", func() {
+		resp, _ := list(recursive(), s3.QparamDelimiter, "/", s3.QparamMaxKeys, "2")
+		Expect(resp.MaxKeys).To(Equal(2))
+		Expect(resp.KeyCount).To(Equal(2))
+		Expect(keys(resp)).To(Equal([]string{"a.txt"}))
+		Expect(prefixes(resp)).To(Equal([]string{"logs/"}))
+		Expect(resp.IsTruncated).To(BeTrue())
+		Expect(resp.NextMarker).To(Equal("logs/"))
+
+		// exactly max-keys: not truncated
+		resp, _ = list(recursive(), s3.QparamDelimiter, "/", s3.QparamMaxKeys, "3")
+		Expect(resp.KeyCount).To(Equal(3))
+		Expect(resp.IsTruncated).To(BeFalse())
+		Expect(resp.NextMarker).To(BeEmpty())
+
+		// V1 without delimiter: no NextMarker (the last key is)
+		resp, _ = list(recursive(), s3.QparamMaxKeys, "4")
+		Expect(keys(resp)).To(Equal(names[:4]))
+		Expect(resp.IsTruncated).To(BeTrue())
+		Expect(resp.NextMarker).To(BeEmpty())
+
+		_, err := s3.NewLsoQuery(url.Values{s3.QparamMaxKeys: []string{"-1"}}, &apc.LsoMsg{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("pages through common prefixes spanning native pages", func() {
+		full, _ := list(recursive(), s3.QparamDelimiter, "/", s3.QparamPrefix, "photos/")
+		expected := append(keys(full), prefixes(full)...)
+		for _, psize := range []int{1, 2, 3, len(names)} {
+			for _, mk := range []string{"1", "2", "1000"} {
+				for _, lt := range []string{"1", "2"} {
+					out, calls := listAll(psize, s3.QparamListType, lt, s3.QparamDelimiter, "/", s3.QparamPrefix, "photos/",
+						s3.QparamMaxKeys, mk)
+					Expect(out).To(ConsistOf(expected), "psize %d, max-keys %s, list-type %s", psize, mk, lt)
+					if mk == "1" {
+						Expect(calls).To(Equal(len(expected)))
+					}
+				}
+			}
+		}
+
+		// across the entire bucket
+		for _, lt := range []string{"1", "2"} {
+			out, calls := listAll(2, s3.QparamListType, lt, s3.QparamDelimiter, "/", s3.QparamMaxKeys, "1")
+			Expect(out).To(Equal([]string{"a.txt", "logs/", "photos/"}))
+			Expect(calls).To(Equal(3))
+
+			out, _ = listAll(4, s3.QparamListType, lt, s3.QparamMaxKeys, "3")
+			Expect(out).To(Equal(names))
+		}
+	})
+
+	It("rejects invalid continuation token", func() {
+		q := url.Values{s3.QparamListType: []string{"2"}, s3.QparamContinuationToken: []string{"not-a-token"}}
+		_, err := s3.NewLsoQuery(q, &apc.LsoMsg{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("URL-encodes keys and prefixes", func() {
+		resp, _ := list(recursive(), s3.QparamPrefix, "photos/", s3.QparamDelimiter, "/", s3.QparamEncodingType, s3.EncodingTypeURL)
+		Expect(resp.EncodingType).To(Equal(s3.EncodingTypeURL))
+		Expect(keys(resp)).To(Equal([]string{"photos/my%20pic%2B1.jpg"}))
+		Expect(prefixes(resp)).To(Equal([]string{"photos/2025/", "photos/2026/"}))
+
+		_, err := s3.NewLsoQuery(url.Values{s3.QparamEncodingType: []string{"base64"}}, &apc.LsoMsg{})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,56 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"io"
+	"strings"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/memsys"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ListObjectVersions", func() {
+	const flags = apc.LocOK | apc.EntryIsVersion
+
+	It("lists versions newest first", func() {
+		lst := &cmn.LsoRes{Entries: cmn.LsoEntries{
+			{Name: "a", Version: "1", Size: 10, Flags: flags | apc.EntryIsCached},
+			{Name: "a", Version: "2", Flags: flags | apc.EntryIsDelMarker, Atime: "2026-01-02T00:00:00Z"},
+			{Name: "a", Version: "3", Size: 30, Flags: apc.EntryIsCached},
+			{Name: "b", Version: "1", Flags: flags | apc.EntryIsDelMarker},
+		}}
+		resp := s3.NewListVersionsResult("bck")
+		resp.FromLsoResult(lst)
+
+		Expect(resp.IsTruncated).To(BeFalse())
+		Expect(resp.Versions).To(HaveLen(2))
+		Expect(resp.Versions[0].Key).To(Equal("a"))
+		Expect(resp.Versions[0].VersionID).To(Equal("3"))
+		Expect(resp.Versions[0].IsLatest).To(BeTrue())
+		Expect(resp.Versions[1].VersionID).To(Equal("1"))
+		Expect(resp.Versions[1].IsLatest).To(BeFalse())
+
+		Expect(resp.DeleteMarkers).To(HaveLen(2))
+		Expect(resp.DeleteMarkers[0].VersionID).To(Equal("2"))
+		Expect(resp.DeleteMarkers[0].IsLatest).To(BeFalse())
+		Expect(resp.DeleteMarkers[0].LastModified).To(Equal("2026-01-02T00:00:00Z"))
+		Expect(resp.DeleteMarkers[1].Key).To(Equal("b"))
+		Expect(resp.DeleteMarkers[1].IsLatest).To(BeTrue())
+
+		sgl := memsys.PageMM().NewSGL(0)
+		defer sgl.Free()
+		resp.MustMarshal(sgl)
+		b, err := io.ReadAll(sgl)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(strings.Contains(string(b), "<ListVersionsResult")).To(BeTrue())
+		Expect(strings.Contains(string(b), "<DeleteMarker>")).To(BeTrue())
+	})
+})
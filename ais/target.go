@@ -36,6 +36,7 @@ import (
 	"github.com/NVIDIA/aistore/ext/etl"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/fs/health"
+	"github.com/NVIDIA/aistore/hk"
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/NVIDIA/aistore/mirror"
 	"github.com/NVIDIA/aistore/nl"
@@ -71,6 +72,8 @@ type (
 		fsprg    fsprungroup
 		txns     txns
 		ups      ups
+		quota    tquota
+		events   tevents
 		htrun    // common w/ proxy
 		regstate regstate
 	}
@@ -308,6 +311,10 @@ func (t *target) init(config *cmn.Config) {
 
 	keyPair := t.newKeyPair(tid, apc.Target)
 	t.si.Init(tid, apc.Target, keyPair.VerifyingKey)
+	if config.FailureDomain != "" {
+		t.si.FailureDomain = config.FailureDomain
+		nlog.Infoln(t.String(), "failure domain:", config.FailureDomain)
+	}
 
 	debug.Assert(t.si.IDDigest != 0)
 	cos.InitShortID(t.si.IDDigest)
@@ -324,6 +331,7 @@ func (t *target) init(config *cmn.Config) {
 	if err := fs.SetVolSizeMedia(); err != nil {
 		nlog.Errorln(err) // TODO: standby
 	}
+	t.initWeight(config)
 
 	t.initHostIP(config)
 	daemon.rg.add(t)
@@ -349,6 +357,28 @@ func (t *target) init(config *cmn.Config) {
 	}
 }
 
+// weighted HRW (see cmn.LocalConfig.HrwWeight): this target and its mountpaths
+func (t *target) initWeight(config *cmn.Config) {
+	auto, weight, err := config.ParseHrwWeight()
+	debug.AssertNoErr(err) // validated
+	total, changed := fs.InitWeights(auto || weight != 0)
+	if changed {
+		if _, err := volume.NewFromMPI(t.SID()); err != nil {
+			nlog.Errorln(t.String(), "failed to persist mountpath weights:", err)
+		}
+		// weighted mountpath HRW places objects differently - see goresilver
+		daemon.resilver.required = true
+		daemon.resilver.reason = "mountpath weights changed"
+	}
+	if auto {
+		weight = max(total, 1)
+	}
+	t.si.HrwWeight = weight
+	if weight != 0 {
+		nlog.Infoln(t.String(), "hrw weight:", weight)
+	}
+}
+
 func (t *target) initHostIP(config *cmn.Config) {
 	hostIP := os.Getenv("AIS_HOST_IP")
 	if hostIP == "" {
@@ -506,6 +536,11 @@ func (t *target) Run() error {
 	mirror.Init()
 
 	xreg.RegWithHK()
+	hk.Reg(apc.ActLifecycle+hk.NameSuffix, t.lcyHK, hk.LifecycleIval)
+	hk.Reg(apc.ActWriteBack+hk.NameSuffix, t.wbHK, hk.WritebackIval)
+	hk.Reg(apc.ActTiering+hk.NameSuffix, t.tierHK, hk.TieringIval)
+	hk.Reg("quota"+hk.NameSuffix, t.quotaHK, hk.QuotaIval)
+	t.events.init(config, t.statsT)
 
 	marked := xreg.GetResilverMarked()
 	if marked.Interrupted || daemon.resilver.required {
@@ -518,6 +553,7 @@ func (t *target) Run() error {
 
 	err = t.htrun.run(config)
 
+	t.events.stop()
 	etl.StopAll() // stop all running ETLs if any
 	cos.Close(db) // close kv db
 
@@ -975,6 +1011,23 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 	case dpq.get(apc.QparamETLName) != "":
 		t.inlineETL(w, r, dpq, lom)
 		return lom, nil
+	case dpq.objVersion() != "":
+		vlom, _, err := t.loadObjVer(lom, dpq.objVersion())
+		if err != nil {
+			return lom, err
+		}
+		if vlom != nil {
+			err = t.getObjVer(w, r, dpq, vlom)
+			core.FreeLOM(vlom)
+			return lom, err
+		}
+		// current version: regular GET
+	case dpq.get(apc.QparamSnapshot) != "":
+		done, err := t.getObjSnap(w, r, dpq, lom)
+		if done || err != nil {
+			return lom, err
+		}
+		// the current object belongs to the snapshot: regular GET
 	case blobDownload || thresholdStr != "":
 		var threshold int64
 		if thresholdStr != "" {
@@ -1041,6 +1094,7 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 		goi.w = w
 		goi.ctx = context.Background()
 		goi.ranges = byteRanges{Range: r.Header.Get(cos.HdrRange), Size: 0}
+		goi.precond = cmn.NewPrecond(r.Header)
 		goi.latestVer = _validateWarmGet(goi.lom, dpq.latestVer) // apc.QparamLatestVer || versioning.*_warm_get
 	}
 	if dpq.isArch() {
@@ -1076,6 +1130,8 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 			if goi.lom.IsFeatureSet(feat.CountObjectNotFoundStats) {
 				t.statsT.IncWith(stats.ErrGetCount, vlabs)
 			}
+		case cmn.IsErrPrecondFailed(err):
+			// not an error (conditional GET)
 
 		default:
 			t.statsT.IncWith(stats.ErrGetCount, vlabs)
@@ -1152,6 +1208,12 @@ func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiR
 			return
 		}
 	}
+	if apireq.dpq.sys.objto == "" {
+		if err := t.quotaCheck(lom, r.ContentLength); err != nil {
+			t.writeErr(w, r, err, http.StatusInsufficientStorage)
+			return
+		}
+	}
 
 	// do
 	var (
@@ -1305,7 +1367,15 @@ func (t *target) httpobjdelete(w http.ResponseWriter, r *http.Request, apireq *a
 		return
 	}
 
-	ecode, err := t.DeleteObject(lom, evict)
+	bypass := cos.IsParseBool(apireq.dpq.get(apc.QparamBypassGovernance))
+	if version := apireq.dpq.get(apc.QparamObjVersion); version != "" && !evict {
+		if _, ecode, err := t.delObjVer(lom, version, bypass); err != nil {
+			t.writeErr(w, r, err, ecode)
+		}
+		core.FreeLOM(lom)
+		return
+	}
+	ecode, err := t.deleteObject(lom, evict, bypass, nil)
 	if err == nil && ecode == 0 {
 		// EC cleanup if EC is enabled
 		ec.ECM.CleanupObject(lom)
@@ -1346,6 +1416,9 @@ func (t *target) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *api
 		if err = t.objMv(lom, msg); err == nil {
 			t.statsT.IncBck(stats.RenameCount, lom.Bucket())
 		} else {
+			if cmn.IsErrObjLocked(err) {
+				ecode = http.StatusForbidden
+			}
 			vlabs := map[string]string{stats.VlabBucket: lom.Bck().Cname("")}
 			t.statsT.IncWith(stats.ErrRenameCount, vlabs)
 		}
@@ -1412,6 +1485,8 @@ func (t *target) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *api
 			body:     nil,
 			parts:    mptCompletedParts,
 			locked:   false,
+			worm:     true,
+			precond:  cmn.NewPrecond(r.Header),
 		})
 	case apc.ActCheckLock:
 		t._checkLocked(w, r, apireq.bck, apireq.items[1])
@@ -1482,14 +1557,21 @@ func (t *target) httpobjhead(w http.ResponseWriter, r *http.Request, apireq *api
 		lom   = core.AllocLOM(objName)
 	)
 	switch {
+	case apireq.dpq.get(apc.QparamObjVersion) != "":
+		ecode, err = t.objHeadVer(r, w.Header(), apireq.dpq, apireq.bck, lom)
+	case apireq.dpq.get(apc.QparamSnapshot) != "":
+		ecode, err = t.objHeadSnap(r, w.Header(), apireq.dpq, apireq.bck, lom)
 	case apireq.dpq.get(apc.QparamProps) != "":
 		ecode, err = t.objHeadV2(r, w.Header(), apireq.dpq, apireq.bck, lom)
 	default:
 		ecode, err = t.objHead(r, w.Header(), apireq.dpq, apireq.bck, lom)
 	}
 	core.FreeLOM(lom)
-	if err != nil {
+	switch {
+	case err != nil:
 		t._erris(w, r, err, ecode, apireq.dpq.silent)
+	case ecode == http.StatusNotModified:
+		w.WriteHeader(ecode)
 	}
 }
 
@@ -1500,6 +1582,9 @@ func (t *target) httpobjhead(w http.ResponseWriter, r *http.Request, apireq *api
 // - 410 (Gone): remote backend returned 404 when latest=true (object was deleted remotely)
 // - 429 (TooManyRequests): remote backend is throttling requests
 // - 503 (ServiceUnavailable): remote backend is temporarily unavailable
+// and, for conditional requests (If-Match, etc.):
+// - 304 (NotModified) with no error
+// - 412 (PreconditionFailed)
 func (t *target) objHead(r *http.Request, whdr http.Header, dpq *dpq, bck *meta.Bck, lom *core.LOM) (int, error) {
 	var (
 		started     = mono.NanoTime()
@@ -1601,10 +1686,29 @@ func (t *target) objHead(r *http.Request, whdr http.Header, dpq *dpq, bck *meta.
 		}
 	}
 
-	// 5. serialize to response headers
+	// 5. conditional HEAD
+	if pc := cmn.NewPrecond(r.Header); pc != nil {
+		var (
+			etag  string
+			mtime time.Time
+		)
+		if exists {
+			etag, mtime = lomPrecondAttrs(lom)
+		} else {
+			etag, mtime = oaPrecondAttrs(&op.ObjAttrs)
+		}
+		if ecode, err := pc.Eval(true /*exists*/, etag, mtime, true /*read*/); ecode != 0 {
+			if err == nil {
+				precondToHdr(whdr, etag, mtime)
+			}
+			return ecode, err
+		}
+	}
+
+	// 6. serialize to response headers
 	objPropsToHeader(&op, whdr, hasEC)
 
-	// 6. stats
+	// 7. stats
 	delta := mono.SinceNano(started)
 	vlabs := bvlabs(bck)
 	t.statsT.IncWith(stats.HeadCount, vlabs)
@@ -1673,10 +1777,53 @@ func (t *target) httpobjpatch(w http.ResponseWriter, r *http.Request, apireq *ap
 		t.writeErr(w, r, err)
 		return
 	}
-	if err := cmn.ValidateCustomMD(custom); err != nil {
+	if err := cmn.ValidateUserMD(custom); err != nil {
 		t.writeErrf(w, r, "%s: %v", lom.Cname(), err)
 		return
 	}
+	// object lock (special case): retention and legal hold are updated separately
+	// from other custom metadata
+	var nlock int
+	for key := range custom {
+		if cmn.IsObjLockMD(key) {
+			nlock++
+		}
+	}
+	if nlock > 0 {
+		if nlock < len(custom) {
+			t.writeErrf(w, r, "%s: object lock metadata cannot be updated together with other custom metadata", lom.Cname())
+			return
+		}
+		to, hold, err := parseObjLockMD(custom)
+		if err != nil {
+			t.writeErrf(w, r, "%s: %v", lom.Cname(), err)
+			return
+		}
+		bypass := cos.IsParseBool(apireq.dpq.get(apc.QparamBypassGovernance))
+		if ecode, err := t.setObjLock(lom, to, hold, bypass); err != nil {
+			t.writeErr(w, r, err, ecode)
+		}
+		return
+	}
+	// object tags (special case)
+	encTags, hasTags := custom[cmn.TagsObjMD]
+	if hasTags {
+		tags, err := cmn.ParseObjTags(encTags)
+		if err != nil {
+			t.writeErrf(w, r, "%s: %v", lom.Cname(), err)
+			return
+		}
+		if len(custom) == 1 {
+			if ecode, err := t.setObjTags(lom, tags); err != nil {
+				t.writeErr(w, r, err, ecode)
+			}
+			return
+		}
+		if _, ecode, err := t.putRemoteTags(lom, tags); err != nil {
+			t.writeErr(w, r, err, ecode)
+			return
+		}
+	}
 
 	lom.Lock(true)
 	if err := lom.Load(true /*cache it*/, true /*locked*/); err != nil {
@@ -1690,12 +1837,24 @@ func (t *target) httpobjpatch(w http.ResponseWriter, r *http.Request, apireq *ap
 	}
 	delOldSetNew := cos.IsParseBool(apireq.dpq.get(apc.QparamNewCustom))
 	if delOldSetNew {
+		// (object lock and system metadata, if any, stay)
+		for key, val := range lom.GetCustomMD() {
+			if _, ok := custom[key]; ok {
+				continue
+			}
+			if cmn.IsObjLockMD(key) || (strings.HasPrefix(key, cmn.ReservedObjMD) && key != cmn.TagsObjMD) {
+				custom[key] = val
+			}
+		}
 		lom.SetCustomMD(custom)
 	} else {
 		for key, val := range custom {
 			lom.SetCustomKey(key, val)
 		}
 	}
+	if hasTags && encTags == "" {
+		lom.DelCustomKey(cmn.TagsObjMD)
+	}
 
 	err = lom.Persist()
 	lom.Unlock(true)
@@ -1755,11 +1914,22 @@ func (t *target) putApndArch(r *http.Request, lom *core.LOM, started int64, dpq
 	return a.do()
 }
 
-func (t *target) DeleteObject(lom *core.LOM, evict bool) (code int, err error) {
+func (t *target) DeleteObject(lom *core.LOM, evict bool) (int, error) {
+	return t.deleteObject(lom, evict, false /*bypass governance*/, nil)
+}
+
+func (t *target) DeleteObjectIf(lom *core.LOM, evict bool, cond func(*core.LOM) bool) (int, error) {
+	return t.deleteObject(lom, evict, false /*bypass governance*/, cond)
+}
+
+func (t *target) deleteObject(lom *core.LOM, evict, bypassGovernance bool, cond func(*core.LOM) bool) (code int, err error) {
 	var isback bool
 	lom.Lock(true)
-	code, err, isback = t.delobj(lom, evict)
+	code, err, isback = t.delobj(lom, evict, bypassGovernance, cond)
 	lom.Unlock(true)
+	if err == cmn.ErrSkip {
+		return 0, err
+	}
 
 	// special corner-case retry (quote):
 	// - googleapi: "Error 503: We encountered an internal error. Please try again."
@@ -1782,6 +1952,8 @@ func (t *target) DeleteObject(lom *core.LOM, evict bool) (code int, err error) {
 		if !evict {
 			t.statsT.IncWith(stats.ErrDeleteCount, vlabs)
 		}
+	case cmn.IsErrObjLocked(err):
+		t.statsT.IncWith(stats.ErrDeleteCount, vlabs)
 	default:
 		// not to confuse with `stats.RemoteDeletedDelCount` that counts against
 		// QparamLatestVer, 'versioning.validate_warm_get' and friends
@@ -1848,15 +2020,25 @@ func (t *target) copyObject(lom *core.LOM, bck *meta.Bck, objName string, dpq *d
 		}
 	}
 
+	// event notification (the destination may reside on a different target)
+	if res.Err == nil && bck.Props != nil && bck.Props.Events.IsActive() {
+		lomTo := core.AllocLOM(objName)
+		if lomTo.InitBck(bck) == nil {
+			lomTo.SetSize(res.Lsize)
+			t.evnotify(lomTo, cmn.EventObjCreatedCopy, nil)
+		}
+		core.FreeLOM(lomTo)
+	}
 	return res.Ecode, res.Err
 }
 
 // NOTE: s3 will return err=nil with OK status to indicate (not deleting) non-existing object (see also aws.go)
-func (t *target) delobj(lom *core.LOM, evict bool) (int, error, bool) {
+func (t *target) delobj(lom *core.LOM, evict, bypassGovernance bool, cond func(*core.LOM) bool) (int, error, bool) {
 	var (
 		aisErr, backendErr         error
 		aisErrCode, backendErrCode int
 		delFromAIS, delFromBackend bool
+		wbPending                  bool
 	)
 	delFromBackend = lom.Bck().IsRemote() && !evict
 	err := lom.Load(false /*cache it*/, true /*locked*/)
@@ -1871,20 +2053,57 @@ func (t *target) delobj(lom *core.LOM, evict bool) (int, error, bool) {
 			}
 			return 0, err, false
 		}
-		if !delFromBackend {
+		if !delFromBackend || cond != nil {
 			return http.StatusNotFound, cos.NewErrNotFound(t, lom.Cname()), false
 		}
 	} else {
+		// (conditional) e.g., lifecycle expiration: the object may have changed since visited
+		if cond != nil && !cond(lom) {
+			return 0, cmn.ErrSkip, false
+		}
 		delFromAIS = true
+		// object lock (WORM) - also applies to eviction
+		if lom.Bprops().ObjectLock.Enabled {
+			if err := lom.CheckWORM(bypassGovernance); err != nil {
+				return http.StatusForbidden, err, false
+			}
+		}
+		// write-back: cannot evict what's not been uploaded yet; may not exist remotely
+		if wbPending = lom.WbPending(); wbPending && evict {
+			return http.StatusConflict, cmn.NewErrFailedTo(t, "evict", lom.Cname(), errWbPending), false
+		}
 	}
 
 	// do
 	if delFromBackend {
 		backendErrCode, backendErr = t.Backend(lom.Bck()).DeleteObj(context.Background(), lom)
+		if wbPending && cos.IsNotExist(backendErr, backendErrCode) {
+			backendErr, backendErrCode = nil, 0
+		}
 	}
 	if delFromAIS {
 		size := lom.Lsize()
+		if !evict && hasSnaps(lom) {
+			if err := lom.PreserveSnaps(); err != nil {
+				return 0, err, false
+			}
+		}
+		retain := !evict && lom.Bck().IsAIS() && lom.Bprops().Versioning.KeepHistory()
+		if retain {
+			if err := retainDeleted(lom, time.Now()); err != nil {
+				return 0, err, false
+			}
+		}
 		aisErr = lom.RemoveObj()
+		if aisErr == nil {
+			if retain {
+				supersede(lom, lom.Version(), nil, time.Now())
+			}
+			t.quota.add(lom.Bck(), -size, -1)
+			if wbPending {
+				lom.WbCancel()
+			}
+		}
 		if aisErr != nil {
 			if !cos.IsNotExist(aisErr) {
 				if backendErr != nil {
@@ -1904,6 +2123,9 @@ func (t *target) delobj(lom *core.LOM, evict bool) (int, error, bool) {
 	if backendErr != nil {
 		return backendErrCode, backendErr, true
 	}
+	if !evict {
+		t.evnotify(lom, cmn.EventObjRemovedDelete, nil)
+	}
 	return aisErrCode, aisErr, false
 }
 
@@ -1925,6 +2147,9 @@ func (t *target) objMv(lom *core.LOM, msg *apc.ActMsg) error {
 	if msg.Name == lom.ObjName {
 		return fmt.Errorf("%s: cannot rename/move object %s onto itself", t.si, lom)
 	}
+	if _, err := checkWORM(lom, false /*locked*/, false /*bypass*/); err != nil {
+		return err
+	}
 
 	buf, slab := t.gmm.Alloc()
 	coiParams := xs.AllocCOI()
@@ -1945,10 +2170,25 @@ func (t *target) objMv(lom *core.LOM, msg *apc.ActMsg) error {
 	}
 
 	lom.Lock(true)
+	if hasSnaps(lom) && lom.Load(false /*cache it*/, true /*locked*/) == nil {
+		if err := lom.PreserveSnaps(); err != nil {
+			lom.Unlock(true)
+			return err
+		}
+	}
 	if err := lom.RemoveObj(); err != nil {
 		nlog.Warningf("%s: failed to delete renamed object %s (new name %s): %v", t, lom, msg.Name, err)
 	}
 	lom.Unlock(true)
+
+	if lom.Bprops().Events.IsActive() {
+		t.evnotify(lom, cmn.EventObjRemovedRename, nil)
+		lomTo := core.AllocLOM(msg.Name)
+		if lomTo.InitBck(lom.Bck()) == nil && lomTo.Load(false /*cache it*/, false /*locked*/) == nil {
+			t.evnotify(lomTo, cmn.EventObjCreatedRename, nil)
+		}
+		core.FreeLOM(lomTo)
+	}
 	return nil
 }
 
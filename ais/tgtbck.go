@@ -13,6 +13,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -22,6 +23,7 @@ import (
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/hk"
 	"github.com/NVIDIA/aistore/nl"
 	"github.com/NVIDIA/aistore/reb"
 	"github.com/NVIDIA/aistore/stats"
@@ -639,6 +641,10 @@ func (t *target) httpbckdelete(w http.ResponseWriter, r *http.Request, apireq *a
 		}
 		// arrived via p.evictRemoteKeepMD
 		// (compare with t.destroyBucket transaction)
+		if err := wbCheckDestroy(bck); err != nil {
+			t.writeErr(w, r, err, http.StatusConflict)
+			return
+		}
 		var (
 			wg  = &sync.WaitGroup{}
 			nlp = newBckNLP(bck)
@@ -785,6 +791,103 @@ func (t *target) runRechunk(xactID string, bck *meta.Bck, rechunkMsg *apc.Rechun
 	return xctn.ID(), nil
 }
 
+// handle apc.ActScrub <-- via api.StartXaction
+func (t *target) runScrub(xactID string, bck *meta.Bck, repair bool) (xid string, err error) {
+	if err := xreg.LimitedCoexistence(t.si, bck, apc.ActScrub); err != nil {
+		return "", err
+	}
+	rns := xreg.RenewBckScrub(bck, xactID, &xreg.ScrubArgs{Repair: repair})
+	if rns.Err != nil {
+		return "", rns.Err
+	}
+	xctn := rns.Entry.Get()
+	if rns.IsRunning() {
+		return xctn.ID(), nil
+	}
+	notif := &xact.NotifXact{
+		Base: nl.Base{When: core.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
+		Xact: xctn,
+	}
+	xctn.AddNotif(notif)
+	xact.GoRunW(xctn)
+	return xctn.ID(), nil
+}
+
+// handle apc.ActLifecycle <-- via api.StartXaction and periodically (see lcyHK)
+func (t *target) runLifecycle(xactID string, bck *meta.Bck) (string, error) {
+	return t.runPeriodic(apc.ActLifecycle, xactID, bck, func(uuid string) xreg.RenewRes {
+		return xreg.RenewBckLifecycle(bck, uuid, &xreg.LcyArgs{AbortMpt: t.ups.abortOld})
+	})
+}
+
+// periodically enforce lifecycle rules of all buckets that have them
+func (t *target) lcyHK(int64) time.Duration {
+	t.periodicHK("lifecycle", func(bck *meta.Bck) bool { return bck.Props.Lifecycle.IsActive() }, t.runLifecycle)
+	return hk.LifecycleIval
+}
+
+// handle apc.ActTiering <-- via api.StartXaction and periodically (see tierHK)
+func (t *target) runTiering(xactID string, bck *meta.Bck) (string, error) {
+	return t.runPeriodic(apc.ActTiering, xactID, bck, func(uuid string) xreg.RenewRes {
+		return xreg.RenewBckTiering(bck, uuid)
+	})
+}
+
+// periodically move objects between hot and cold tiers of all buckets that have tiering enabled
+func (t *target) tierHK(int64) time.Duration {
+	t.periodicHK("tiering", func(bck *meta.Bck) bool { return bck.Props.Tiering.Enabled }, t.runTiering)
+	return hk.TieringIval
+}
+
+// common for per-bucket xactions that run both on demand and periodically;
+// empty xactID means locally triggered (target-own UUID, registered with IC)
+func (t *target) runPeriodic(kind, xactID string, bck *meta.Bck, renew func(string) xreg.RenewRes) (string, error) {
+	if err := xreg.LimitedCoexistence(t.si, bck, kind); err != nil {
+		return "", err
+	}
+	regToIC := xactID == ""
+	if regToIC {
+		xactID = cos.GenUUID()
+	}
+	rns := renew(xactID)
+	if rns.Err != nil {
+		return "", rns.Err
+	}
+	xctn := rns.Entry.Get()
+	if rns.IsRunning() {
+		return xctn.ID(), nil
+	}
+	if regToIC {
+		regMsg := xactRegMsg{UUID: xactID, Kind: kind, Srcs: []string{t.SID()}}
+		msg := t.newAmsgActVal(apc.ActRegGlobalXaction, regMsg)
+		t.bcastAsyncIC(msg)
+	}
+	notif := &xact.NotifXact{
+		Base: nl.Base{When: core.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
+		Xact: xctn,
+	}
+	xctn.AddNotif(notif)
+	xact.GoRunW(xctn)
+	return xctn.ID(), nil
+}
+
+// (housekeeping) run the xaction for all buckets that have it enabled
+func (t *target) periodicHK(tag string, enabled func(*meta.Bck) bool, run func(string, *meta.Bck) (string, error)) {
+	if !t.ClusterStarted() || nlog.Stopping() {
+		return
+	}
+	bmd := t.owner.bmd.get()
+	bmd.Range(nil, nil, func(bck *meta.Bck) bool {
+		if !enabled(bck) {
+			return false
+		}
+		if _, err := run("" /*xactID*/, bck); err != nil && !cmn.IsErrXactUsePrev(err) {
+			nlog.Warningln(t.String(), tag+":", bck.Cname(""), err)
+		}
+		return false
+	})
+}
+
 func (t *target) runIndexShard(xactID string, bck *meta.Bck, msg *apc.IndexShardMsg) (xid string, err error) {
 	if err := xreg.LimitedCoexistence(t.si, bck, apc.ActIndexShard); err != nil {
 		return "", err
@@ -30,10 +30,6 @@ func (t *target) etlHandler(w http.ResponseWriter, r *http.Request) {
 	if !t.ensureIntraControl(w, r, true /* from primary */) {
 		return
 	}
-	if !k8s.IsK8s() {
-		t.writeErr(w, r, k8s.ErrK8sRequired, 0, Silent)
-		return
-	}
 	switch r.Method {
 	case http.MethodDelete:
 		t.handleETLDelete(w, r)
@@ -78,7 +74,9 @@ func (t *target) handleETLGet(w http.ResponseWriter, r *http.Request, dpq *dpq)
 	case apc.ETLDetails:
 		t.detailsETL(w, r, dpq, apiItems[0])
 	case apc.ETLMetrics:
-		k8s.InitMetricsClient()
+		if k8s.IsK8s() {
+			k8s.InitMetricsClient()
+		}
 		t.metricsETL(w, r, apiItems[0])
 	default:
 		t.writeErrURL(w, r)
@@ -257,7 +255,7 @@ func etlParseObjectReq(r *http.Request) (etlName, secret string, bck *meta.Bck,
 // Ed25519 authentication: its callers are ETL containers, not Smap members,
 // and therefore have no AIS node identity or signing key. During ETL startup,
 // the target injects AIS_TARGET_URL containing a per-ETL bearer secret into the
-// container (see ext/etl/boot.go, _setPodEnv). Possession of that secret
+// container (see ext/etl/boot.go, _setPodEnv; or ext/etl/local.go for local processes). Possession of that secret
 // authorizes object I/O for the corresponding ETL.
 //
 // The secret is cluster-wide per ETL instance - deliberately: with direct
@@ -272,12 +270,7 @@ func etlParseObjectReq(r *http.Request) (etlName, secret string, bck *meta.Bck,
 //
 // TODO: review/improve the secret's lifecycle
 func (t *target) etlObjHandler(w http.ResponseWriter, r *http.Request) {
-	// 1. K8s only
-	if !k8s.IsK8s() {
-		t.writeErr(w, r, k8s.ErrK8sRequired, 0, Silent)
-		return
-	}
-	// 2. parse the (fixed-shape) path; authorize via per-ETL secret
+	// 1. parse the (fixed-shape) path; authorize via per-ETL secret
 	etlName, secret, bck, objName, err := etlParseObjectReq(r)
 	if err != nil {
 		t.writeErr(w, r, err)
@@ -287,7 +280,7 @@ func (t *target) etlObjHandler(w http.ResponseWriter, r *http.Request) {
 		t.writeErr(w, r, err)
 		return
 	}
-	// 3. dpq - parse once for all verbs
+	// 2. dpq - parse once for all verbs
 	dpq := dpqAlloc()
 	if err := dpq.parse(r.URL.RawQuery); err != nil {
 		dpqFree(dpq)
@@ -295,7 +288,7 @@ func (t *target) etlObjHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// 4. do
+	// 3. do
 	switch r.Method {
 	case http.MethodGet:
 		t.getObjectETL(w, r, dpq, bck, objName)
@@ -0,0 +1,340 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// Bucket event notifications (see cmn/events and s3/events):
+// - upon object create, delete, and rename, target evaluates the bucket's rules;
+// - for each matching rule, the event (S3 event message) is first stored in the rule's
+//   destination queue: a directory under <config-dir>/.ais.events, one file per event,
+//   in order (file names are hex-encoded sequence numbers);
+// - local queue ("queue/<name>"): external consumers process `*.json` files in
+//   lexicographical order and remove them;
+// - webhook ("webhook/<url hash>"): a dedicated goroutine POSTs pending events, in order,
+//   and removes each upon 2xx response - otherwise, retries with backoff;
+// - either way, delivery is at-least-once, and pending events survive restarts;
+// - backlog is bounded: when the number of pending events exceeds the rule's max,
+//   the oldest get dropped.
+
+const (
+	evExt        = ".json"
+	evURLFile    = ".url" // (webhook queue) destination URL, to resume delivery upon restart
+	evQueueDir   = "queue"
+	evWebhookDir = "webhook"
+
+	evTimeout  = 30 * time.Second
+	evRetryMin = time.Second
+	evRetryMax = time.Minute
+	evRecheck  = time.Minute // (webhook) when idle
+)
+
+type (
+	evq struct {
+		dir     string
+		webhook string        // empty for local queues
+		wake    chan struct{} // (webhook)
+		last    int64         // last used sequence number
+		cnt     int64         // approx. number of pending events
+		mu      sync.Mutex
+	}
+	tevents struct {
+		statsT  stats.Tracker
+		qs      map[string]*evq // dir => queue
+		clientH *http.Client
+		clientS *http.Client
+		root    string
+		stopCh  cos.StopCh
+		mu      sync.Mutex
+	}
+)
+
+// emit event(s) for a given (new, deleted, or renamed) object
+// NOTE: best effort - failure to queue is logged and counted but does not fail the operation
+func (t *target) evnotify(lom *core.LOM, event string, r *http.Request) {
+	conf := &lom.Bprops().Events
+	if !conf.IsActive() {
+		return
+	}
+	var (
+		now      = time.Now()
+		obj      *s3.EventObject
+		sourceIP string
+	)
+	if r != nil {
+		sourceIP, _, _ = net.SplitHostPort(r.RemoteAddr)
+	}
+	for i := range conf.Rules {
+		rule := &conf.Rules[i]
+		if !rule.Match(event, lom.ObjName) {
+			continue
+		}
+		if obj == nil {
+			obj = &s3.EventObject{Key: lom.ObjName}
+			if cmn.IsObjCreatedEvent(event) {
+				obj.Size = lom.Lsize(true /*not loaded ok*/)
+				obj.ETag = lom.ETag(time.Time{}, false /*allow syscall*/)
+				obj.VersionID = lom.Version(true)
+			}
+		}
+		msg := s3.NewEventMessage(event, rule.ID, lom.Bucket().Name, obj, sourceIP, now)
+		q, err := t.events.get(rule)
+		if err == nil {
+			err = q.put(cos.MustMarshal(msg), rule.Backlog(), t.statsT)
+		}
+		if err != nil {
+			nlog.Errorln(t.String(), "failed to queue", event, "event for", lom.Cname(), "[", err, "]")
+			t.statsT.Inc(stats.ErrEventCount)
+			continue
+		}
+		t.statsT.Inc(stats.EventCount)
+	}
+}
+
+/////////////
+// tevents //
+/////////////
+
+// resume delivery of pending webhook events, if any
+func (te *tevents) init(config *cmn.Config, statsT stats.Tracker) {
+	te.root = filepath.Join(config.ConfigDir, fname.EventsDir)
+	te.qs = make(map[string]*evq, 4)
+	te.statsT = statsT
+	te.stopCh.Init()
+	te.clientH, te.clientS = cmn.NewDefaultClients(evTimeout)
+
+	dirents, err := os.ReadDir(filepath.Join(te.root, evWebhookDir))
+	if err != nil {
+		return
+	}
+	for _, de := range dirents {
+		dir := filepath.Join(te.root, evWebhookDir, de.Name())
+		b, err := os.ReadFile(filepath.Join(dir, evURLFile))
+		if err != nil {
+			continue
+		}
+		if _, err := te.getq(dir, string(b)); err != nil {
+			nlog.Errorln("failed to resume event notifications [", dir, err, "]")
+		}
+	}
+}
+
+func (te *tevents) stop() { te.stopCh.Close() }
+
+func (te *tevents) get(rule *cmn.EventRule) (*evq, error) {
+	if rule.Webhook == "" {
+		return te.getq(filepath.Join(te.root, evQueueDir, rule.Queue), "")
+	}
+	dir := filepath.Join(te.root, evWebhookDir, cos.ChecksumB2S(cos.UnsafeB(rule.Webhook), cos.ChecksumOneXxh))
+	return te.getq(dir, rule.Webhook)
+}
+
+func (te *tevents) getq(dir, webhook string) (*evq, error) {
+	te.mu.Lock()
+	defer te.mu.Unlock()
+	if q, ok := te.qs[dir]; ok {
+		return q, nil
+	}
+	if err := cos.CreateDir(dir); err != nil {
+		return nil, err
+	}
+	q := &evq{dir: dir, webhook: webhook}
+	names, err := q.pending()
+	if err != nil {
+		return nil, err
+	}
+	q.cnt = int64(len(names))
+	if l := len(names); l > 0 {
+		q.last, _ = strconv.ParseInt(strings.TrimSuffix(names[l-1], evExt), 16, 64)
+	}
+	if webhook != "" {
+		if err := os.WriteFile(filepath.Join(dir, evURLFile), cos.UnsafeB(webhook), cos.PermRWR); err != nil {
+			return nil, err
+		}
+		q.wake = make(chan struct{}, 1)
+		go te.send(q)
+	}
+	te.qs[dir] = q
+	return q, nil
+}
+
+// (webhook) deliver pending events in order
+func (te *tevents) send(q *evq) {
+	backoff := evRetryMin
+	for {
+		names, err := q.pending()
+		if err != nil || len(names) == 0 {
+			select {
+			case <-q.wake:
+			case <-time.After(evRecheck):
+			case <-te.stopCh.Listen():
+				return
+			}
+			continue
+		}
+		for _, name := range names {
+			if err = te.post(q, name); err != nil {
+				break
+			}
+			backoff = evRetryMin
+			te.statsT.Inc(stats.EventSentCount)
+		}
+		if err == nil {
+			continue
+		}
+		te.statsT.Inc(stats.ErrEventCount)
+		if backoff == evRetryMin {
+			nlog.Warningln("event notifications: failed to deliver to", q.webhook, "[", err, "] - will retry")
+		}
+		select {
+		case <-time.After(backoff):
+			backoff = min(backoff*2, evRetryMax)
+		case <-te.stopCh.Listen():
+			return
+		}
+	}
+}
+
+func (te *tevents) post(q *evq, name string) error {
+	fqn := filepath.Join(q.dir, name)
+	b, err := os.ReadFile(fqn)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil // dropped (see trim)
+		}
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, q.webhook, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set(cos.HdrContentType, cos.ContentJSON)
+	client := te.clientH
+	if strings.HasPrefix(q.webhook, "https") {
+		client = te.clientS
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	io.Copy(io.Discard, resp.Body) //nolint:errcheck // drain
+	resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%s: %s", q.webhook, resp.Status)
+	}
+	if err := os.Remove(fqn); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	q.mu.Lock()
+	q.cnt = max(q.cnt-1, 0)
+	q.mu.Unlock()
+	return nil
+}
+
+/////////
+// evq //
+/////////
+
+// store event, in order; enforce max backlog
+func (q *evq) put(b []byte, backlog int64, statsT stats.Tracker) error {
+	q.mu.Lock()
+	q.last = max(q.last+1, time.Now().UnixNano())
+	var (
+		name = fmt.Sprintf("%016x%s", q.last, evExt)
+		fqn  = filepath.Join(q.dir, name)
+		tmp  = filepath.Join(q.dir, "."+name)
+	)
+	fh, err := cos.CreateFile(tmp)
+	if err == nil {
+		if _, err = fh.Write(b); err == nil {
+			err = cos.FlushClose(fh)
+		} else {
+			fh.Close()
+		}
+		if err == nil {
+			err = os.Rename(tmp, fqn)
+		}
+		if err != nil {
+			os.Remove(tmp)
+		}
+	}
+	if err != nil {
+		q.mu.Unlock()
+		return err
+	}
+	q.cnt++
+	if q.cnt > backlog {
+		if n := q.trim(backlog); n > 0 {
+			statsT.Add(stats.EventDropCount, n)
+		}
+	}
+	q.mu.Unlock()
+
+	if q.wake != nil {
+		select {
+		case q.wake <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+// (under lock) recount and drop the oldest, if need be
+func (q *evq) trim(backlog int64) (n int64) {
+	names, err := q.pending()
+	if err != nil {
+		return 0
+	}
+	q.cnt = int64(len(names))
+	for _, name := range names {
+		if q.cnt <= backlog {
+			break
+		}
+		if err := os.Remove(filepath.Join(q.dir, name)); err != nil && !os.IsNotExist(err) {
+			break
+		}
+		q.cnt--
+		n++
+	}
+	if n > 0 {
+		nlog.Warningln("event notifications:", q.dir, "exceeded max backlog", backlog, "- dropped", n, "oldest event(s)")
+	}
+	return n
+}
+
+// pending events, in order
+func (q *evq) pending() ([]string, error) {
+	dirents, err := os.ReadDir(q.dir) // (sorted by name)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(dirents))
+	for _, de := range dirents {
+		if name := de.Name(); name[0] != '.' && strings.HasSuffix(name, evExt) {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,136 @@
+// Package ais: internal unit tests
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func newTestEvents(t *testing.T) *tevents {
+	te := &tevents{}
+	te.init(&cmn.Config{LocalConfig: cmn.LocalConfig{ConfigDir: t.TempDir()}}, mock.NewStatsTracker())
+	t.Cleanup(te.stop)
+	return te
+}
+
+func testEventMsg(key string) []byte {
+	msg := s3.NewEventMessage(cmn.EventObjCreatedPut, "rule", "bck", &s3.EventObject{Key: key, Size: 1}, "", time.Now())
+	b, _ := json.Marshal(msg)
+	return b
+}
+
+func TestEventsQueueBacklog(t *testing.T) {
+	var (
+		te   = newTestEvents(t)
+		rule = &cmn.EventRule{Events: []string{cmn.EventObjCreated}, Queue: "shards", MaxBacklog: 3}
+	)
+	q, err := te.get(rule)
+	tassert.CheckFatal(t, err)
+	for i := range 5 {
+		tassert.CheckFatal(t, q.put(testEventMsg(fmt.Sprintf("obj%d", i)), rule.Backlog(), te.statsT))
+	}
+	names, err := q.pending()
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(names) == 3, "expected 3 pending events, got %d", len(names))
+
+	// in order; the oldest two dropped
+	for i, name := range names {
+		b, err := readEventFile(q, name)
+		tassert.CheckFatal(t, err)
+		want := fmt.Sprintf("obj%d", i+2)
+		tassert.Errorf(t, b.Records[0].S3.Object.Key == want, "expected %q, got %q", want, b.Records[0].S3.Object.Key)
+	}
+
+	// restart: resume numbering
+	te2 := &tevents{}
+	te2.init(&cmn.Config{LocalConfig: cmn.LocalConfig{ConfigDir: filepath.Dir(te.root)}}, te.statsT)
+	defer te2.stop()
+	q2, err := te2.get(rule)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, q2.last == q.last && q2.cnt == 3, "expected (%d, 3), got (%d, %d)", q.last, q2.last, q2.cnt)
+}
+
+func TestEventsWebhook(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		keys []string
+		fail = 2 // fail the first two deliveries
+		done = make(chan struct{}, 8)
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if fail > 0 {
+			fail--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		b, _ := io.ReadAll(r.Body)
+		msg := &s3.EventMessage{}
+		if err := json.Unmarshal(b, msg); err != nil || len(msg.Records) != 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		keys = append(keys, msg.Records[0].S3.Object.Key)
+		done <- struct{}{}
+	}))
+	defer srv.Close()
+
+	var (
+		te   = newTestEvents(t)
+		rule = &cmn.EventRule{Events: []string{cmn.EventObjCreated}, Webhook: srv.URL + "/events"}
+	)
+	q, err := te.get(rule)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, q.put(testEventMsg("a"), rule.Backlog(), te.statsT))
+	tassert.CheckFatal(t, q.put(testEventMsg("b"), rule.Backlog(), te.statsT))
+
+	for range 2 {
+		select {
+		case <-done:
+		case <-time.After(10 * time.Second):
+			t.Fatal("timed out waiting for webhook delivery")
+		}
+	}
+	mu.Lock()
+	tassert.Errorf(t, len(keys) == 2 && keys[0] == "a" && keys[1] == "b", "expected in-order delivery of [a b], got %v", keys)
+	mu.Unlock()
+
+	// delivered events are removed
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		names, _ := q.pending()
+		if len(names) == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected no pending events, got %v", names)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func readEventFile(q *evq, name string) (*s3.EventMessage, error) {
+	b, err := os.ReadFile(filepath.Join(q.dir, name))
+	if err != nil {
+		return nil, err
+	}
+	msg := &s3.EventMessage{}
+	return msg, json.Unmarshal(b, msg)
+}
@@ -84,6 +84,24 @@ func (t *target) FinalizeObj(lom *core.LOM, workFQN string, xctn core.Xact, owt
 	if err = cos.Stat(workFQN); err != nil {
 		return
 	}
+	if lom.WriteEncoded() {
+		// the work file is plain: compress and/or encrypt as per bucket configuration
+		var (
+			encFQN    = lom.GenFQN(fs.WorkCT, fs.WorkfilePut)
+			buf, slab = t.gmm.Alloc()
+		)
+		_, _, err = encodeFile(lom, workFQN, encFQN, buf, cos.ChecksumNone)
+		slab.Free(buf)
+		if err != nil {
+			return http.StatusInternalServerError, err
+		}
+		if errRm := cos.RemoveFile(workFQN); errRm != nil {
+			nlog.Warningln(t, "failed to remove", workFQN, errRm)
+		}
+		workFQN = encFQN
+	} else {
+		lom.SetStored(nil)
+	}
 	poi := allocPOI()
 	{
 		poi.t = t
@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/NVIDIA/aistore/stats"
 )
@@ -48,6 +50,7 @@ type (
 		manifest    *core.Ufest
 		chunk       *core.Uchunk
 		fh          io.WriteCloser
+		cw          *core.Cwriter // compressing fh (when configured)
 		uploadID    string
 		partNum     int
 		size        int64 // take precedence over req.ContentLength
@@ -63,6 +66,8 @@ type (
 		isS3        bool
 		skipBackend bool
 		locked      bool // true if the LOM is already locked by the caller
+		worm        bool // enforce object lock (user and xaction writes, not cold GET)
+		precond     *cmn.Precond
 	}
 	// partCksums holds checksum state for a single part upload
 	partCksums struct {
@@ -165,6 +170,29 @@ func (ups *ups) del(id string) {
 	ups.Unlock()
 }
 
+// abort multipart uploads to a given bucket/prefix that were initiated more than `age` ago
+// (used by bucket lifecycle - see xreg.LcyArgs)
+func (ups *ups) abortOld(bck *meta.Bck, prefix string, age time.Duration) (n int) {
+	now := time.Now()
+	for _, manifest := range ups.toSlice() {
+		lom := manifest.Lom()
+		if !lom.Bucket().Equal(bck.Bucket()) || !strings.HasPrefix(lom.ObjName, prefix) {
+			continue
+		}
+		if now.Sub(manifest.Created()) < age {
+			continue
+		}
+		if _, err := ups.abort(nil, lom, manifest.ID()); err != nil {
+			if !cos.IsNotExist(err) {
+				nlog.Warningln("lifecycle: failed to abort upload [", manifest.ID(), lom.Cname(), err, "]")
+			}
+			continue
+		}
+		n++
+	}
+	return n
+}
+
 //
 // backend operations - encapsulate IsRemoteS3/IsRemoteOCI pattern
 //
@@ -307,12 +335,25 @@ func (ups *ups) putPart(args *partArgs) (etag string, ecode int, err error) {
 	}
 
 	path := args.chunk.Path()
-	if args.fh, err = lom.CreatePart(path); err != nil {
+	fh, err := lom.CreatePart(path)
+	if err != nil {
 		return "", http.StatusInternalServerError, err
 	}
+	args.fh = fh
+	if args.cw, err = lom.NewCwriter(fh); err != nil {
+		cos.Close(fh)
+		_ = cos.RemoveFile(path)
+		return "", http.StatusInternalServerError, err
+	}
+	if args.cw != nil {
+		args.fh = args.cw
+	}
 
 	etag, ecode, err = ups._put(args)
-	cos.Close(args.fh)
+	if args.cw != nil {
+		args.cw.Close() // (no-op when already flushed)
+	}
+	cos.Close(fh)
 
 	if err != nil {
 		if nerr := cos.RemoveFile(path); nerr != nil && !cos.IsNotExist(nerr) {
@@ -401,6 +442,13 @@ func (ups *ups) _put(args *partArgs) (etag string, ecode int, err error) {
 		args.manifest.StreamWriteDone()
 	}
 
+	// flush compressed and/or encrypted chunk
+	if err == nil && args.cw != nil {
+		if err = args.cw.Close(); err == nil {
+			args.chunk.SetStored(args.cw)
+		}
+	}
+
 	// validate, finalize
 	var (
 		size  = mw.Size()
@@ -466,6 +514,32 @@ func (ups *ups) complete(args *completeArgs) (string, int, error) {
 		return "", http.StatusBadRequest, err
 	}
 
+	// conditional (chunked) PUT and complete-upload: evaluate and complete under the same wlock
+	// (including remote, if any - see also putOI.fini)
+	var locked bool
+	if args.precond != nil {
+		if !args.locked {
+			lom.Lock(true)
+			locked = true
+		}
+		if ecode, err := t.evalPutPrecond(args.precond, lom, args.r, true /*locked*/); err != nil {
+			if locked {
+				lom.Unlock(true)
+			}
+			return "", ecode, err
+		}
+	}
+
+	// object lock (WORM)
+	if args.worm {
+		if ecode, err := checkWORM(lom, args.locked || locked, false /*bypass*/); err != nil {
+			if locked {
+				lom.Unlock(true)
+			}
+			return "", ecode, err
+		}
+	}
+
 	// call remote
 	remote := lom.Bck().IsRemote()
 	if remote && !args.skipBackend { // skipBackend implies no need to write to backend
@@ -482,6 +556,9 @@ func (ups *ups) complete(args *completeArgs) (string, int, error) {
 
 		tag, ecode, err := ups._completeRemote(args.r, lom, uploadID, args.body, args.parts)
 		if err != nil {
+			if locked {
+				lom.Unlock(true)
+			}
 			return "", ecode, err
 		}
 		etag = tag
@@ -498,8 +575,7 @@ func (ups *ups) complete(args *completeArgs) (string, int, error) {
 	}
 
 	// Whole-object checksum: use streaming checksum if valid, otherwise CRC32C combination
-	var locked bool
-	if !args.locked {
+	if !args.locked && !locked {
 		lom.Lock(true)
 		locked = true
 	}
@@ -512,9 +588,21 @@ func (ups *ups) complete(args *completeArgs) (string, int, error) {
 		return "", 0, err
 	}
 	lom.SetCksum(cksum)
+	if args.worm {
+		applyObjLock(lom, time.Now())
+	}
+	if hasSnaps(lom) {
+		if err := preserveSnaps(lom); err != nil {
+			if locked {
+				lom.Unlock(true)
+			}
+			return "", 0, err
+		}
+	}
 
 	// atomically flip: persist manifest, mark chunked, persist main
 	// NOTE: coldGET implies the LOM's lock has been promoted to wlock
+	prevSize, prevObjs := t.quotaPrev(lom)
 	err = lom.CompleteUfest(manifest, args.locked || locked)
 	if locked {
 		lom.Unlock(true)
@@ -523,6 +611,8 @@ func (ups *ups) complete(args *completeArgs) (string, int, error) {
 		nlog.Errorf("upload %q: failed to complete %s locally: %v", uploadID, lom.Cname(), err)
 		return "", http.StatusInternalServerError, err
 	}
+	t.quota.add(lom.Bck(), lom.Lsize()-prevSize, 1-prevObjs)
+	t.evnotify(lom, cmn.EventObjCreatedMpt, args.r)
 
 	ups.del(uploadID)
 
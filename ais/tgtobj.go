@@ -56,6 +56,7 @@ type (
 		lom         *core.LOM     // obj
 		cksumToUse  *cos.Cksum    // if available (not `none`), can be validated and will be stored
 		config      *cmn.Config   // (during this request)
+		precond     *cmn.Precond  // conditional PUT (If-Match, If-None-Match: *, etc.)
 		resphdr     http.Header   // as implied
 		workFQN     string        // temp fqn to be renamed
 		atime       int64         // access time.Now()
@@ -78,6 +79,7 @@ type (
 		ctx        context.Context // context used when getting object from remote backend (access creds)
 		t          *target         // this
 		lom        *core.LOM       // obj
+		precond    *cmn.Precond    // conditional GET (If-Match, If-None-Match, etc.)
 		dpq        *dpq
 		ranges     byteRanges // range read (see https://www.rfc-editor.org/rfc/rfc7233#section-2.1)
 		atime      int64      // access time.Now()
@@ -156,10 +158,18 @@ func (poi *putOI) do(resphdr http.Header, r *http.Request, dpq *dpq) (_ int, err
 	if poi.cksumToUse, err = oah.FromHeader(r.Header); err != nil {
 		return 0, err
 	}
+	if poi.restful && !poi.t2t {
+		if err := cmn.ValidateUserHdr(r.Header); err != nil {
+			return http.StatusBadRequest, err
+		}
+	}
 
 	if dpq.sys.owt != "" {
 		poi.owt.FromS(dpq.sys.owt)
 	}
+	if poi.owt == cmn.OwtPut && poi.restful && !poi.t2t {
+		poi.precond = cmn.NewPrecond(r.Header)
+	}
 	if dpq.sys.uuid != "" {
 		// resolve cluster-wide xact "behind" this PUT (promote via a single target won't show up)
 		xctn, err := xreg.GetXact(dpq.sys.uuid)
@@ -244,7 +254,12 @@ func (poi *putOI) chunk(chunkSize int64) (ecode int, err error) {
 		isS3:        false,
 		skipBackend: poi.skipBackend,
 		locked:      poi.locked,
+		worm:        poi.owt < cmn.OwtChunks,
+		precond:     poi.precond,
 	})
+	if err != nil {
+		poi.t.ups.abort(poi.oreq, lom, uploadID)
+	}
 	return ecode, err
 }
 
@@ -253,6 +268,13 @@ func (poi *putOI) putObject() (ecode int, err error) {
 	// protect the bucket: if the object size exceeds the max monolithic size, MUST chunk
 	// NOTE: if `poi.size` is not set, don't trigger chunking
 	if maxMonoSize > 0 && poi.size > maxMonoSize {
+		if poi.precond != nil {
+			// fail early - prior to writing all the chunks;
+			// evaluated again under wlock upon completion (see ups.complete)
+			if ecode, err := poi.evalPrecond(false /*locked*/); err != nil {
+				return ecode, err
+			}
+		}
 		if cmn.Rom.V(5, cos.ModAIS) {
 			nlog.Infoln("PUT", poi.lom.Cname(), "size", poi.size, "exceeds object size limit, PUT as chunks")
 		}
@@ -260,8 +282,8 @@ func (poi *putOI) putObject() (ecode int, err error) {
 	}
 	poi.ltime = mono.NanoTime()
 
-	// if checksums match PUT is a no-op
-	if !poi.skipVC && !poi.skipBackend {
+	// if checksums match PUT is a no-op (unless conditional)
+	if !poi.skipVC && !poi.skipBackend && poi.precond == nil {
 		if poi.lom.EqCksum(poi.cksumToUse) {
 			if cmn.Rom.V(4, cos.ModAIS) {
 				nlog.Infoln(poi.lom.String(), "has identical", poi.cksumToUse.String(), "- PUT is a no-op")
@@ -307,7 +329,7 @@ func (poi *putOI) putObject() (ecode int, err error) {
 	}
 	return 0, nil
 rerr:
-	if poi.owt == cmn.OwtPut && poi.restful && !poi.t2t {
+	if poi.owt == cmn.OwtPut && poi.restful && !poi.t2t && !cmn.IsErrPrecondFailed(err) {
 		vlabs := poi._vlabs(true /*detailed*/)
 		poi.t.statsT.IncWith(stats.ErrPutCount, vlabs)
 
@@ -434,11 +456,44 @@ func (poi *putOI) _retry503() (ecode int, err error) {
 // poi.workFQN => LOM
 func (poi *putOI) fini() (ecode int, err error) {
 	var (
-		lom = poi.lom
-		bck = lom.Bck()
+		lom    = poi.lom
+		bck    = lom.Bck()
+		locked bool
+		wback  bool
 	)
-	// put remote
-	if bck.IsRemote() && poi.owt < cmn.OwtRebalance {
+	// conditional PUT: evaluate and write under the same wlock
+	// (including remote write, if any)
+	if poi.precond != nil {
+		debug.Assert(poi.owt == cmn.OwtPut, poi.owt)
+		lom.Lock(true)
+		defer lom.Unlock(true)
+		locked = true
+		if ecode, err = poi.evalPrecond(true /*locked*/); err != nil {
+			return ecode, err
+		}
+	}
+
+	// object lock (WORM): the existing object must not be retained; the new one
+	// inherits bucket's default retention
+	if poi.owt < cmn.OwtChunks {
+		if bck.Props.ObjectLock.Enabled && !locked {
+			lom.Lock(true)
+			defer lom.Unlock(true)
+			locked = true
+		}
+		if ecode, err = checkWORM(lom, locked, false /*bypass*/); err != nil {
+			return ecode, err
+		}
+		applyObjLock(lom, time.Now())
+	}
+
+	// put remote: write-through or write-back (see core/lwback)
+	if bck.IsRemote() && poi.owt == cmn.OwtPut && core.WbEnabled(lom) {
+		wback = true
+		if !bck.IsRemoteAIS() {
+			lom.ObjAttrs().DelStdCustom() // (to be updated upon write-back)
+		}
+	} else if bck.IsRemote() && poi.owt < cmn.OwtRebalance {
 		ecode, err = poi.putRemote()
 		if err != nil {
 			if cmn.Rom.V(5, cos.ModAIS) {
@@ -470,16 +525,37 @@ func (poi *putOI) fini() (ecode int, err error) {
 		defer lom.Unlock(true)
 	default:
 		debug.Assert(cos.IsValidAtime(poi.atime), poi.atime) // expecting valid atime
-		lom.Lock(true)
-		defer lom.Unlock(true)
+		if !locked {
+			lom.Lock(true)
+			defer lom.Unlock(true)
+		}
 		lom.SetAtimeUnix(poi.atime)
 	}
 
+	// bucket snapshots: copy-on-write
+	if poi.owt < cmn.OwtRebalance && hasSnaps(lom) {
+		if err := preserveSnaps(lom); err != nil {
+			return 0, err
+		}
+	}
+
+	if wback {
+		if err := lom.WbMark(poi.oreq); err != nil {
+			return 0, err
+		}
+	}
+
 	// ais versioning
+	var (
+		vers  []*core.ObjVer
+		saved string
+	)
 	if bck.IsAIS() && lom.VersionConf().Enabled {
 		switch {
 		case poi.owt >= cmn.OwtRebalance || poi.owt == cmn.OwtCopy:
 			// rebalance, copy, get*: do nothing
+		case lom.Bprops().Versioning.KeepHistory():
+			saved, vers = poi.keepVersion()
 		default:
 			// best effort
 			if remSrc, ok := lom.GetCustomKey(cmn.SourceObjMD); !ok || remSrc == "" {
@@ -491,6 +567,10 @@ func (poi *putOI) fini() (ecode int, err error) {
 	}
 
 	// done
+	var prevSize, prevObjs int64
+	if poi.owt != cmn.OwtRebalance {
+		prevSize, prevObjs = poi.t.quotaPrev(lom)
+	}
 	if err := lom.RenameFinalize(poi.workFQN); err != nil {
 		return 0, err
 	}
@@ -499,10 +579,67 @@ func (poi *putOI) fini() (ecode int, err error) {
 			nlog.Errorf("PUT (%s): failed to delete old copies [%v], proceeding anyway...", poi.loghdr(), errdc)
 		}
 	}
+	if bck.Props.Tiering.Enabled {
+		lom.DelOtherTier()
+	}
 	if lom.AtimeUnix() == 0 { // (is set when migrating within cluster; prefetch special case)
 		lom.SetAtimeUnix(poi.atime)
 	}
-	return 0, lom.PersistMain(false /*isChunked*/)
+	if err := lom.PersistMain(false /*isChunked*/); err != nil {
+		return 0, err
+	}
+	if poi.owt != cmn.OwtRebalance {
+		poi.t.quota.add(bck, lom.Lsize()-prevSize, 1-prevObjs)
+	}
+	if saved != "" || len(vers) > 0 {
+		supersede(lom, saved, vers, time.Now())
+	}
+
+	// event notifications (copies - see t.copyObject)
+	switch poi.owt {
+	case cmn.OwtPut:
+		poi.t.evnotify(lom, cmn.EventObjCreatedPut, poi.oreq)
+	case cmn.OwtPromote:
+		poi.t.evnotify(lom, cmn.EventObjCreatedPromote, nil)
+	}
+	return 0, nil
+}
+
+func (poi *putOI) evalPrecond(locked bool) (int, error) {
+	return poi.t.evalPutPrecond(poi.precond, poi.lom, poi.oreq, locked)
+}
+
+// evaluate PUT preconditions against the current object - in-cluster or, if not present, remote
+// (using a separate LOM so that the one being written stays intact)
+func (t *target) evalPutPrecond(pc *cmn.Precond, lom *core.LOM, r *http.Request, locked bool) (int, error) {
+	var (
+		etag   string
+		mtime  time.Time
+		exists bool
+		cur    = core.AllocLOM(lom.ObjName)
+	)
+	defer core.FreeLOM(cur)
+	if err := cur.InitBck(lom.Bck()); err != nil {
+		return 0, err
+	}
+	err := cur.Load(false /*cache it*/, locked)
+	switch {
+	case err == nil:
+		exists = true
+		etag, mtime = lomPrecondAttrs(cur)
+	case !cmn.IsErrObjNought(err):
+		return http.StatusInternalServerError, err
+	case cur.Bck().IsRemote():
+		oa, ecode, errH := t.HeadCold(cur, r)
+		switch {
+		case errH == nil:
+			exists = true
+			etag, mtime = oaPrecondAttrs(oa)
+		case ecode != http.StatusNotFound:
+			return ecode, errH
+		}
+	}
+	return pc.Eval(exists, etag, mtime, false /*read*/)
 }
 
 // via backend.PutObj()
@@ -511,7 +648,11 @@ func (poi *putOI) putRemote() (int, error) {
 		lom       = poi.lom
 		startTime = mono.NanoTime()
 	)
-	lmfh, err := cos.NewFileHandle(poi.workFQN)
+	var (
+		lmfh cos.ReadOpenCloser
+		err  error
+	)
+	lmfh, err = lom.NewFileHandle(poi.workFQN) // (remote gets decompressed and decrypted)
 	if err != nil {
 		return 0, cmn.NewErrFailedTo(poi.t, "open", poi.workFQN, err)
 	}
@@ -546,10 +687,20 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh cos.LomWriter, er
 			finalized bool           // to avoid computing the same checksum type twice
 		}{}
 		ckconf = poi.lom.CksumConf()
+		cw     *core.Cwriter
+		w      io.Writer
 	)
 	if lmfh, err = poi.lom.CreateWork(poi.workFQN); err != nil {
 		return nil, nil, nil, err
 	}
+	w = lmfh
+	if cw, err = poi.lom.NewCwriter(lmfh); err != nil {
+		return nil, nil, lmfh, err
+	}
+	if cw != nil {
+		defer cw.Close() // (no-op when flushed)
+		w = cw
+	}
 	if poi.size <= 0 {
 		buf, slab = poi.t.gmm.Alloc()
 	} else {
@@ -561,16 +712,16 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh cos.LomWriter, er
 		poi.lom.SetCksum(cos.NoneCksum)
 		// not using `ReadFrom` of the `*os.File` -
 		// ultimately, https://github.com/golang/go/blob/master/src/internal/poll/copy_file_range_linux.go#L100
-		written, err = cos.CopyBuffer(lmfh, poi.r, buf)
+		written, err = cos.CopyBuffer(w, poi.r, buf)
 	case !cos.NoneC(poi.cksumToUse) && !poi.validateCksum(ckconf):
 		// if the corresponding validation is not configured/enabled we just go ahead
 		// and use the checksum that has arrived with the object
 		poi.lom.SetCksum(poi.cksumToUse)
 		// (ditto)
-		written, err = cos.CopyBuffer(lmfh, poi.r, buf)
+		written, err = cos.CopyBuffer(w, poi.r, buf)
 	default:
 		writers := make([]io.Writer, 0, 3)
-		writers = append(writers, lmfh)
+		writers = append(writers, w)
 		cksums.store = cos.NewCksumHash(ckconf.Type) // always according to the bucket
 		writers = append(writers, cksums.store.H)
 		if !poi.skipVC && !cos.NoneC(poi.cksumToUse) && poi.validateCksum(ckconf) {
@@ -601,6 +752,12 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh cos.LomWriter, er
 	}
 
 	// ok
+	if cw != nil {
+		if err = cw.Close(); err != nil {
+			return buf, slab, lmfh, err
+		}
+	}
+	poi.lom.SetStored(cw)
 	if poi.lom.IsFeatureSet(feat.FsyncPUT) {
 		err = lmfh.Sync() // compare w/ cos.FlushClose
 		debug.AssertNoErr(err)
@@ -809,6 +966,11 @@ do: // retry uplock or ec-recovery, the latter only once
 
 	// read locally and stream back
 fin:
+	if goi.precond != nil {
+		if ecode, err = goi.evalPrecond(); ecode != 0 {
+			return ecode, err // (304 is written by evalPrecond)
+		}
+	}
 	var fqn string
 	fqn, ecode, err = goi.txfini()
 	if err == nil {
@@ -826,6 +988,18 @@ fin:
 	return ecode, err
 }
 
+// conditional GET: evaluate preconditions against the (loaded) object;
+// when not modified, write the 304 response right away
+func (goi *getOI) evalPrecond() (int, error) {
+	etag, mtime := lomPrecondAttrs(goi.lom)
+	ecode, err := goi.precond.Eval(true /*exists*/, etag, mtime, true /*read*/)
+	if ecode == http.StatusNotModified {
+		precondToHdr(goi.w.Header(), etag, mtime)
+		goi.w.WriteHeader(ecode)
+	}
+	return ecode, err
+}
+
 func (goi *getOI) expostfacto(fqn string) error {
 	lom := goi.lom
 
@@ -866,6 +1040,8 @@ func (goi *getOI) isStreamingColdGet() bool {
 		return false
 	case goi.lom.Bprops().Chunks.AutoEnabled():
 		return false
+	case goi.precond != nil:
+		return false
 	}
 	return true
 }
@@ -1434,9 +1610,9 @@ func (goi *getOI) sendfile(r io.Reader, fqn string, size int64, committed bool)
 	return nil
 }
 
-// source must be monolithic file-backed (see assert)
+// source must be monolithic file-backed and uncompressed (see assert)
 func (goi *getOI) canSendfile(lmfh cos.LomReader) bool {
-	if cmn.Rom.UseHTTPS() || goi.lom.IsChunked() {
+	if cmn.Rom.UseHTTPS() || goi.lom.IsChunked() || goi.lom.IsEncoded() {
 		return false
 	}
 
@@ -1638,7 +1814,11 @@ func (a *apndOI) apnd(buf []byte) (packedHdl string, err error) {
 		workFQN = a.lom.GenFQN(fs.WorkCT, fs.WorkfileAppend)
 		a.lom.Lock(false)
 		if a.lom.Load(false /*cache it*/, false /*locked*/) == nil {
-			_, a.hdl.partialCksum, err = cos.CopyFile(a.lom.FQN, workFQN, buf, a.lom.CksumType())
+			if a.lom.IsEncoded() {
+				a.hdl.partialCksum, err = a.decompress(workFQN, buf)
+			} else {
+				_, a.hdl.partialCksum, err = cos.CopyFile(a.lom.FQN, workFQN, buf, a.lom.CksumType())
+			}
 			a.lom.Unlock(false)
 			if err != nil {
 				return "", err
@@ -1679,6 +1859,30 @@ func (a *apndOI) apnd(buf []byte) (packedHdl string, err error) {
 	return packedHdl, nil
 }
 
+// appending to a compressed and/or encrypted object: start with its decoded content
+// (flush, in turn, encodes the result - see _promLocal)
+func (a *apndOI) decompress(workFQN string, buf []byte) (*cos.CksumHash, error) {
+	lmfh, err := a.lom.Open()
+	if err != nil {
+		return nil, err
+	}
+	wfh, err := a.lom.CreateWork(workFQN)
+	if err != nil {
+		cos.Close(lmfh)
+		return nil, err
+	}
+	_, cksum, err := cos.CopyAndChecksum(wfh, lmfh, buf, a.lom.CksumType())
+	cos.Close(lmfh)
+	cos.Close(wfh)
+	if err != nil {
+		if nerr := cos.RemoveFile(workFQN); nerr != nil {
+			nlog.Errorf(fmtNested, a.t, err, "remove", workFQN, nerr)
+		}
+		return nil, err
+	}
+	return cksum, nil
+}
+
 func (a *apndOI) flush() (int, error) {
 	if a.hdl.workFQN == "" {
 		return 0, fmt.Errorf("failed to finalize append-file operation: empty source in the %+v handle", a.hdl)
@@ -1831,6 +2035,10 @@ func (coi *coi) do(t *target, dm *bundle.DM, lom *core.LOM) (res xs.CoiRes) {
 	case lom.Bprops().Chunks.MaxMonolithicSize != dstMaxMonoSize && lom.Lsize() > int64(dstMaxMonoSize):
 		// source and destination buckets have different chunks config => rechunk if the source exceeds the destination's limit
 		res = coi._chunk(t, lom, dst, int64(dst.Bprops().Chunks.ChunkSize))
+	case !lom.Bprops().Encryption.SameKey(&dst.Bprops().Encryption):
+		// different data keys => decrypt and re-encrypt (raw copy won't do)
+		coi.GetROC = core.GetDefaultROC
+		res = coi._reader(t, dm, lom, dst, coi.ETLArgs)
 	default:
 		// fast path: destination is _this_ target
 		// (note coi.send(=> another target) above)
@@ -2155,7 +2363,7 @@ func (a *putA2I) do() (int, error) {
 	}
 	// standard library does not support appending to tgz, zip, and such;
 	// for TAR there is an optimizing workaround not requiring a full copy
-	if a.mime == archive.ExtTar && !a.put /*append*/ && !a.lom.IsChunked() {
+	if a.mime == archive.ExtTar && !a.put /*append*/ && !a.lom.IsChunked() && !a.lom.IsEncoded() {
 		var (
 			err       error
 			fh        *os.File
@@ -2181,7 +2389,7 @@ func (a *putA2I) do() (int, error) {
 		// do - fast
 		if size, err = a.fast(fh, tarFormat, offset); err == nil {
 			// TODO: checksum NIY
-			if err = a.finalize(size, cos.NoneCksum, workFQN); err == nil {
+			if err = a.finalize(size, cos.NoneCksum, workFQN, nil); err == nil {
 				return http.StatusInternalServerError, nil // ok
 			}
 		} else if errV := a.lom.RenameToMain(workFQN); errV != nil {
@@ -2194,6 +2402,8 @@ cpap: // copy + append
 	var (
 		err, erc error
 		wfh      *os.File
+		w        io.Writer
+		cw       *core.Cwriter
 		lmfh     cos.LomReader
 		workFQN  string
 		cksum    cos.CksumHashSize
@@ -2204,12 +2414,21 @@ cpap: // copy + append
 	if err != nil {
 		return http.StatusInternalServerError, err
 	}
+	w = wfh
+	if cw, err = a.lom.NewCwriter(wfh); err != nil {
+		cos.Close(wfh)
+		cos.RemoveFile(workFQN)
+		return http.StatusInternalServerError, err
+	}
+	if cw != nil {
+		w = cw
+	}
 	// currently, arch writers only use size and time but it may change
 	oah := cos.SimpleOAH{Size: a.size, Atime: a.started}
 	if a.put {
 		// when append becomes PUT (TODO: checksum type)
 		cksum.Init(cos.ChecksumCesXxh)
-		aw = archive.NewWriter(a.mime, wfh, &cksum, nil /*opts*/)
+		aw = archive.NewWriter(a.mime, w, &cksum, nil /*opts*/)
 		err = aw.Write(a.filename, oah, a.r)
 		erc = aw.Fini()
 	} else {
@@ -2220,7 +2439,7 @@ cpap: // copy + append
 			return http.StatusNotFound, err
 		}
 		cksum.Init(a.lom.CksumType())
-		aw = archive.NewWriter(a.mime, wfh, &cksum, nil)
+		aw = archive.NewWriter(a.mime, w, &cksum, nil)
 		err = aw.Copy(lmfh, a.lom.Lsize())
 		if err == nil {
 			err = aw.Write(a.filename, oah, a.r)
@@ -2230,13 +2449,18 @@ cpap: // copy + append
 	}
 
 	// finalize
+	if cw != nil {
+		if e := cw.Close(); erc == nil {
+			erc = e
+		}
+	}
 	cos.Close(wfh)
 	if err == nil {
 		err = erc
 	}
 	if err == nil {
 		cksum.Finalize()
-		err = a.finalize(cksum.Size, cksum.Clone(), workFQN)
+		err = a.finalize(cksum.Size, cksum.Clone(), workFQN, cw)
 	} else {
 		cos.RemoveFile(workFQN)
 	}
@@ -2277,17 +2501,22 @@ func (*putA2I) reterr(err error) (int, error) {
 	return ecode, err
 }
 
-func (a *putA2I) finalize(size int64, cksum *cos.Cksum, fqn string) error {
+func (a *putA2I) finalize(size int64, cksum *cos.Cksum, fqn string, cw *core.Cwriter) error {
+	psize := size
+	if cw != nil {
+		psize = cw.Psize()
+	}
 	debug.Func(func() {
 		finfo, err := os.Stat(fqn)
 		debug.AssertNoErr(err)
-		debug.Assertf(finfo.Size() == size, "%d != %d", finfo.Size(), size)
+		debug.Assertf(finfo.Size() == psize, "%d != %d", finfo.Size(), psize)
 	})
 	// done
 	if err := a.lom.RenameFinalize(fqn); err != nil {
 		return err
 	}
 	a.lom.SetSize(size)
+	a.lom.SetStored(cw)
 	a.lom.SetCksum(cksum)
 	a.lom.SetAtimeUnix(a.started)
 	if err := a.lom.Persist(); err != nil {
@@ -2426,6 +2655,35 @@ var (
 	snd0 sendArgs
 )
 
+//
+// conditional requests: current object's ETag and last-modified
+//
+
+func lomPrecondAttrs(lom *core.LOM) (etag string, mtime time.Time) {
+	mtime, _ = lom.LastModified()
+	etag = lom.ETag(mtime, true /*allow syscall*/)
+	return etag, mtime
+}
+
+// given object attributes returned by remote backend
+func oaPrecondAttrs(oa *cmn.ObjAttrs) (etag string, mtime time.Time) {
+	etag, _ = oa.GetCustomKey(cmn.ETag)
+	if s, ok := oa.GetCustomKey(cos.HdrLastModified); ok {
+		mtime, _ = time.Parse(http.TimeFormat, s)
+	}
+	return etag, mtime
+}
+
+// 304 response headers
+func precondToHdr(whdr http.Header, etag string, mtime time.Time) {
+	if etag != "" {
+		whdr.Set(cos.HdrETag, cmn.QuoteETag(etag))
+	}
+	if !mtime.IsZero() {
+		whdr.Set(cos.HdrLastModified, mtime.UTC().Format(http.TimeFormat))
+	}
+}
+
 func allocGOI() *getOI {
 	return goiPool.Get().(*getOI)
 }
@@ -127,6 +127,92 @@ func TestPutObjectChunks(tst *testing.T) {
 	}
 }
 
+// conditional PUT of a chunked object: preconditions are evaluated upon completion, under wlock
+func TestPutObjectChunksPrecond(test *testing.T) {
+	const size, chunkSize = 300, 100
+	lom := core.AllocLOM("test-chunked-obj-precond")
+	defer core.FreeLOM(lom)
+	err := lom.InitBck(&meta.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal})
+	tassert.CheckFatal(test, err)
+	defer lom.RemoveMain()
+
+	put := func(precond *cmn.Precond) (int, error) {
+		reader, _ := readers.New(&readers.Arg{Type: readers.Rand, Size: size, CksumType: cos.ChecksumNone})
+		poi := &putOI{
+			atime:   time.Now().UnixNano(),
+			t:       mockTarget,
+			lom:     lom,
+			r:       reader,
+			oreq:    &http.Request{Header: make(http.Header)},
+			workFQN: path.Join(testMountpath, "test-chunked-obj-precond.work"),
+			config:  cmn.GCO.Get(),
+			size:    size,
+			owt:     cmn.OwtPut,
+			precond: precond,
+		}
+		return poi.chunk(chunkSize)
+	}
+
+	// create only
+	_, err = put(&cmn.Precond{IfNoneMatch: "*"})
+	tassert.CheckFatal(test, err)
+
+	// object exists: 412
+	ecode, err := put(&cmn.Precond{IfNoneMatch: "*"})
+	tassert.Fatalf(test, cmn.IsErrPrecondFailed(err), "expected precondition failure, got %v", err)
+	tassert.Fatalf(test, ecode == http.StatusPreconditionFailed, "expected status %d, got %d", http.StatusPreconditionFailed, ecode)
+
+	ecode, err = put(&cmn.Precond{IfMatch: `"no-such-etag"`})
+	tassert.Fatalf(test, cmn.IsErrPrecondFailed(err), "expected precondition failure, got %v", err)
+	tassert.Fatalf(test, ecode == http.StatusPreconditionFailed, "expected status %d, got %d", http.StatusPreconditionFailed, ecode)
+}
+
+// conditional GET/HEAD of a prior version or snapshot: evaluated against the latter
+func TestPrecondVer(test *testing.T) {
+	vlom := core.AllocLOM("test-obj-precond-ver")
+	defer core.FreeLOM(vlom)
+	err := vlom.InitBck(&meta.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal})
+	tassert.CheckFatal(test, err)
+	mtime := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	vlom.SetCustomKey(cmn.ETag, "prior-etag")
+	vlom.SetCustomKey(cos.HdrLastModified, mtime.Format(http.TimeFormat))
+
+	eval := func(key, val string) (http.Header, int, error) {
+		r := &http.Request{Header: make(http.Header)}
+		if key != "" {
+			r.Header.Set(key, val)
+		}
+		whdr := make(http.Header)
+		ecode, err := precondVer(r, whdr, vlom)
+		return whdr, ecode, err
+	}
+
+	// unconditional
+	_, ecode, err := eval("", "")
+	tassert.Fatalf(test, ecode == 0 && err == nil, "expected to proceed, got %d (%v)", ecode, err)
+
+	// match the prior version (not the current object)
+	_, ecode, err = eval(cos.HdrIfMatch, `"prior-etag"`)
+	tassert.Fatalf(test, ecode == 0 && err == nil, "expected to proceed, got %d (%v)", ecode, err)
+
+	_, ecode, err = eval(cos.HdrIfMatch, `"current-etag"`)
+	tassert.Fatalf(test, cmn.IsErrPrecondFailed(err), "expected precondition failure, got %v", err)
+	tassert.Fatalf(test, ecode == http.StatusPreconditionFailed, "expected status %d, got %d", http.StatusPreconditionFailed, ecode)
+
+	_, ecode, err = eval(cos.HdrIfUnmodifiedSince, mtime.Add(-time.Hour).Format(http.TimeFormat))
+	tassert.Fatalf(test, ecode == http.StatusPreconditionFailed, "expected status %d, got %d (%v)", http.StatusPreconditionFailed, ecode, err)
+
+	// not modified
+	whdr, ecode, err := eval(cos.HdrIfNoneMatch, `"prior-etag"`)
+	tassert.CheckFatal(test, err)
+	tassert.Fatalf(test, ecode == http.StatusNotModified, "expected status %d, got %d", http.StatusNotModified, ecode)
+	tassert.Fatalf(test, whdr.Get(cos.HdrETag) == `"prior-etag"`, "expected ETag of the prior version, got %q", whdr.Get(cos.HdrETag))
+
+	_, ecode, err = eval(cos.HdrIfModifiedSince, mtime.Format(http.TimeFormat))
+	tassert.CheckFatal(test, err)
+	tassert.Fatalf(test, ecode == http.StatusNotModified, "expected status %d, got %d", http.StatusNotModified, ecode)
+}
+
 func BenchmarkObjPut(b *testing.B) {
 	benches := []struct {
 		fileSize int64
@@ -0,0 +1,314 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/hk"
+)
+
+// Capacity and object-count quotas (see cmn/quota and prxquota):
+// - target tracks local usage of the buckets that have quotas, or belong to namespaces that do;
+// - the initial (and periodic) recount walks the bucket; in between, writes and deletes
+//   update the counters incrementally;
+// - periodically, target reports local usage to the primary and gets back
+//   cluster-wide totals - the latter (minus own contribution) plus current local usage
+//   is what hard quotas are checked against;
+// - rebalance (that moves objects between targets) is not counted - recount takes care of it.
+
+const quotaRecountIval = time.Hour
+
+type (
+	quotaCnt struct {
+		ns      string // namespace uname
+		counted int64  // mono time of the last recount
+		size    atomic.Int64
+		objs    atomic.Int64
+	}
+	tquota struct {
+		bcks    map[string]*quotaCnt      // bucket uname => local usage
+		others  map[string]cmn.QuotaUsage // bucket or namespace uname => usage by all other targets (as of the last report)
+		mu      sync.RWMutex
+		walking atomic.Bool // recount in progress
+	}
+)
+
+func quotaApplies(bmd *bucketMD, bck *meta.Bck) bool {
+	return bck.Props.Quota.IsSet() || bmd.NsQuota(&bck.Ns) != nil
+}
+
+// check hard quota(s) prior to writing `size` bytes
+// NOTE: not counted yet (e.g., right after setting quota) implies not enforced yet
+// NOTE: unknown size (-1: chunked transfer encoding) must still fit - i.e., fails
+// when already at or over the limit
+func (t *target) quotaCheck(lom *core.LOM, size int64) error {
+	var (
+		bck = lom.Bck()
+		bq  = &bck.Props.Quota
+		nq  = t.owner.bmd.get().NsQuota(&bck.Ns)
+	)
+	if !bq.HasHard() && (nq == nil || !nq.HasHard()) {
+		return nil
+	}
+	bused, nused, ok := t.quota.usage(bck)
+	if !ok {
+		return nil
+	}
+	unknown := size < 0
+	if unknown {
+		size = 1 // at least one byte
+	}
+	// overwriting existing object (unknown size: may not shrink it - don't count)
+	objs := int64(1)
+	cur := core.AllocLOM(lom.ObjName)
+	if cur.InitBck(bck) == nil && cur.Load(false /*cache it*/, false /*locked*/) == nil {
+		objs = 0
+		if !unknown {
+			size -= cur.Lsize()
+		}
+	}
+	core.FreeLOM(cur)
+
+	if err := bq.CheckHard(&bused, size, objs, "bucket", bck.Cname("")); err != nil {
+		return err
+	}
+	if nq != nil {
+		return nq.CheckHard(&nused, size, objs, "namespace", bck.Ns.Uname())
+	}
+	return nil
+}
+
+// (under wlock) the existing object's contribution, if any - prior to overwriting it
+func (t *target) quotaPrev(lom *core.LOM) (size, objs int64) {
+	if !t.quota.tracked(lom.Bck()) {
+		return 0, 0
+	}
+	cur := core.AllocLOM(lom.ObjName)
+	if cur.InitBck(lom.Bck()) == nil && cur.Load(false /*cache it*/, true /*locked*/) == nil {
+		size, objs = cur.Lsize(), 1
+	}
+	core.FreeLOM(cur)
+	return size, objs
+}
+
+// periodically: update the set of tracked buckets, recount, report usage to primary
+func (t *target) quotaHK(int64) time.Duration {
+	if !t.ClusterStarted() || nlog.Stopping() {
+		return hk.QuotaIval
+	}
+	var (
+		bmd     = t.owner.bmd.get()
+		now     = mono.NanoTime()
+		recount []*meta.Bck
+		tq      = &t.quota
+		want    = make(map[string]struct{}, 4)
+	)
+	tq.mu.Lock()
+	bmd.Range(nil, nil, func(bck *meta.Bck) bool {
+		if !quotaApplies(bmd, bck) {
+			return false
+		}
+		uname := string(bck.MakeUname(""))
+		want[uname] = struct{}{}
+		if cnt, ok := tq.bcks[uname]; !ok || time.Duration(now-cnt.counted) > quotaRecountIval {
+			recount = append(recount, bck)
+		}
+		return false
+	})
+	for uname := range tq.bcks {
+		if _, ok := want[uname]; !ok {
+			delete(tq.bcks, uname)
+		}
+	}
+	tracking := len(tq.bcks) > 0
+	if !tracking {
+		tq.others = nil
+	}
+	tq.mu.Unlock()
+
+	if len(recount) > 0 && tq.walking.CAS(false, true) {
+		go t.quotaRecount(recount)
+	}
+	if tracking {
+		t.quotaReport()
+	}
+	return hk.QuotaIval
+}
+
+func (t *target) quotaRecount(bcks []*meta.Bck) {
+	defer t.quota.walking.Store(false)
+	for _, bck := range bcks {
+		var (
+			u     cmn.QuotaUsage
+			avail = fs.GetAvail()
+		)
+		for _, mi := range avail {
+			opts := &fs.WalkOpts{
+				Mi:  mi,
+				Bck: *bck.Bucket(),
+				CTs: []string{fs.ObjCT},
+				Callback: func(fqn string, _ fs.DirEntry) error {
+					lom := core.AllocLOM("")
+					if lom.InitFQN(fqn, bck.Bucket()) == nil && lom.Load(false /*cache it*/, false /*locked*/) == nil {
+						if !lom.HasCopies() || !lom.IsCopy() {
+							u.Add(lom.Lsize(), 1)
+						}
+					}
+					core.FreeLOM(lom)
+					return nil
+				},
+			}
+			if err := fs.Walk(opts); err != nil && !cos.IsNotExist(err) {
+				nlog.Warningln(t.String(), "quota: failed to count", bck.Cname(""), err)
+			}
+		}
+		t.quota.set(bck, &u)
+	}
+}
+
+// report local usage and get back cluster-wide totals
+func (t *target) quotaReport() {
+	var (
+		smap  = t.owner.smap.get()
+		local = t.quota.local()
+	)
+	if len(local.Bcks) == 0 || smap.validate() != nil {
+		return
+	}
+	cargs := allocCargs()
+	{
+		cargs.si = smap.Primary
+		cargs.req = cmn.HreqArgs{
+			Method: http.MethodPut,
+			Path:   apc.URLPathClu.S,
+			Body:   cos.MustMarshal(apc.ActMsg{Action: apc.ActQuotaReport, Value: local}),
+		}
+		cargs.timeout = cmn.Rom.CplaneOperation()
+		cargs.cresv = cresjGeneric[cmn.QuotaStats]{}
+	}
+	res := t.call(cargs, smap)
+	freeCargs(cargs)
+	if res.err != nil {
+		if cmn.Rom.V(4, cos.ModAIS) {
+			nlog.Warningln(t.String(), "quota: failed to report usage:", res.err)
+		}
+	} else {
+		t.quota.update(local, res.v.(*cmn.QuotaStats))
+	}
+	freeCR(res)
+}
+
+////////////
+// tquota //
+////////////
+
+func (tq *tquota) tracked(bck *meta.Bck) bool {
+	tq.mu.RLock()
+	if len(tq.bcks) == 0 {
+		tq.mu.RUnlock()
+		return false
+	}
+	_, ok := tq.bcks[string(bck.MakeUname(""))]
+	tq.mu.RUnlock()
+	return ok
+}
+
+// written (positive) or deleted (negative)
+func (tq *tquota) add(bck *meta.Bck, size, objs int64) {
+	tq.mu.RLock()
+	if len(tq.bcks) > 0 {
+		if cnt, ok := tq.bcks[string(bck.MakeUname(""))]; ok {
+			cnt.size.Add(size)
+			cnt.objs.Add(objs)
+		}
+	}
+	tq.mu.RUnlock()
+}
+
+// recount replaces the counters (and absorbs any drift)
+func (tq *tquota) set(bck *meta.Bck, u *cmn.QuotaUsage) {
+	uname := string(bck.MakeUname(""))
+	tq.mu.Lock()
+	cnt, ok := tq.bcks[uname]
+	if !ok {
+		if tq.bcks == nil {
+			tq.bcks = make(map[string]*quotaCnt, 4)
+		}
+		cnt = &quotaCnt{ns: bck.Ns.Uname()}
+		tq.bcks[uname] = cnt
+	}
+	cnt.size.Store(u.Size)
+	cnt.objs.Store(u.Objs)
+	cnt.counted = mono.NanoTime()
+	tq.mu.Unlock()
+}
+
+// cluster-wide bucket and namespace usage: others' as of the last report + local now
+func (tq *tquota) usage(bck *meta.Bck) (bused, nused cmn.QuotaUsage, ok bool) {
+	var (
+		uname = string(bck.MakeUname(""))
+		ns    = bck.Ns.Uname()
+	)
+	tq.mu.RLock()
+	defer tq.mu.RUnlock()
+	cnt, ok := tq.bcks[uname]
+	if !ok {
+		return bused, nused, false
+	}
+	bused = tq.others[uname]
+	bused.Add(cnt.size.Load(), cnt.objs.Load())
+	nused = tq.others[ns]
+	for _, c := range tq.bcks {
+		if c.ns == ns {
+			nused.Add(c.size.Load(), c.objs.Load())
+		}
+	}
+	return bused, nused, true
+}
+
+func (tq *tquota) local() *cmn.QuotaStats {
+	tq.mu.RLock()
+	out := &cmn.QuotaStats{Bcks: make(map[string]cmn.QuotaUsage, len(tq.bcks))}
+	for uname, cnt := range tq.bcks {
+		out.Bcks[uname] = cmn.QuotaUsage{Size: cnt.size.Load(), Objs: cnt.objs.Load()}
+	}
+	tq.mu.RUnlock()
+	return out
+}
+
+// given reported local usage and the resulting cluster-wide totals
+func (tq *tquota) update(local, all *cmn.QuotaStats) {
+	others := make(map[string]cmn.QuotaUsage, len(all.Bcks)+len(all.Nss))
+	for uname, u := range all.Bcks {
+		l := local.Bcks[uname]
+		u.Add(-l.Size, -l.Objs)
+		others[uname] = u
+	}
+	for ns, u := range all.Nss {
+		others[ns] = u
+	}
+	tq.mu.Lock()
+	for uname, l := range local.Bcks {
+		if cnt, ok := tq.bcks[uname]; ok {
+			u := others[cnt.ns]
+			u.Add(-l.Size, -l.Objs)
+			others[cnt.ns] = u
+		}
+	}
+	tq.others = others
+	tq.mu.Unlock()
+}
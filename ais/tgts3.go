@@ -5,6 +5,7 @@
 package ais
 
 import (
+	"encoding/xml"
 	"errors"
 	"fmt"
 	"net/http"
@@ -37,6 +38,10 @@ func (t *target) s3Handler(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		return
 	}
+	// CORS (redirected cross-origin requests)
+	if len(apiItems) > 0 && s3.HandleCORS(w, r, apiItems[0], t.owner.bmd) {
+		return
+	}
 	dpq := dpqAlloc()
 	defer dpqFree(dpq)
 	if err := dpq.parse(r.URL.RawQuery); err != nil {
@@ -68,9 +73,12 @@ func (t *target) s3Handler(w http.ResponseWriter, r *http.Request) {
 	case http.MethodPut:
 		t.putCopyMpt(w, r, dpq, apiItems)
 	case http.MethodDelete:
-		if dpq.has(s3.QparamMptUploadID) {
+		switch {
+		case dpq.has(s3.QparamMptUploadID):
 			t.abortMptS3(w, r, dpq, apiItems)
-		} else {
+		case dpq.has(s3.QparamTagging):
+			t.delObjTagsS3(w, r, apiItems)
+		default:
 			t.delObjS3(w, r, apiItems)
 		}
 	case http.MethodPost:
@@ -100,6 +108,10 @@ func (t *target) putCopyMpt(w http.ResponseWriter, r *http.Request, dpq *dpq, it
 	}
 
 	switch {
+	case dpq.has(s3.QparamTagging):
+		t.putObjTagsS3(w, r, bck, items)
+	case dpq.has(s3.QparamRetention) || dpq.has(s3.QparamLegalHold):
+		t.putObjLockS3(w, r, bck, items, dpq.has(s3.QparamLegalHold))
 	case dpq.has(s3.QparamMptPartNo) && dpq.has(s3.QparamMptUploadID):
 		if r.Header.Get(cos.S3HdrObjSrc) != "" {
 			// TODO:
@@ -234,6 +246,30 @@ func (t *target) putObjS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck,
 			return
 		}
 	}
+	if err := t.quotaCheck(lom, r.ContentLength); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden, Code: s3.ErrCodeQuotaExceeded})
+		return
+	}
+	if v := r.Header.Get(s3.HdrTagging); v != "" {
+		tags, err := cmn.ParseObjTags(v)
+		if err != nil {
+			s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: s3.ErrCodeInvalidTag})
+			return
+		}
+		lom.SetCustomKey(cmn.TagsObjMD, cmn.EncodeObjTags(tags))
+	}
+	if custom, err := s3.ObjLockFromHeaders(r.Header); err != nil || custom != nil {
+		if err == nil && !bck.Props.ObjectLock.Enabled {
+			err = fmt.Errorf("bucket %s is missing object lock configuration", bck.Cname(""))
+		}
+		if err != nil {
+			s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: s3.ErrCodeInvalidRequest})
+			return
+		}
+		for k, v := range custom {
+			lom.SetCustomKey(k, v)
+		}
+	}
 	started := time.Now()
 	lom.SetAtimeUnix(started.UnixNano())
 
@@ -281,6 +317,14 @@ func (t *target) getObjS3(w http.ResponseWriter, r *http.Request, items []string
 	if errN != nil {
 		return
 	}
+	if dpq.has(s3.QparamTagging) {
+		t.getObjTagsS3(w, r, bck, objName)
+		return
+	}
+	if dpq.has(s3.QparamRetention) || dpq.has(s3.QparamLegalHold) {
+		t.getObjLockS3(w, r, bck, objName, dpq.has(s3.QparamLegalHold))
+		return
+	}
 	if dpq.has(s3.QparamMptPartNo) {
 		if cmn.Rom.V(5, cos.ModS3) {
 			nlog.Infoln("getMptPart", bck.String(), objName, dpq.m)
@@ -332,6 +376,11 @@ func (t *target) headObjS3(w http.ResponseWriter, r *http.Request, items []strin
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
 		return
 	}
+	if version := r.URL.Query().Get(s3.QparamVersionID); version != "" && version != s3.NullVersionID {
+		if t.headObjVerS3(w, r, lom, version) {
+			return
+		}
+	}
 	exists := true
 	err = lom.Load(true /*cache it*/, false /*locked*/)
 	if err != nil {
@@ -370,6 +419,28 @@ func (t *target) headObjS3(w http.ResponseWriter, r *http.Request, items []strin
 	custom := op.GetCustomMD()
 	lom.SetCustomMD(custom)
 
+	// conditional HEAD
+	if pc := cmn.NewPrecond(r.Header); pc != nil {
+		var (
+			etag  string
+			mtime time.Time
+		)
+		if exists {
+			etag, mtime = lomPrecondAttrs(lom)
+		} else {
+			etag, mtime = oaPrecondAttrs(&op.ObjAttrs)
+		}
+		if ecode, err := pc.Eval(true /*exists*/, etag, mtime, true /*read*/); ecode != 0 {
+			if err != nil {
+				s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: ecode})
+				return
+			}
+			s3.SetS3Headers(hdr, lom)
+			w.WriteHeader(ecode)
+			return
+		}
+	}
+
 	// set s3 response headers
 	s3.SetS3Headers(hdr, lom)
 	hdr.Set(cos.HdrContentLength, strconv.FormatInt(op.Size, 10))
@@ -401,23 +472,214 @@ func (t *target) delObjS3(w http.ResponseWriter, r *http.Request, items []string
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
 		return
 	}
-	ecode, err = t.DeleteObject(lom, false)
+	bypass := cos.IsParseBool(r.Header.Get(s3.HdrBypassGovernance))
+	if version := r.URL.Query().Get(s3.QparamVersionID); version != "" && version != s3.NullVersionID {
+		t.delObjVerS3(w, r, lom, version, bypass)
+		return
+	}
+	ecode, err = t.deleteObject(lom, false /*evict*/, bypass, nil)
 	if err != nil {
 		name := lom.Cname()
-		if ecode == http.StatusNotFound {
+		switch {
+		case ecode == http.StatusNotFound:
 			err := cos.NewErrNotFound(t, name)
 			ei := s3.ErrInfo{Err: err, Status: http.StatusNotFound, Code: s3.NoSuchKey}
 			s3.WriteErr(w, r, ei)
-		} else {
+		case cmn.IsErrObjLocked(err):
+			s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden, Code: s3.ErrCodeAccessDenied})
+		default:
 			err := fmt.Errorf("error deleting %s: %v", name, err)
 			s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: ecode})
 		}
 		return
 	}
+	if bck.IsAIS() && bck.Props.Versioning.KeepHistory() {
+		w.Header().Set(s3.HdrDeleteMarker, "true")
+	}
 	// EC cleanup if EC is enabled
 	ec.ECM.CleanupObject(lom)
 }
 
+// GET /s3/<bucket-name>/<object-name>?tagging
+// See: https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetObjectTagging.html
+func (t *target) getObjTagsS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+		return
+	}
+	if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
+		ei := s3.ErrInfo{Err: err}
+		if cos.IsNotExist(err) {
+			ei.Err = cos.NewErrNotFound(t, lom.Cname())
+			ei.Status, ei.Code = http.StatusNotFound, s3.NoSuchKey
+		}
+		s3.WriteErr(w, r, ei)
+		return
+	}
+	tags, err := cmn.ObjTags(lom.GetCustomMD())
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+		return
+	}
+	sgl := t.gmm.NewSGL(0)
+	s3.NewTagging(tags).MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// PUT /s3/<bucket-name>/<object-name>?tagging
+// See: https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectTagging.html
+func (t *target) putObjTagsS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, items []string) {
+	objName, errN := s3.JoinValidateOname(w, r, items)
+	if errN != nil {
+		return
+	}
+	tagging := &s3.Tagging{}
+	if err := xml.NewDecoder(r.Body).Decode(tagging); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: s3.ErrCodeMalformedXML})
+		return
+	}
+	tags, err := tagging.ToTags()
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: s3.ErrCodeInvalidTag})
+		return
+	}
+	t._setObjTagsS3(w, r, bck, objName, tags)
+}
+
+// DELETE /s3/<bucket-name>/<object-name>?tagging
+// See: https://docs.aws.amazon.com/AmazonS3/latest/API/API_DeleteObjectTagging.html
+func (t *target) delObjTagsS3(w http.ResponseWriter, r *http.Request, items []string) {
+	bck, ecode, err := meta.InitByNameOnly(items[0], t.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: ecode})
+		return
+	}
+	objName, errN := s3.JoinValidateOname(w, r, items)
+	if errN != nil {
+		return
+	}
+	if t._setObjTagsS3(w, r, bck, objName, nil) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (t *target) _setObjTagsS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string, tags cos.StrKVs) bool {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+		return false
+	}
+	ecode, err := t.setObjTags(lom, tags)
+	if err == nil {
+		return true
+	}
+	ei := s3.ErrInfo{Err: err, Status: ecode}
+	if ecode == http.StatusNotFound {
+		ei.Err, ei.Code = cos.NewErrNotFound(t, lom.Cname()), s3.NoSuchKey
+	}
+	s3.WriteErr(w, r, ei)
+	return false
+}
+
+// GET /s3/<bucket-name>/<object-name>?retention|legal-hold
+// See: https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetObjectRetention.html
+func (t *target) getObjLockS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string, legalHold bool) {
+	if !bck.Props.ObjectLock.Enabled {
+		err := fmt.Errorf("bucket %s is missing object lock configuration", bck.Cname(""))
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: s3.ErrCodeInvalidRequest})
+		return
+	}
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+		return
+	}
+	if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
+		ei := s3.ErrInfo{Err: err}
+		if cos.IsNotExist(err) {
+			ei.Err = cos.NewErrNotFound(t, lom.Cname())
+			ei.Status, ei.Code = http.StatusNotFound, s3.NoSuchKey
+		}
+		s3.WriteErr(w, r, ei)
+		return
+	}
+	ret := lom.Retention()
+	sgl := t.gmm.NewSGL(0)
+	switch {
+	case legalHold:
+		s3.NewLegalHold(ret.LegalHold).MustMarshal(sgl)
+	case ret.RetainUntil.IsZero():
+		sgl.Free()
+		err := fmt.Errorf("%s has no retention configuration", lom.Cname())
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusNotFound, Code: s3.NoSuchObjectLockConfiguration})
+		return
+	default:
+		s3.NewRetention(&ret).MustMarshal(sgl)
+	}
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// PUT /s3/<bucket-name>/<object-name>?retention|legal-hold
+// See: https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectRetention.html
+func (t *target) putObjLockS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, items []string, legalHold bool) {
+	objName, errN := s3.JoinValidateOname(w, r, items)
+	if errN != nil {
+		return
+	}
+	var (
+		to   *cmn.ObjRetention
+		hold *bool
+		err  error
+	)
+	if legalHold {
+		lh := &s3.LegalHold{}
+		if err = xml.NewDecoder(r.Body).Decode(lh); err == nil {
+			var on bool
+			on, err = lh.On()
+			hold = &on
+		}
+	} else {
+		ret := &s3.Retention{}
+		if err = xml.NewDecoder(r.Body).Decode(ret); err == nil {
+			to, err = ret.ToRetention()
+		}
+	}
+	if err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Code: s3.ErrCodeMalformedXML})
+		return
+	}
+
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
+		return
+	}
+	bypass := cos.IsParseBool(r.Header.Get(s3.HdrBypassGovernance))
+	ecode, err := t.setObjLock(lom, to, hold, bypass)
+	if err == nil {
+		return
+	}
+	ei := s3.ErrInfo{Err: err, Status: ecode}
+	switch ecode {
+	case http.StatusNotFound:
+		ei.Err, ei.Code = cos.NewErrNotFound(t, lom.Cname()), s3.NoSuchKey
+	case http.StatusForbidden:
+		ei.Code = s3.ErrCodeAccessDenied
+	case http.StatusBadRequest:
+		ei.Code = s3.ErrCodeInvalidRequest
+	}
+	s3.WriteErr(w, r, ei)
+}
+
 // POST /s3/<bucket-name>/<object-name>
 func (t *target) postObjS3(w http.ResponseWriter, r *http.Request, items []string, dpq *dpq) {
 	bck, ecode, err := meta.InitByNameOnly(items[0], t.owner.bmd)
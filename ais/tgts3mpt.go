@@ -9,11 +9,11 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"os"
 	"strconv"
 
 	"github.com/NVIDIA/aistore/ais/s3"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
@@ -100,6 +100,10 @@ func (t *target) putPartMptS3(w http.ResponseWriter, r *http.Request, dpq *dpq,
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
 		return
 	}
+	if err := t.quotaCheck(lom, r.ContentLength); err != nil {
+		s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: http.StatusForbidden, Code: s3.ErrCodeQuotaExceeded})
+		return
+	}
 
 	args := partArgs{
 		req:      r,
@@ -191,6 +195,8 @@ func (t *target) completeMptS3(w http.ResponseWriter, r *http.Request, dpq *dpq,
 		body:     body,
 		parts:    partList,
 		isS3:     true,
+		worm:     true,
+		precond:  cmn.NewPrecond(r.Header),
 	})
 	// convert generic error to s3 error
 	if cos.IsNotExist(err) {
@@ -358,8 +364,8 @@ func (t *target) getPartMptS3(w http.ResponseWriter, r *http.Request, bck *meta.
 		return
 	}
 
-	// read chunk file
-	fh, err := os.Open(chunk.Path())
+	// read chunk (decompress and/or decrypt if need be)
+	fh, err := manifest.OpenChunk(chunk)
 	if err != nil {
 		s3.WriteErr(w, r, s3.ErrInfo{Err: err})
 		return
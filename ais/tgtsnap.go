@@ -0,0 +1,102 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// Bucket snapshots: copy-on-write when overwriting or deleting, and
+// reading objects as of a given snapshot; see core/lsnap for storage and limitations
+
+func hasSnaps(lom *core.LOM) bool {
+	return lom.Bck().IsAIS() && len(lom.Bprops().Snapshots) > 0
+}
+
+// (under wlock) preserve the current object, if exists, prior to overwriting it
+// with `lom`, and stamp the latter (see core/lsnap)
+func preserveSnaps(lom *core.LOM) error {
+	cur := core.AllocLOM(lom.ObjName)
+	defer core.FreeLOM(cur)
+	if err := cur.InitBck(lom.Bck()); err != nil {
+		return err
+	}
+	if err := cur.Load(false /*cache it*/, true /*locked*/); err == nil {
+		if err := cur.PreserveSnaps(); err != nil {
+			return err
+		}
+	}
+	lom.StampSnaps(time.Now().UnixNano())
+	return nil
+}
+
+// load the object as of the named snapshot; returns (nil, 0, nil) when it is the current one
+func (t *target) loadObjSnap(lom *core.LOM, name string) (*core.LOM, int, error) {
+	bck := lom.Bucket()
+	if !lom.Bck().IsAIS() {
+		return nil, http.StatusBadRequest, fmt.Errorf("%s: bucket snapshots are only supported for ais:// buckets", lom.Cname())
+	}
+	snap := lom.Bprops().Snapshot(name)
+	if snap == nil {
+		return nil, http.StatusNotFound, cmn.NewErrSnapshotNotFound(bck, name)
+	}
+	slom, err := lom.LoadSnap(snap)
+	if err != nil {
+		if cos.IsNotExist(err) {
+			return nil, http.StatusNotFound, err
+		}
+		return nil, 0, err
+	}
+	return slom, 0, nil
+}
+
+// GET object as of the named snapshot; returns false when it is the current one
+// (to proceed with the regular GET)
+func (t *target) getObjSnap(w http.ResponseWriter, r *http.Request, dpq *dpq, lom *core.LOM) (bool, error) {
+	slom, _, err := t.loadObjSnap(lom, dpq.get(apc.QparamSnapshot))
+	if err != nil || slom == nil {
+		return false, err
+	}
+	defer core.FreeLOM(slom)
+	if r.Header.Get(cos.HdrRange) != "" {
+		return true, fmt.Errorf("%s: range read of a snapshot (%s) is not supported", lom.Cname(), dpq.get(apc.QparamSnapshot))
+	}
+	return true, t.getObjVer(w, r, dpq, slom)
+}
+
+// HEAD object as of the named snapshot
+func (t *target) objHeadSnap(r *http.Request, whdr http.Header, dpq *dpq, bck *meta.Bck, lom *core.LOM) (int, error) {
+	if err := lom.InitBck(bck); err != nil {
+		if cmn.IsErrBucketNought(err) {
+			return http.StatusNotFound, err
+		}
+		return 0, err
+	}
+	slom, ecode, err := t.loadObjSnap(lom, dpq.get(apc.QparamSnapshot))
+	if err != nil {
+		return ecode, err
+	}
+	if slom == nil {
+		return t.objHead(r, whdr, dpq, bck, lom)
+	}
+	defer core.FreeLOM(slom)
+	if ecode, err := precondVer(r, whdr, slom); ecode != 0 {
+		return ecode, err
+	}
+	op := cmn.ObjectProps{Name: slom.ObjName, Bck: *slom.Bucket(), Present: true}
+	op.ObjAttrs = *slom.ObjAttrs()
+	op.Location = slom.Location()
+	op.Mirror.Copies = 1
+	objPropsToHeader(&op, whdr, false /*hasEC*/)
+	return 0, nil
+}
@@ -0,0 +1,64 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+)
+
+// Object tags: a single custom metadata entry (cmn.TagsObjMD) that we also
+// propagate to remote backends, if and when supported (see core.TagsBackend)
+
+// set (or, when empty, remove) object tags
+// - remote buckets: object may not be present in-cluster (in which case we only update the remote)
+func (t *target) setObjTags(lom *core.LOM, tags cos.StrKVs) (int, error) {
+	lom.Lock(true)
+	defer lom.Unlock(true)
+
+	err := lom.Load(true /*cache it*/, true /*locked*/)
+	exists := err == nil
+	if err != nil && !cos.IsNotExist(err) {
+		return 0, err
+	}
+	supported, ecode, errR := t.putRemoteTags(lom, tags)
+	if errR != nil {
+		return ecode, errR
+	}
+	if !exists {
+		if supported {
+			return 0, nil
+		}
+		return http.StatusNotFound, err
+	}
+	if len(tags) == 0 {
+		lom.DelCustomKey(cmn.TagsObjMD)
+	} else {
+		lom.SetCustomKey(cmn.TagsObjMD, cmn.EncodeObjTags(tags))
+	}
+	return 0, lom.Persist()
+}
+
+// returns supported == false when the bucket is not remote, or its backend does not support tagging
+func (t *target) putRemoteTags(lom *core.LOM, tags cos.StrKVs) (supported bool, _ int, _ error) {
+	bck := lom.Bck()
+	if !bck.IsRemote() || bck.IsRemoteAIS() {
+		return false, 0, nil
+	}
+	bp := t.Backend(bck)
+	if rlbp, ok := bp.(*rlbackend); ok {
+		bp = rlbp.Backend
+	}
+	tbp, ok := bp.(core.TagsBackend)
+	if !ok {
+		return false, 0, nil
+	}
+	ecode, err := tbp.PutObjTags(context.Background(), lom, tags)
+	return true, ecode, err
+}
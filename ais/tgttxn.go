@@ -86,6 +86,9 @@ import (
 
 const actTxnCleanup = "cleanup" // in addition to (apc.Begin2PC, ...)
 
+// throttle (objects per second, per target) rechunking triggered by bucket props update
+const rechunkRateLimit = 256
+
 // context structure to gather all (or most) of the relevant state in one place
 // (compare with txnCln)
 type txnSrv struct {
@@ -383,6 +386,11 @@ func (t *target) setBprops(c *txnSrv) (string, error) {
 		if nprops, err = t.validateNprops(c.bck, c.msg); err != nil {
 			return "", err
 		}
+		if c.query.Get(apc.QparamRechunk) != "" && _reChunk(c.bck.Props, nprops) {
+			if err := xreg.LimitedCoexistence(t.si, c.bck, apc.ActRechunk); err != nil {
+				return "", err
+			}
+		}
 		nlp := newBckNLP(c.bck)
 		if !nlp.TryLock(c.timeout.netw / 2) {
 			return "", cmn.NewErrBusy("bucket", c.bck.Cname(""))
@@ -408,7 +416,6 @@ func (t *target) setBprops(c *txnSrv) (string, error) {
 		if err = t.txns.wait(txn, c.timeout.netw, c.timeout.host); err != nil {
 			return "", cmn.NewErrFailedTo(t, "commit", txn, err)
 		}
-		// TODO: add `_reChunks` xaction here, triggered by `chunks` field in `BpropsToSet`
 		if _reMirror(bprops, nprops) {
 			n := int(nprops.Mirror.Copies)
 			rns := xreg.RenewBckMakeNCopies(c.bck, c.uuid, "mnc-setprops", n)
@@ -441,11 +448,41 @@ func (t *target) setBprops(c *txnSrv) (string, error) {
 				xid = "" // not supporting multiple..
 			}
 		}
+		if rechunk := c.query.Get(apc.QparamRechunk); rechunk != "" && _reChunk(bprops, nprops) {
+			xctn, err := t.reChunk(c, nprops, rechunk == apc.RechunkDryRun)
+			if err != nil {
+				return "", fmt.Errorf("%s %s: %v", t, txn, err)
+			}
+			if xid == "" {
+				xid = xctn.ID()
+			} else {
+				xid = "" // ditto
+			}
+		}
 		return xid, nil
 	}
 	return "", nil
 }
 
+// rechunk existing objects in the background, to conform to the new `chunks` props
+func (t *target) reChunk(c *txnSrv, nprops *cmn.Bprops, dryRun bool) (core.Xact, error) {
+	msg := &apc.RechunkMsg{
+		ObjSizeLimit: int64(nprops.Chunks.ObjSizeLimit),
+		ChunkSize:    int64(nprops.Chunks.ChunkSize),
+		DryRun:       dryRun,
+		RateLimit:    rechunkRateLimit,
+	}
+	rns := xreg.RenewBckRechunks(c.bck, c.uuid, msg)
+	if rns.Err != nil {
+		return nil, rns.Err
+	}
+	xctn := rns.Entry.Get()
+	c.addNotif(xctn) // notify upon completion
+	xact.GoRunW(xctn)
+	nlog.Infoln(t.String(), "props update: start", xctn.Name(), xctn.CtlMsg())
+	return xctn, nil
+}
+
 func (t *target) validateNprops(bck *meta.Bck, msg *actMsgExt) (nprops *cmn.Bprops, err error) {
 	var (
 		body = cos.MustMarshal(msg.Value)
@@ -487,6 +524,9 @@ func (t *target) renameBucket(c *txnSrv) (string, error) {
 		if err := t.validateBckRenTxn(bckFrom, bckTo, c.msg); err != nil {
 			return "", err
 		}
+		if err := t.checkWORMBck(bckFrom, "move"); err != nil {
+			return "", err
+		}
 		nlpFrom := newBckNLP(bckFrom)
 		nlpTo := newBckNLP(bckTo)
 		if !nlpFrom.TryLock(c.timeout.netw / 4) {
@@ -972,6 +1012,14 @@ func (t *target) checkRebCoexistence(c *txnSrv) error {
 func (t *target) destroyBucket(c *txnSrv) error {
 	switch c.phase {
 	case apc.Begin2PC:
+		if err := wbCheckDestroy(c.bck); err != nil {
+			return err
+		}
+		if c.msg.Action == apc.ActDestroyBck {
+			if err := t.checkWORMBck(c.bck, "destroy"); err != nil {
+				return err
+			}
+		}
 		nlp := newBckNLP(c.bck)
 		if !nlp.TryLock(c.timeout.netw / 2) {
 			return cmn.NewErrBusy("bucket", c.bck.Cname(""))
@@ -0,0 +1,317 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// Object version history (ais:// buckets with `versioning.keep_versions` and/or `keep_days`);
+// see core/lversion for storage and limitations
+
+// requested object version (native API or S3 `versionId`); S3 "null" denotes the current one
+func (dpq *dpq) objVersion() string {
+	if !dpq.isS3 {
+		return dpq.get(apc.QparamObjVersion)
+	}
+	if v := dpq.get(s3.QparamVersionID); v != s3.NullVersionID {
+		return v
+	}
+	return ""
+}
+
+// (under wlock) retain the current object, if exists, as a prior version and
+// number the new one - never reusing version numbers of deleted objects;
+// returns the retained version (see supersede) and the history
+func (poi *putOI) keepVersion() (string, []*core.ObjVer) {
+	var (
+		lom   = poi.lom
+		cur   = core.AllocLOM(lom.ObjName)
+		ver   string
+		saved string
+	)
+	defer core.FreeLOM(cur)
+	if err := cur.InitBck(lom.Bck()); err != nil {
+		nlog.Warningln(poi.loghdr(), err)
+		return "", nil
+	}
+	if err := cur.Load(false /*cache it*/, true /*locked*/); err == nil {
+		ver = cur.Version()
+		if err := cur.SaveVersion(); err != nil {
+			nlog.Errorln(poi.loghdr(), "failed to retain version", ver, "err:", err)
+		} else {
+			saved = ver
+		}
+	}
+	vers, err := cur.Versions()
+	if err != nil {
+		nlog.Warningln(poi.loghdr(), err)
+	}
+	lom.SetVersion(core.NextVersion(ver, vers))
+	return saved, vers
+}
+
+// (under wlock) delete w/ version history: retain the object and add delete marker
+// (to be followed by supersede once the object is removed)
+func retainDeleted(lom *core.LOM, now time.Time) error {
+	if err := lom.SaveVersion(); err != nil {
+		return cmn.NewErrFailedTo(core.T, "retain version of", lom.Cname(), err)
+	}
+	vers, err := lom.Versions()
+	if err != nil {
+		return err
+	}
+	if err := lom.PutDelMarker(core.NextVersion(lom.Version(), vers), now.UnixNano()); err != nil {
+		return cmn.NewErrFailedTo(core.T, "add delete marker", lom.Cname(), err)
+	}
+	return nil
+}
+
+// (under wlock) the object has been overwritten or deleted: record when its retained
+// version got superseded and prune the history
+func supersede(lom *core.LOM, version string, vers []*core.ObjVer, now time.Time) {
+	if version != "" {
+		if err := lom.Supersede(version, now.UnixNano()); err != nil {
+			nlog.Warningln("version history:", lom.Cname(), "version", version, "err:", err)
+		}
+	}
+	if vers == nil {
+		var err error
+		if vers, err = lom.Versions(); err != nil {
+			return
+		}
+	}
+	lom.PruneVersions(vers, now)
+}
+
+// load the specified prior version; returns (nil, 0, nil) when it is the current one
+func (t *target) loadObjVer(lom *core.LOM, version string) (*core.LOM, int, error) {
+	if !lom.Bck().IsAIS() {
+		return nil, http.StatusBadRequest, fmt.Errorf("%s: object version history is only supported for ais:// buckets", lom.Cname())
+	}
+	if err := lom.Load(true /*cache it*/, false /*locked*/); err == nil && lom.Version() == version {
+		return nil, 0, nil
+	}
+	vlom, err := lom.LoadVersion(version)
+	if err != nil {
+		if cos.IsNotExist(err) {
+			return nil, http.StatusNotFound, err
+		}
+		return nil, 0, err
+	}
+	return vlom, 0, nil
+}
+
+// GET prior version (whole object only)
+func (t *target) getObjVer(w http.ResponseWriter, r *http.Request, dpq *dpq, vlom *core.LOM) error {
+	if r.Header.Get(cos.HdrRange) != "" {
+		return fmt.Errorf("%s: range read of a prior version (%s) is not supported", vlom.Cname(), vlom.Version())
+	}
+	if ecode, err := precondVer(r, w.Header(), vlom); ecode != 0 {
+		if err == nil {
+			w.WriteHeader(ecode) // 304
+		}
+		return err
+	}
+	fh, err := vlom.OpenFile(vlom.FQN)
+	if err != nil {
+		if cos.IsNotExist(err) {
+			err = cos.NewErrNotFound(t, vlom.Cname()+" version "+vlom.Version())
+		}
+		return err
+	}
+	var (
+		size = vlom.Lsize()
+		whdr = w.Header()
+	)
+	whdr.Set(cos.HdrContentType, cos.ContentBinary)
+	if dpq.isS3 {
+		whdr.Set(cos.HdrContentLength, strconv.FormatInt(size, 10))
+		s3.SetS3Headers(whdr, vlom)
+	} else {
+		cmn.ToHeader(vlom.ObjAttrs(), whdr, size)
+	}
+	buf, slab := t.gmm.AllocSize(min(size, cos.MiB))
+	_, err = io.CopyBuffer(w, fh, buf)
+	slab.Free(buf)
+	cos.Close(fh)
+	return err
+}
+
+// HEAD prior version (native API)
+func (t *target) objHeadVer(r *http.Request, whdr http.Header, dpq *dpq, bck *meta.Bck, lom *core.LOM) (int, error) {
+	if err := lom.InitBck(bck); err != nil {
+		if cmn.IsErrBucketNought(err) {
+			return http.StatusNotFound, err
+		}
+		return 0, err
+	}
+	vlom, ecode, err := t.loadObjVer(lom, dpq.objVersion())
+	if err != nil {
+		return ecode, err
+	}
+	if vlom == nil {
+		return t.objHead(r, whdr, dpq, bck, lom)
+	}
+	defer core.FreeLOM(vlom)
+	if ecode, err := precondVer(r, whdr, vlom); ecode != 0 {
+		return ecode, err
+	}
+	op := cmn.ObjectProps{Name: vlom.ObjName, Bck: *vlom.Bucket(), Present: true}
+	op.ObjAttrs = *vlom.ObjAttrs()
+	op.Location = vlom.Location()
+	op.Mirror.Copies = 1
+	objPropsToHeader(&op, whdr, false /*hasEC*/)
+	return 0, nil
+}
+
+// conditional GET/HEAD (If-Match, etc.) of a prior version or snapshot:
+// evaluate against the latter rather than the current object
+// returns (0, nil) to proceed, 304 with no error, or 412
+func precondVer(r *http.Request, whdr http.Header, vlom *core.LOM) (int, error) {
+	pc := cmn.NewPrecond(r.Header)
+	if pc == nil {
+		return 0, nil
+	}
+	etag, mtime := lomPrecondAttrs(vlom)
+	ecode, err := pc.Eval(true /*exists*/, etag, mtime, true /*read*/)
+	if ecode == http.StatusNotModified {
+		precondToHdr(whdr, etag, mtime)
+	}
+	return ecode, err
+}
+
+// permanently delete the specified version or delete marker
+// (S3 semantics: when the current object is gone and the latest retained
+// version is not a delete marker, it becomes current)
+func (t *target) delObjVer(lom *core.LOM, version string, bypassGovernance bool) (delMarker bool, _ int, _ error) {
+	if !lom.Bck().IsAIS() {
+		return false, http.StatusBadRequest, fmt.Errorf("%s: object version history is only supported for ais:// buckets", lom.Cname())
+	}
+	lom.Lock(true)
+	defer lom.Unlock(true)
+
+	vers, err := lom.Versions()
+	if err != nil {
+		return false, 0, err
+	}
+	err = lom.Load(false /*cache it*/, true /*locked*/)
+	exists := err == nil
+	if err != nil && !cmn.IsErrObjNought(err) {
+		return false, 0, err
+	}
+
+	if exists && lom.Version() == version {
+		if lom.Bprops().ObjectLock.Enabled {
+			if err := lom.CheckWORM(bypassGovernance); err != nil {
+				return false, http.StatusForbidden, err
+			}
+		}
+		if err := lom.RemoveObj(); err != nil {
+			return false, 0, err
+		}
+		exists = false
+	} else {
+		idx := -1
+		for i, ov := range vers {
+			if ov.Version == version {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			return false, http.StatusNotFound, cos.NewErrNotFound(t, lom.Cname()+" version "+version)
+		}
+		ov := vers[idx]
+		if !ov.DelMarker && lom.Bprops().ObjectLock.Enabled {
+			vlom, err := lom.LoadVersion(version)
+			if err == nil {
+				err = vlom.CheckWORM(bypassGovernance)
+				core.FreeLOM(vlom)
+			}
+			if err != nil {
+				return false, http.StatusForbidden, err
+			}
+		}
+		if err := cos.RemoveFile(ov.FQN); err != nil {
+			return false, 0, err
+		}
+		delMarker = ov.DelMarker
+		vers = append(vers[:idx], vers[idx+1:]...)
+	}
+
+	if l := len(vers); !exists && l > 0 && !vers[l-1].DelMarker {
+		if err := lom.RestoreVersion(vers[l-1]); err != nil {
+			nlog.Errorln(t.String(), "failed to restore", lom.Cname(), "version", vers[l-1].Version, "err:", err)
+		}
+	}
+	return delMarker, 0, nil
+}
+
+// S3 HEAD specific version; returns false when it is the current one
+func (t *target) headObjVerS3(w http.ResponseWriter, r *http.Request, lom *core.LOM, version string) bool {
+	vlom, ecode, err := t.loadObjVer(lom, version)
+	if err != nil {
+		ei := s3.ErrInfo{Err: err, Status: ecode}
+		if ecode == http.StatusNotFound {
+			ei.Code = s3.NoSuchVersion
+		}
+		s3.WriteErr(w, r, ei)
+		return true
+	}
+	if vlom == nil {
+		return false
+	}
+	defer core.FreeLOM(vlom)
+	hdr := w.Header()
+	if ecode, err := precondVer(r, hdr, vlom); ecode != 0 {
+		if err != nil {
+			s3.WriteErr(w, r, s3.ErrInfo{Err: err, Status: ecode})
+			return true
+		}
+		s3.SetS3Headers(hdr, vlom)
+		w.WriteHeader(ecode)
+		return true
+	}
+	s3.SetS3Headers(hdr, vlom)
+	hdr.Set(cos.HdrContentLength, strconv.FormatInt(vlom.Lsize(), 10))
+	if v, ok := vlom.GetCustomKey(cos.HdrContentType); ok {
+		hdr.Set(cos.HdrContentType, v)
+	}
+	return true
+}
+
+// S3 DELETE specific version
+func (t *target) delObjVerS3(w http.ResponseWriter, r *http.Request, lom *core.LOM, version string, bypass bool) {
+	delMarker, ecode, err := t.delObjVer(lom, version, bypass)
+	if err != nil {
+		ei := s3.ErrInfo{Err: err, Status: ecode}
+		switch {
+		case ecode == http.StatusNotFound:
+			ei.Code = s3.NoSuchVersion
+		case cmn.IsErrObjLocked(err):
+			ei.Status, ei.Code = http.StatusForbidden, s3.ErrCodeAccessDenied
+		}
+		s3.WriteErr(w, r, ei)
+		return
+	}
+	hdr := w.Header()
+	hdr.Set(cos.S3VersionHeader, version)
+	if delMarker {
+		hdr.Set(s3.HdrDeleteMarker, "true")
+	}
+}
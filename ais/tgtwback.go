@@ -0,0 +1,95 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/nl"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Delayed (write-back) data write policy: PUT marks objects pending upload
+// (see core/lwback), x-write-back uploads them - periodically and on demand (flush)
+
+var wbRestored atomic.Bool // upon startup, once
+
+var errWbPending = errors.New("pending write-back to remote backend (to flush, run 'ais start write-back')")
+
+// handle apc.ActWriteBack <-- via api.StartXaction (flush) and periodically (see wbHK);
+// empty xactID means locally triggered background write-back
+func (t *target) runWriteBack(xactID string, bck *meta.Bck) (xid string, err error) {
+	flush := xactID != ""
+	if !flush {
+		xactID = cos.GenUUID()
+	}
+	rns := xreg.RenewBckWriteBack(bck, xactID, &xreg.WbArgs{Flush: flush})
+	if rns.Err != nil {
+		return "", rns.Err
+	}
+	xctn := rns.Entry.Get()
+	if rns.IsRunning() {
+		return xctn.ID(), nil
+	}
+	if flush {
+		notif := &xact.NotifXact{
+			Base: nl.Base{When: core.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
+			Xact: xctn,
+		}
+		xctn.AddNotif(notif)
+	}
+	xact.GoRunW(xctn)
+	return xctn.ID(), nil
+}
+
+// periodically write back buckets that may have pending uploads
+func (t *target) wbHK(int64) time.Duration {
+	if !t.ClusterStarted() || nlog.Stopping() {
+		return hk.WritebackIval
+	}
+	if !wbRestored.Load() {
+		bmd := t.owner.bmd.get()
+		bmd.Range(nil, nil, func(bck *meta.Bck) bool {
+			if bck.IsRemote() {
+				if n := core.WbRestore(bck); n > 0 {
+					nlog.Infoln(t.String(), "write-back:", bck.Cname(""), "pending", n)
+				}
+			}
+			return false
+		})
+		wbRestored.Store(true)
+	}
+	for _, b := range core.WbDirtyBcks() {
+		bck := meta.CloneBck(&b)
+		if err := bck.Init(t.owner.bmd); err != nil {
+			continue // destroyed or evicted
+		}
+		if _, err := t.runWriteBack("" /*xactID*/, bck); err != nil {
+			core.WbSetDirty(&b) // try again later
+			if !cmn.IsErrXactUsePrev(err) {
+				nlog.Warningln(t.String(), "write-back:", bck.Cname(""), err)
+			}
+		}
+	}
+	return hk.WritebackIval
+}
+
+// objects pending write-back must be flushed prior to destroying (evicting) remote bucket
+func wbCheckDestroy(bck *meta.Bck) error {
+	if bck.IsRemote() && core.WbHasPending(bck.Bucket()) {
+		return cmn.NewErrFailedTo(core.T, "destroy (evict)", bck.Cname(""), errWbPending, http.StatusConflict)
+	}
+	return nil
+}
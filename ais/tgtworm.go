@@ -0,0 +1,138 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// Object lock (WORM): retention and legal hold stored in the object's custom metadata
+// (see cmn.ObjectLockConf, cmn.ObjRetention, and core/lworm.go)
+
+// check the current (in-cluster) object, if exists, before it gets overwritten
+// (using a separate LOM so that the one being written stays intact)
+func checkWORM(lom *core.LOM, locked, bypassGovernance bool) (int, error) {
+	if !lom.Bprops().ObjectLock.Enabled {
+		return 0, nil
+	}
+	cur := core.AllocLOM(lom.ObjName)
+	defer core.FreeLOM(cur)
+	if err := cur.InitBck(lom.Bck()); err != nil {
+		return 0, err
+	}
+	if err := cur.Load(false /*cache it*/, locked); err != nil {
+		if cmn.IsErrObjNought(err) {
+			return 0, nil
+		}
+		return http.StatusInternalServerError, err
+	}
+	if err := cur.CheckWORM(bypassGovernance); err != nil {
+		return http.StatusForbidden, err
+	}
+	return 0, nil
+}
+
+// destroying or moving (renaming) bucket is not permitted as long as
+// any of its objects is retained or under legal hold
+func (t *target) checkWORMBck(bck *meta.Bck, action string) error {
+	if bck.Init(t.owner.bmd) != nil || !bck.Props.ObjectLock.Enabled {
+		return nil
+	}
+	if err := core.FindLockedWORM(bck); err != nil {
+		return cmn.NewErrFailedTo(t, action, bck.Cname(""), err, http.StatusForbidden)
+	}
+	return nil
+}
+
+// new object: apply bucket's default retention unless explicitly specified;
+// otherwise (object lock disabled), strip object lock metadata, if any
+func applyObjLock(lom *core.LOM, now time.Time) {
+	conf := &lom.Bprops().ObjectLock
+	if !conf.Enabled {
+		lom.DelCustomKey(cmn.RetainUntilObjMD)
+		lom.DelCustomKey(cmn.RetainModeObjMD)
+		lom.DelCustomKey(cmn.LegalHoldObjMD)
+		return
+	}
+	if _, ok := lom.GetCustomKey(cmn.RetainUntilObjMD); ok {
+		return
+	}
+	if until := conf.DefaultRetainUntil(now); !until.IsZero() {
+		lom.SetCustomKey(cmn.RetainUntilObjMD, until.UTC().Format(time.RFC3339))
+		lom.SetCustomKey(cmn.RetainModeObjMD, conf.Mode)
+	}
+}
+
+// update object retention and/or legal hold (nil - unchanged)
+func (t *target) setObjLock(lom *core.LOM, to *cmn.ObjRetention, hold *bool, bypassGovernance bool) (int, error) {
+	if !lom.Bprops().ObjectLock.Enabled {
+		return http.StatusBadRequest, fmt.Errorf("%s: object lock is not enabled for bucket %s", t, lom.Bck().Cname(""))
+	}
+	lom.Lock(true)
+	defer lom.Unlock(true)
+
+	if err := lom.Load(true /*cache it*/, true /*locked*/); err != nil {
+		if cos.IsNotExist(err) {
+			return http.StatusNotFound, err
+		}
+		return 0, err
+	}
+	cur := lom.Retention()
+	if to != nil {
+		if err := cur.ValidateUpdate(to, time.Now(), bypassGovernance); err != nil {
+			return http.StatusForbidden, err
+		}
+		if to.RetainUntil.IsZero() {
+			lom.DelCustomKey(cmn.RetainUntilObjMD)
+			lom.DelCustomKey(cmn.RetainModeObjMD)
+		} else {
+			lom.SetCustomKey(cmn.RetainUntilObjMD, to.RetainUntil.UTC().Format(time.RFC3339))
+			lom.SetCustomKey(cmn.RetainModeObjMD, to.Mode)
+		}
+	}
+	if hold != nil {
+		if *hold {
+			lom.SetCustomKey(cmn.LegalHoldObjMD, cmn.LegalHoldOn)
+		} else {
+			lom.DelCustomKey(cmn.LegalHoldObjMD)
+		}
+	}
+	return 0, lom.Persist()
+}
+
+// PATCH (custom metadata) special case: object lock keys
+// - retain-until (empty value removes retention) requires retain-mode
+// - legal-hold: "on" | "off" (or empty)
+func parseObjLockMD(custom cos.StrKVs) (to *cmn.ObjRetention, hold *bool, err error) {
+	s, hasUntil := custom[cmn.RetainUntilObjMD]
+	mode, hasMode := custom[cmn.RetainModeObjMD]
+	if hasUntil || hasMode {
+		to = &cmn.ObjRetention{Mode: mode}
+		if s != "" {
+			if to.RetainUntil, err = cmn.ParseRetainUntil(s); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	if v, ok := custom[cmn.LegalHoldObjMD]; ok {
+		var on bool
+		switch v {
+		case cmn.LegalHoldOn:
+			on = true
+		case "", "off":
+		default:
+			return nil, nil, fmt.Errorf("invalid legal hold %q (expecting %q or %q)", v, cmn.LegalHoldOn, "off")
+		}
+		hold = &on
+	}
+	return to, hold, nil
+}
@@ -303,6 +303,23 @@ func (t *target) xstart(args *xact.ArgsMsg, bck *meta.Bck, msg *apc.ActMsg) (xid
 			ObjSizeLimit: int64(bck.Props.Chunks.ObjSizeLimit),
 			ChunkSize:    int64(bck.Props.Chunks.ChunkSize),
 		})
+	case apc.ActLifecycle:
+		if !bck.Props.Lifecycle.IsActive() {
+			return xid, fmt.Errorf("%s: lifecycle is disabled or has no enabled rules", bck.Cname(""))
+		}
+		return t.runLifecycle(args.ID, bck)
+	case apc.ActTiering:
+		if !bck.Props.Tiering.Enabled {
+			return xid, fmt.Errorf("%s: tiering is disabled", bck.Cname(""))
+		}
+		return t.runTiering(args.ID, bck)
+	case apc.ActScrub:
+		return t.runScrub(args.ID, bck, args.Flags&xact.FlagRepair != 0)
+	case apc.ActWriteBack:
+		if !bck.IsRemote() {
+			return xid, fmt.Errorf("%s: write-back requires remote bucket", bck.Cname(""))
+		}
+		return t.runWriteBack(args.ID, bck)
 	case apc.ActLoadLomCache:
 		rns := xreg.RenewBckLoadLomCache(args.ID, bck)
 		return xid, rns.Err
@@ -72,13 +72,20 @@ func (t *target) _promLocal(params *core.PromoteParams, lom *core.LOM) (fileSize
 		mi, _, err := fs.FQN2Mpath(params.SrcFQN)
 		extraCopy = err != nil || !mi.FS.Equal(lom.Mountpath().FS)
 	}
-	if extraCopy {
+	// compressed and/or encrypted bucket requires extra copy, regardless
+	encode := lom.WriteEncoded()
+	if extraCopy || encode {
 		var (
 			buf, slab = t.gmm.Alloc()
 			err       error
 		)
 		workFQN = lom.GenFQN(fs.WorkCT, fs.WorkfilePut)
-		fileSize, cksum, err = cos.CopyFile(params.SrcFQN, workFQN, buf, lom.CksumType())
+		if encode {
+			fileSize, cksum, err = encodeFile(lom, params.SrcFQN, workFQN, buf, lom.CksumType())
+		} else {
+			fileSize, cksum, err = cos.CopyFile(params.SrcFQN, workFQN, buf, lom.CksumType())
+			lom.SetStored(nil)
+		}
 		slab.Free(buf)
 		if err != nil {
 			return 0, 0, err
@@ -96,6 +103,7 @@ func (t *target) _promLocal(params *core.PromoteParams, lom *core.LOM) (fileSize
 
 		fileSize = fi.Size()
 		workFQN = params.SrcFQN
+		lom.SetStored(nil)
 		if params.Cksum != nil {
 			lom.SetCksum(params.Cksum) // already computed somewhere else, use it
 		} else {
@@ -135,6 +143,44 @@ func (t *target) _promLocal(params *core.PromoteParams, lom *core.LOM) (fileSize
 	return fileSize, ecode, err
 }
 
+// copy while compressing and/or encrypting (as per bucket configuration);
+// optionally, compute checksum of the (original) content
+func encodeFile(lom *core.LOM, srcFQN, workFQN string, buf []byte, cksumType string) (int64, *cos.CksumHash, error) {
+	fh, err := os.Open(srcFQN)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer cos.Close(fh)
+	wfh, err := lom.CreateWork(workFQN)
+	if err != nil {
+		return 0, nil, err
+	}
+	cw, err := lom.NewCwriter(wfh)
+	if err == nil {
+		var (
+			written int64
+			cksum   *cos.CksumHash
+		)
+		written, cksum, err = cos.CopyAndChecksum(cw, fh, buf, cksumType)
+		if erc := cw.Close(); err == nil {
+			err = erc
+		}
+		if erc := wfh.Close(); err == nil {
+			err = erc
+		}
+		if err == nil {
+			lom.SetStored(cw)
+			return written, cksum, nil
+		}
+	} else {
+		cos.Close(wfh)
+	}
+	if nerr := cos.RemoveFile(workFQN); nerr != nil {
+		nlog.Errorf(fmtNested, lom, err, "remove", workFQN, nerr)
+	}
+	return 0, nil, err
+}
+
 // [TODO]
 // - use DM streams
 // - Xact.InObjsAdd on the receive side
@@ -150,3 +150,27 @@ func TestCheckTimeoutCommitPhaseUnaffected(t *testing.T) {
 		t.Fatalf("expected [commit - done] timeout, got: %v", err)
 	}
 }
+
+// set-bprops: which `chunks` changes trigger rechunking (when requested)
+func TestReChunk(t *testing.T) {
+	props := func(limit, chunkSize cos.SizeIEC) *cmn.Bprops {
+		return &cmn.Bprops{Chunks: cmn.ChunksConf{ObjSizeLimit: limit, ChunkSize: chunkSize}}
+	}
+	tests := []struct {
+		name           string
+		bprops, nprops *cmn.Bprops
+		want           bool
+	}{
+		{"no change", props(cos.GiB, 64*cos.MiB), props(cos.GiB, 64*cos.MiB), false},
+		{"enable", props(0, cos.GiB), props(cos.GiB, cos.GiB), true},
+		{"disable", props(cos.GiB, cos.GiB), props(0, cos.GiB), true},
+		{"new limit", props(cos.GiB, cos.GiB), props(2*cos.GiB, cos.GiB), true},
+		{"new chunk size", props(cos.GiB, cos.GiB), props(cos.GiB, 64*cos.MiB), true},
+		{"chunk size while disabled", props(0, cos.GiB), props(0, 64*cos.MiB), false},
+	}
+	for _, test := range tests {
+		if got := _reChunk(test.bprops, test.nprops); got != test.want {
+			t.Errorf("%s: expected %t, got %t", test.name, test.want, got)
+		}
+	}
+}
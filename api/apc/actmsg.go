@@ -56,6 +56,10 @@ const (
 
 	ActLRU          = "lru"
 	ActStoreCleanup = "cleanup-store"
+	ActLifecycle    = "lifecycle"  // enforce bucket lifecycle rules (see cmn.LifecycleConf)
+	ActScrub        = "scrub"      // verify content checksums and (optionally) repair corrupted or missing replicas
+	ActWriteBack    = "write-back" // upload objects pending write-back to remote backend (see apc.WriteDelayed)
+	ActTiering      = "tiering"    // move objects between hot and cold storage classes (see cmn.TieringConf)
 
 	ActEvictRemoteBck = "evict-remote-bck" // evict remote bucket's data
 	ActList           = "list"
@@ -126,6 +130,20 @@ const (
 	ActCreateNBI  = "create-inventory"
 	ActDestroyNBI = "destroy-inventory"
 	ActShowNBI    = "show-inventory"
+
+	// point-in-time bucket snapshots (see cmn.BckSnapshot)
+	ActCreateSnapshot  = "create-snapshot"
+	ActDestroySnapshot = "destroy-snapshot"
+
+	// namespace capacity and object-count quota (see cmn.QuotaConf)
+	ActSetNsQuota = "set-ns-quota"
+
+	// recurring jobs executed by the primary (see cmn.JobSchedule)
+	ActAddJobSchedule = "add-job-schedule"
+	ActRmJobSchedule  = "rm-job-schedule"
+
+	// cancel pending request in the xaction admission queue (see cmn.XactQueue)
+	ActXactDequeue = "dequeue-xaction"
 )
 
 const (
@@ -153,6 +171,7 @@ const (
 	ActSelfRemove   = "self-initiated-removal" // e.g., when losing last mountpath
 	ActPrimaryForce = "primary-force"          // set primary with force (BEWARE! advanced usage only)
 	ActBumpMetasync = "bump-metasync"          // when executing ActPrimaryForce - the final step
+	ActQuotaReport  = "quota-report"           // target => primary: local usage of buckets with quotas
 )
 
 const (
@@ -44,6 +44,7 @@ type (
 		TotalSize struct {
 			OnDisk      uint64 `json:"size_on_disk,string"`          // sum(dir sizes) aka "apparent size"
 			PresentObjs uint64 `json:"size_all_present_objs,string"` // sum(cached object sizes)
+			PresentPhys uint64 `json:"size_present_phys,string"`     // ditto, physical (differs from the above when compressed)
 			RemoteObjs  uint64 `json:"size_all_remote_objs,string"`  // sum(all object sizes in a remote bucket)
 			Disks       uint64 `json:"total_disks_size,string"`
 		}
@@ -11,14 +11,41 @@ package apc
 const (
 	CompressAlways = "always"
 	CompressNever  = "never"
+	CompressAuto   = "auto" // sample compressibility and skip incompressible payloads
 )
 
-// sent via req.Header.Set(apc.HdrCompress, LZ4Compression)
-// (alternative to lz4 compressions upon popular request)
-const LZ4Compression = "lz4"
+// sent via req.Header.Set(apc.HdrCompress, ...)
+// - lz4 and zstd: the entire stream is compressed with the configured codec (transport.codec)
+// - auto: the stream is split into frames, each either compressed or sent as is
+const (
+	LZ4Compression  = "lz4"
+	ZstdCompression = "zstd"
+	AutoCompression = "auto"
+)
 
-var SupportedCompression = [...]string{CompressNever, CompressAlways}
+var SupportedCompression = [...]string{CompressNever, CompressAlways, CompressAuto}
 
 func IsValidCompression(c string) bool {
-	return c == "" || c == SupportedCompression[0] || c == SupportedCompression[1]
+	return c == "" || c == CompressNever || c == CompressAlways || c == CompressAuto
+}
+
+// intra-cluster (wire) codecs (config "transport.codec")
+var SupportedWireCodecs = [...]string{LZ4Compression, ZstdCompression}
+
+func IsValidWireCodec(c string) bool {
+	return c == "" || c == LZ4Compression || c == ZstdCompression
+}
+
+// At-rest compression codecs (bucket property "compression.codec")
+// NOTE: unlike the wire compression above, the codec is applied to stored objects and chunks
+const (
+	CodecNone = "none"
+	CodecZstd = "zstd"
+	CodecLZ4  = "lz4"
+)
+
+var SupportedCodecs = [...]string{CodecNone, CodecZstd, CodecLZ4}
+
+func IsValidCodec(c string) bool {
+	return c == "" || c == CodecNone || c == CodecZstd || c == CodecLZ4
 }
@@ -7,4 +7,7 @@ package apc
 const (
 	LsoCtlMsgPaging = ", paging"
 	LsoCtlMsgRemote = ", remote"
+
+	// scrub: number of EC objects whose slices and replicas are not spread across failure domains
+	ScrubCtlMsgDomains = "domain-violations"
 )
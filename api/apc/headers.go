@@ -120,7 +120,7 @@ const (
 
 	// intra-cluster streams
 	HdrSessID   = aisPrefix + "Session-Id"
-	HdrCompress = aisPrefix + "Compress" // LZ4
+	HdrCompress = aisPrefix + "Compress" // lz4, zstd, or auto (intra-cluster streams)
 
 	// Promote(dir)
 	HdrPromoteNamesHash = aisPrefix + "Promote-Names-Hash"
@@ -97,6 +97,12 @@ const (
 	// each target delivers an approximate share of the requested page size,
 	// subject to local chunking, minimum bounds, and slight overfetch
 	LsNBI
+
+	// ais:// buckets with version history (see `versioning.keep_versions` and `keep_days`):
+	// in addition to each listed object, list its retained prior versions and delete markers
+	// (flagged `EntryIsVersion` and `EntryIsDelMarker`, respectively);
+	// objects that were deleted are only listed when the prefix is exactly their name
+	LsVersions
 )
 
 // max page sizes
@@ -117,7 +123,7 @@ const (
 	LsoStatusMask = (1 << statusBits) - 1
 )
 
-// NOTE: approaching uint16 limit - bits 9,14,15 remaining
+// NOTE: approaching uint16 limit - bit 9 remaining
 const (
 	// location _status_
 	LocOK = iota
@@ -136,6 +142,9 @@ const (
 	EntryHeadFail   = 1 << (statusBits + 7)
 	// added v4.0
 	EntryIsChunked = 1 << (statusBits + 8) // see NOTE above
+	// retained prior version and delete marker (see LsVersions)
+	EntryIsVersion   = 1 << (statusBits + 9)
+	EntryIsDelMarker = 1 << (statusBits + 10)
 )
 
 // LsoMsg and HEAD(object) enum
@@ -236,6 +245,9 @@ type (
 		// Maximum entries returned in a single page. `0` selects the
 		// server-side default.
 		PageSize int64 `json:"pagesize"` // +gen:optional
+		// List the named bucket snapshot rather than the current
+		// content (ais:// buckets only; see `ActCreateSnapshot`).
+		Snapshot string `json:"snapshot,omitempty"` // +gen:optional
 	}
 )
 
@@ -319,6 +331,10 @@ func (lsmsg *LsoMsg) Str(cname string, sb *cos.SB) {
 		sb.WriteString(", flags:")
 		lsmsg.appendFlags(sb)
 	}
+	if lsmsg.Snapshot != "" {
+		sb.WriteString(", snapshot:")
+		sb.WriteString(lsmsg.Snapshot)
+	}
 }
 
 func (lsmsg *LsoMsg) appendFlags(sb *cos.SB) {
@@ -369,6 +385,9 @@ func (lsmsg *LsoMsg) appendFlags(sb *cos.SB) {
 	if lsmsg.IsFlagSet(LsIsS3) {
 		flags = append(flags, "s3")
 	}
+	if lsmsg.IsFlagSet(LsVersions) {
+		flags = append(flags, "versions")
+	}
 
 	if len(flags) > 0 {
 		sb.WriteString(strings.Join(flags, ","))
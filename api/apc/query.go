@@ -33,6 +33,17 @@ const (
 	// NOTE: making an s/_/-/ naming exception because of the namesake CLI usage
 	QparamNewCustom = "set-new-custom"
 
+	// object lock: bypass (shorten or remove) governance-mode retention
+	// (requires the permission to set bucket ACL)
+	QparamBypassGovernance = "bypass_governance"
+
+	// ais:// buckets with version history: GET, HEAD, or (permanently) DELETE
+	// the specified object version (see `versioning.keep_versions` and `keep_days`)
+	QparamObjVersion = "version"
+
+	// ais:// buckets: GET or HEAD object as of the named bucket snapshot
+	QparamSnapshot = "snapshot"
+
 	// Main bucket query params.
 	QparamProvider  = "provider"  // Backend provider: one of "ais", "aws", "gcp", "azure", "oci". Defaults to "ais".
 	QparamNamespace = "namespace" // Bucket namespace; used for remote buckets and cross-cluster operations. Leave empty for the default namespace.
@@ -61,6 +72,11 @@ const (
 	// When evicting, keep remote bucket in BMD (i.e., evict data only)
 	QparamKeepRemote = "keep_bck_md" // Keep bucket metadata when evicting remote bucket data
 
+	// When updating bucket props that change `chunks` (objsize_limit and/or chunk_size),
+	// also start (throttled) rechunking of the existing objects - see RechunkMsg.
+	// Values: "true" or RechunkDryRun (to only estimate the number and size of objects to convert).
+	QparamRechunk = "rechunk" // Rechunk existing objects upon `chunks` props change ("true" | "dry-run")
+
 	// (api.GetBucketInfo)
 	// NOTE: non-empty value indicates api.GetBucketInfo; "true" value further requires "with remote obj-s"
 	QparamBinfoWithOrWithoutRemote = "bsumm_remote" // Request bucket info (any non-empty value); set to "true" to also include remote (out-of-cluster) objects in the summary.
@@ -130,6 +146,12 @@ const (
 	// - attach invalid mountpath
 	QparamForce = "frc" // Force operation to override restrictions
 
+	// xaction admission queue (see cmn.XactQueue): when conflicting with a running xaction,
+	// queue the request on the primary with a given priority (higher first)
+	// and optional deadline (duration), instead of failing right away
+	QparamAdmitPrio     = "admit-prio"
+	QparamAdmitDeadline = "admit-deadline"
+
 	// same as `Versioning.ValidateWarmGet` (cluster config and bucket props)
 	// - usage: GET and (copy|transform) x (bucket|multi-object) operations
 	// - implies remote backend
@@ -290,6 +312,15 @@ const (
 	WhatQueryXactStats  = "qryxstats"   // stats: all matching xactions
 	WhatAllRunningXacts = "running_all" // e.g. e.g.: put-copies[D-ViE6HEL_j] list[H96Y7bhR2s] ...
 
+	// cluster-wide usage of buckets and namespaces with quotas (see cmn.QuotaStats)
+	WhatQuota = "quota"
+
+	// recurring jobs: schedules and run history (see cmn.JobSchedules)
+	WhatJobSchedules = "job-schedules"
+
+	// xaction admission queue: pending and recently resolved requests (see cmn.XactQueue)
+	WhatXactQueue = "xact-queue"
+
 	// internal
 	WhatSnode    = "snode"
 	WhatICBundle = "ic_bundle"
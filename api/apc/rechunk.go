@@ -23,4 +23,13 @@ type RechunkMsg struct {
 	Prefix string `json:"prefix"` // +gen:optional
 	// Also write rechunked objects back to the remote backend.
 	SyncRemote bool `json:"sync-remote"` // +gen:optional
+	// Do not convert anything - only count (and size) the objects
+	// that would be converted.
+	DryRun bool `json:"dry-run"` // +gen:optional
+	// Maximum number of objects to convert per second, per target;
+	// 0 (zero) - unlimited.
+	RateLimit int `json:"rate-limit"` // +gen:optional
 }
+
+// QparamRechunk value
+const RechunkDryRun = "dry-run"
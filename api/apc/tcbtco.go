@@ -40,6 +40,10 @@ type (
 		Sync bool `json:"synchronize"` // +gen:optional
 		// Do not recurse into nested virtual subdirectories.
 		NonRecurs bool `json:"non-recurs,omitempty"` // +gen:optional
+		// Copy the named snapshot of the source (ais://) bucket rather
+		// than its current content. Bucket-to-bucket copy only; the
+		// destination must not exist.
+		Snapshot string `json:"snapshot,omitempty"` // +gen:optional
 	}
 
 	// Transform selects an ETL transformation (or pipeline) to apply
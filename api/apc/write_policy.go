@@ -12,8 +12,8 @@ type WritePolicy string
 
 const (
 	WriteImmediate = WritePolicy("immediate") // immediate write (default)
-	WriteDelayed   = WritePolicy("delayed")   // cache and flush when not accessed for a while (lom_cache_hk.go)
-	WriteNever     = WritePolicy("never")     // transient - in-memory only
+	WriteDelayed   = WritePolicy("delayed")   // md: cache and flush when not accessed for a while (lom_cache_hk.go); data: write back (core/lwback.go)
+	WriteNever     = WritePolicy("never")     // md only: transient - in-memory only
 
 	WriteDefault = WritePolicy("") // same as `WriteImmediate` - see IsImmediate() below
 )
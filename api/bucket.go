@@ -38,19 +38,31 @@ func doBckAct(bp BaseParams, bck cmn.Bck, body []byte, q url.Values) (xid string
 // Set bucket properties.
 func SetBucketProps(bp BaseParams, bck cmn.Bck, props *cmn.BpropsToSet) (string, error) {
 	jbody := cos.MustMarshal(apc.ActMsg{Action: apc.ActSetBprops, Value: props})
-	return patchBprops(bp, bck, jbody)
+	return patchBprops(bp, bck, jbody, "")
+}
+
+// Set bucket properties and, if `chunks` (objsize_limit and/or chunk_size) change,
+// start rechunking existing objects in the background. With dryRun, the started job
+// only estimates the number and size of objects to be converted.
+// Returns the job ID (empty when there's nothing to rechunk).
+func SetBucketPropsRechunk(bp BaseParams, bck cmn.Bck, props *cmn.BpropsToSet, dryRun bool) (string, error) {
+	jbody := cos.MustMarshal(apc.ActMsg{Action: apc.ActSetBprops, Value: props})
+	return patchBprops(bp, bck, jbody, cos.Ternary(dryRun, apc.RechunkDryRun, "true"))
 }
 
 // Reset bucket properties to the global configuration.
 func ResetBucketProps(bp BaseParams, bck cmn.Bck) (string, error) {
 	jbody := cos.MustMarshal(apc.ActMsg{Action: apc.ActResetBprops})
-	return patchBprops(bp, bck, jbody)
+	return patchBprops(bp, bck, jbody, "")
 }
 
-func patchBprops(bp BaseParams, bck cmn.Bck, jbody []byte) (string, error) {
+func patchBprops(bp BaseParams, bck cmn.Bck, jbody []byte, rechunk string) (string, error) {
 	q := qalloc()
 	bp.Method = http.MethodPatch
 	bck.SetQuery(q)
+	if rechunk != "" {
+		q.Set(apc.QparamRechunk, rechunk)
+	}
 	return doBckAct(bp, bck, jbody, q)
 }
 
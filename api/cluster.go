@@ -346,6 +346,32 @@ func ClearLcache(bp BaseParams, tid string) error {
 	return _putCluster(bp, apc.ActMsg{Action: apc.ActClearLcache, Name: tid})
 }
 
+// SetNsQuota sets capacity and object-count quota for all buckets in a given namespace;
+// zero quota removes the namespace's quota (see also bucket property "quota")
+func SetNsQuota(bp BaseParams, ns cmn.Ns, quota *cmn.QuotaConf) error {
+	return _putCluster(bp, apc.ActMsg{Action: apc.ActSetNsQuota, Name: ns.Uname(), Value: quota})
+}
+
+// GetQuotaUsage returns cluster-wide usage of buckets and namespaces with quotas
+func GetQuotaUsage(bp BaseParams) (stats *cmn.QuotaStats, err error) {
+	q := qalloc()
+	q.Set(apc.QparamWhat, apc.WhatQuota)
+
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = q
+	}
+	stats = &cmn.QuotaStats{}
+	_, err = reqParams.DoReqAny(stats)
+
+	FreeRp(reqParams)
+	qfree(q)
+	return stats, err
+}
+
 func _putCluster(bp BaseParams, msg apc.ActMsg) error {
 	bp.Method = http.MethodPut
 	reqParams := AllocRp()
@@ -385,6 +411,8 @@ func GetClusterConfig(bp BaseParams) (*cmn.ClusterConfig, error) {
 	return cluConfig, nil
 }
 
+// Attach remote ais cluster via one or more (comma-separated) URLs of its proxies;
+// attaching already attached alias adds URLs (to fail over to).
 func AttachRemoteAIS(bp BaseParams, alias, u string) (err error) {
 	q := qalloc()
 	q.Set(apc.QparamWhat, apc.WhatRemoteAIS)
@@ -0,0 +1,19 @@
+// Package env contains environment variables
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package env
+
+// At-rest encryption: master key (key-encryption key) provider
+// - must be identically configured on all nodes (gateways and targets)
+// - when AIS_KMS_PROVIDER is not set, the provider is inferred from the variables present
+// see also: cmn/kms, docs/environment-vars.md
+
+//nolint:gosec // false positive G101
+const (
+	AisKMSProvider  = "AIS_KMS_PROVIDER"      // one of: "env", "file", "kmip"
+	AisKMSMasterKey = "AIS_KMS_MASTER_KEY"    // "env": hex or base64 encoded 256-bit key
+	AisKMSKeyFile   = "AIS_KMS_KEY_FILE"      // "file": pathname of a file containing the key (raw 32 bytes, hex, or base64)
+	AisKMSKeystore  = "AIS_KMS_KMIP_KEYSTORE" // "kmip": KMIP-compatible local keystore (JSON)
+	AisKMSKeyID     = "AIS_KMS_KEY_ID"        // "kmip": unique identifier of the current (active) master key
+)
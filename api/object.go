@@ -621,9 +621,89 @@ func SetObjectCustomProps(bp BaseParams, bck cmn.Bck, objName string, custom cos
 	return err
 }
 
+// Object tags (S3 object tagging) are stored as a single custom metadata entry - see cmn.TagsObjMD.
+// For remote buckets, tags also propagate to the backends that support them (currently, AWS and Azure).
+// See also: cmn.ParseObjTags and cmn.ValidateObjTags (limits)
+
+func GetObjectTags(bp BaseParams, bck cmn.Bck, objName string) (cos.StrKVs, error) {
+	op, err := HeadObject(bp, bck, objName, HeadArgs{})
+	if err != nil {
+		return nil, err
+	}
+	return cmn.ObjTags(op.CustomMD)
+}
+
+// replaces all existing tags, if any
+func SetObjectTags(bp BaseParams, bck cmn.Bck, objName string, tags cos.StrKVs) error {
+	if err := cmn.ValidateObjTags(tags); err != nil {
+		return err
+	}
+	return SetObjectCustomProps(bp, bck, objName, cos.StrKVs{cmn.TagsObjMD: cmn.EncodeObjTags(tags)}, false)
+}
+
+func DeleteObjectTags(bp BaseParams, bck cmn.Bck, objName string) error {
+	return SetObjectTags(bp, bck, objName, nil)
+}
+
+// Object lock (WORM): retention and legal hold are stored as custom metadata
+// (see cmn.ObjectLockConf and cmn.RetainUntilObjMD et al.); requires bucket with object lock enabled.
+// To get the current values, use HeadObject and cmn.ObjRetentionFromMD(op.CustomMD).
+
+// sets (or, when `until` is zero, removes) object retention;
+// shortening or removing governance-mode retention requires `bypassGovernance`
+func SetObjectRetention(bp BaseParams, bck cmn.Bck, objName, mode string, until time.Time, bypassGovernance bool) error {
+	custom := cos.StrKVs{cmn.RetainUntilObjMD: "", cmn.RetainModeObjMD: mode}
+	if !until.IsZero() {
+		custom[cmn.RetainUntilObjMD] = until.UTC().Format(time.RFC3339)
+	}
+	return setObjLock(bp, bck, objName, custom, bypassGovernance)
+}
+
+func SetObjectLegalHold(bp BaseParams, bck cmn.Bck, objName string, on bool) error {
+	custom := cos.StrKVs{cmn.LegalHoldObjMD: ""}
+	if on {
+		custom[cmn.LegalHoldObjMD] = cmn.LegalHoldOn
+	}
+	return setObjLock(bp, bck, objName, custom, false)
+}
+
+func setObjLock(bp BaseParams, bck cmn.Bck, objName string, custom cos.StrKVs, bypassGovernance bool) error {
+	var (
+		actMsg = apc.ActMsg{Value: custom}
+		q      = qalloc()
+	)
+	q = bck.AddToQuery(q)
+	if bypassGovernance {
+		q.Set(apc.QparamBypassGovernance, "true")
+	}
+	bp.Method = http.MethodPatch
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		reqParams.Body = cos.MustMarshal(actMsg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = q
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	qfree(q)
+	return err
+}
+
 // DELETE(object) ======================================================================================
 
 func DeleteObject(bp BaseParams, bck cmn.Bck, objName string) error {
+	return deleteObject(bp, bck, objName, false)
+}
+
+// delete object retained in governance mode (object lock);
+// requires the permission to set bucket ACL
+func DeleteObjectBypassGovernance(bp BaseParams, bck cmn.Bck, objName string) error {
+	return deleteObject(bp, bck, objName, true)
+}
+
+func deleteObject(bp BaseParams, bck cmn.Bck, objName string, bypassGovernance bool) error {
 	q := qalloc()
 	bp.Method = http.MethodDelete
 	reqParams := AllocRp()
@@ -631,6 +711,9 @@ func DeleteObject(bp BaseParams, bck cmn.Bck, objName string) error {
 		reqParams.BaseParams = bp
 		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
 		bck.SetQuery(q)
+		if bypassGovernance {
+			q.Set(apc.QparamBypassGovernance, "true")
+		}
 		reqParams.Query = q
 	}
 	err := reqParams.DoRequest()
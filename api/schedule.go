@@ -0,0 +1,42 @@
+// Package api provides native Go-based API/SDK over HTTP(S).
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"net/http"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+)
+
+// AddJobSchedule adds a named recurring job executed by the primary as per its cron expression;
+// the job is either a cluster-wide "start xaction" (apc.ActXactStart with xact.ArgsMsg value)
+// or a bucket action (e.g., copy-bucket or prefetch) - see cmn.JobSchedule
+func AddJobSchedule(bp BaseParams, sched *cmn.JobSchedule) error {
+	return _putCluster(bp, apc.ActMsg{Action: apc.ActAddJobSchedule, Name: sched.Name, Value: sched})
+}
+
+func RemoveJobSchedule(bp BaseParams, name string) error {
+	return _putCluster(bp, apc.ActMsg{Action: apc.ActRmJobSchedule, Name: name})
+}
+
+// GetJobSchedules returns all job schedules, including their next run times and run history
+func GetJobSchedules(bp BaseParams) (scheds cmn.JobSchedules, err error) {
+	q := qalloc()
+	q.Set(apc.QparamWhat, apc.WhatJobSchedules)
+
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = q
+	}
+	_, err = reqParams.DoReqAny(&scheds)
+
+	FreeRp(reqParams)
+	qfree(q)
+	return scheds, err
+}
@@ -0,0 +1,46 @@
+// Package api provides native Go-based API/SDK over HTTP(S).
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"net/http"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Create named point-in-time (copy-on-write) snapshot of the ais:// bucket.
+// To read, list, or copy the snapshot, use apc.QparamSnapshot (GET and HEAD object),
+// apc.LsoMsg.Snapshot, and apc.CopyBckMsg.Snapshot, respectively.
+func CreateSnapshot(bp BaseParams, bck cmn.Bck, name string) error {
+	q := qalloc()
+	bck.SetQuery(q)
+	bp.Method = http.MethodPost
+	jbody := cos.MustMarshal(apc.ActMsg{Action: apc.ActCreateSnapshot, Name: name})
+	_, err := doBckAct(bp, bck, jbody, q)
+	return err
+}
+
+// Destroy bucket snapshot (the space is reclaimed by the subsequent space cleanup)
+func DestroySnapshot(bp BaseParams, bck cmn.Bck, name string) error {
+	q := qalloc()
+
+	bp.Method = http.MethodDelete
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActDestroySnapshot, Name: name})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		bck.SetQuery(q)
+		reqParams.Query = q
+	}
+	err := reqParams.DoRequest()
+
+	FreeRp(reqParams)
+	qfree(q)
+	return err
+}
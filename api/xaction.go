@@ -68,17 +68,26 @@ const (
 )
 
 func StartXaction(bp BaseParams, args *xact.ArgsMsg, extra string /* e.g. blob-downloader objname */) (xid string, err error) {
+	xid, _, err = startXaction(bp, args, extra, nil)
+	return xid, err
+}
+
+// returns xaction ID or, when queued (http.StatusAccepted), queue ID
+func startXaction(bp BaseParams, args *xact.ArgsMsg, extra string, admit *AdmitArgs) (id string, status int, err error) {
 	if err := _validateKindID(args, false /*need IC*/); err != nil {
-		return "", err
+		return "", 0, err
 	}
 	if !xact.Table[args.Kind].Startable {
-		return "", fmt.Errorf("xaction %q is not startable", args.Kind)
+		return "", 0, fmt.Errorf("xaction %q is not startable", args.Kind)
 	}
 	q := qalloc()
 	args.Bck.SetQuery(q)
 	if args.Force {
 		q.Set(apc.QparamForce, "true")
 	}
+	if admit != nil {
+		admit.setQuery(q)
+	}
 	msg := apc.ActMsg{Action: apc.ActXactStart, Value: args, Name: extra}
 
 	bp.Method = http.MethodPut
@@ -90,11 +99,11 @@ func StartXaction(bp BaseParams, args *xact.ArgsMsg, extra string /* e.g. blob-d
 		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
 		reqParams.Query = q
 	}
-	_, err = reqParams.doReqStr(&xid)
+	status, err = reqParams.doReqStr(&id)
 
 	FreeRp(reqParams)
 	qfree(q)
-	return xid, err
+	return id, status, err
 }
 
 // a.k.a. stop
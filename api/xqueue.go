@@ -0,0 +1,101 @@
+// Package api provides native Go-based API/SDK over HTTP(S).
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+// AdmitArgs: xaction admission queue parameters (see cmn.XactQueue)
+type AdmitArgs struct {
+	Prio     int           // priority: higher runs first
+	Deadline time.Duration // (optional) max time to remain queued
+}
+
+func (a *AdmitArgs) setQuery(q url.Values) {
+	q.Set(apc.QparamAdmitPrio, strconv.Itoa(a.Prio))
+	if a.Deadline > 0 {
+		q.Set(apc.QparamAdmitDeadline, a.Deadline.String())
+	}
+}
+
+// StartXactionAdmit is StartXaction that, instead of failing with "limited coexistence"
+// conflict, gets queued on the primary to run when the conflicting xaction(s) finish.
+// Returns either xaction ID (started right away) or queue ID (queued).
+func StartXactionAdmit(bp BaseParams, args *xact.ArgsMsg, extra string, admit *AdmitArgs) (xid, qid string, err error) {
+	id, status, err := startXaction(bp, args, extra, admit)
+	if status == http.StatusAccepted {
+		return "", id, err
+	}
+	return id, "", err
+}
+
+// BckActionAdmit executes xaction-starting bucket action (e.g., apc.ActCopyBck with apc.TCBMsg value)
+// with admission queue parameters - see StartXactionAdmit
+func BckActionAdmit(bp BaseParams, bck cmn.Bck, bckTo *cmn.Bck, msg *apc.ActMsg, admit *AdmitArgs) (xid, qid string, err error) {
+	if bckTo != nil {
+		if err := bckTo.Validate(); err != nil {
+			return "", "", err
+		}
+	}
+	q := qalloc()
+	bck.SetQuery(q)
+	if bckTo != nil {
+		_ = bckTo.AddUnameToQuery(q, apc.QparamBckTo, "" /*objName*/)
+	}
+	admit.setQuery(q)
+
+	var id string
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(msg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = q
+	}
+	status, err := reqParams.doReqStr(&id)
+
+	FreeRp(reqParams)
+	qfree(q)
+	if status == http.StatusAccepted {
+		return "", id, err
+	}
+	return id, "", err
+}
+
+// GetXactQueue returns pending requests in the order of admission,
+// followed by the most recently resolved ones
+func GetXactQueue(bp BaseParams) (xq cmn.XactQueue, err error) {
+	q := qalloc()
+	q.Set(apc.QparamWhat, apc.WhatXactQueue)
+
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = q
+	}
+	_, err = reqParams.DoReqAny(&xq)
+
+	FreeRp(reqParams)
+	qfree(q)
+	return xq, err
+}
+
+// DequeueXaction cancels pending (queued) request
+func DequeueXaction(bp BaseParams, qid string) error {
+	return _putCluster(bp, apc.ActMsg{Action: apc.ActXactDequeue, Name: qid})
+}
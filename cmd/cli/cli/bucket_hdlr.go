@@ -302,6 +302,8 @@ var (
 		),
 		cmdSetBprops: {
 			forceFlag,
+			rechunkFlag,
+			rechunkDryRunFlag,
 		},
 		cmdResetBprops: {},
 
@@ -314,6 +316,7 @@ var (
 			objSizeLimitFlag,
 			verbObjPrefixFlag,
 			syncRemoteFlag,
+			dryRunFlag,
 			waitFlag,
 			waitJobXactFinishedFlag,
 		},
@@ -658,6 +661,7 @@ func rechunkBucketHandler(c *cli.Context) error {
 		ChunkSize:    chunkSize,
 		Prefix:       prefix,
 		SyncRemote:   syncRemote,
+		DryRun:       flagIsSet(c, dryRunFlag),
 	}
 	xid, err := api.RechunkBucket(apiBP, bck, msg)
 	if err != nil {
@@ -902,7 +906,17 @@ func updateBckProps(c *cli.Context, bck cmn.Bck, currBprops *cmn.Bprops, updateP
 	}
 
 	// do
-	if _, err := api.SetBucketProps(apiBP, bck, updateProps); err != nil {
+	var (
+		xid     string
+		err     error
+		rechunk = flagIsSet(c, rechunkFlag) || flagIsSet(c, rechunkDryRunFlag)
+	)
+	if rechunk {
+		xid, err = api.SetBucketPropsRechunk(apiBP, bck, updateProps, flagIsSet(c, rechunkDryRunFlag))
+	} else {
+		_, err = api.SetBucketProps(apiBP, bck, updateProps)
+	}
+	if err != nil {
 		if herr, ok := err.(*cmn.ErrHTTP); ok && herr.Status == http.StatusNotFound {
 			return herr
 		}
@@ -914,6 +928,10 @@ func updateBckProps(c *cli.Context, bck cmn.Bck, currBprops *cmn.Bprops, updateP
 	_showDiff(c, currBprops, allNewBprops)
 
 	actionDone(c, "\nBucket props successfully updated.")
+	if rechunk && xid != "" {
+		_, xname := xact.GetKindName(apc.ActRechunk)
+		actionDone(c, fmt.Sprintf("Started %s. %s", xact.Cname(xname, xid), toMonitorMsg(c, xid, "")))
+	}
 	return nil
 }
 
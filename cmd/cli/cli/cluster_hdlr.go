@@ -8,10 +8,12 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/xact"
@@ -167,8 +169,10 @@ var (
 				aliasFor: joinCommandWords(commandShow, cmdDashboard),
 			}),
 			{
-				Name:      cmdCluAttach,
-				Usage:     "Attach remote ais cluster",
+				Name: cmdCluAttach,
+				Usage: "Attach remote ais cluster via one or more (comma-separated) proxy URLs, e.g.:\n" +
+					indent1 + "\t- 'ais cluster remote-attach remais=http://10.0.1.1:8080,http://10.0.1.2:8080';\n" +
+					indent1 + "\tattaching already attached alias adds URL(s) to fail over to",
 				ArgsUsage: attachRemoteAISArgument,
 				Flags:     sortFlags(clusterCmdsFlags[cmdAttach]),
 				Action:    attachRemoteAISHandler,
@@ -293,6 +297,15 @@ var (
 				Action:       reloadCredsHandler,
 				BashComplete: suggestProvider,
 			},
+			{
+				Name: cmdNsQuota,
+				Usage: "Show or set capacity and object-count quota for all buckets in a namespace, e.g.:\n" +
+					indent1 + "\t- 'ais cluster ns-quota @#team-a'\t- show current quota and usage;\n" +
+					indent1 + "\t- 'ais cluster ns-quota @#team-a soft_size=8TiB hard_size=10TiB hard_objs=50000000'\t- set;\n" +
+					indent1 + "\t- 'ais cluster ns-quota @#team-a hard_size=0'\t- remove hard capacity limit (0 - unlimited).",
+				ArgsUsage: "NAMESPACE [soft_size=SIZE] [hard_size=SIZE] [soft_objs=N] [hard_objs=N]",
+				Action:    nsQuotaHandler,
+			},
 		},
 	}
 )
@@ -732,6 +745,12 @@ func psetForce(c *cli.Context, pid, sname, toURL string) error {
 }
 
 func startRebHandler(c *cli.Context) (err error) {
+	if flagIsSet(c, rebalanceDryRunFlag) {
+		return rebDryRun(c)
+	}
+	if flagIsSet(c, rebalanceWeightsFlag) {
+		return fmt.Errorf("%s is only valid with %s", qflprn(rebalanceWeightsFlag), qflprn(rebalanceDryRunFlag))
+	}
 	var prefix string
 	if flagIsSet(c, verbObjPrefixFlag) {
 		prefix = parseStrFlag(c, verbObjPrefixFlag)
@@ -919,6 +938,75 @@ func reloadCredsHandler(c *cli.Context) error {
 	return api.ReloadBackendCreds(apiBP, p)
 }
 
+func nsQuotaHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	ns := cmn.ParseNsUname(c.Args().Get(0))
+	if err := ns.Validate(); err != nil {
+		return err
+	}
+	bmd, err := api.GetBMD(apiBP)
+	if err != nil {
+		return V(err)
+	}
+	var quota cmn.QuotaConf
+	if q := bmd.NsQuota(&ns); q != nil {
+		quota = *q
+	}
+
+	// show
+	if c.NArg() == 1 {
+		stats, err := api.GetQuotaUsage(apiBP)
+		if err != nil {
+			return V(err)
+		}
+		used := stats.Nss[ns.Uname()]
+		fmt.Fprintf(c.App.Writer, "namespace %s: quota %s; used %s\n", ns.Uname(), quota.String(), used.String())
+		return nil
+	}
+
+	// set
+	nvs, err := makePairs(c.Args().Tail())
+	if err != nil {
+		return err
+	}
+	for k, v := range nvs {
+		switch k {
+		case "soft_size", "hard_size":
+			size, err := cos.ParseSize(v, cos.UnitsIEC)
+			if err != nil {
+				return fmt.Errorf("invalid %s=%q: %v", k, v, err)
+			}
+			if k == "soft_size" {
+				quota.SoftSize = cos.SizeIEC(size)
+			} else {
+				quota.HardSize = cos.SizeIEC(size)
+			}
+		case "soft_objs", "hard_objs":
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid %s=%q: %v", k, v, err)
+			}
+			if k == "soft_objs" {
+				quota.SoftObjs = n
+			} else {
+				quota.HardObjs = n
+			}
+		default:
+			return fmt.Errorf("invalid quota property %q (expecting one of: soft_size, hard_size, soft_objs, hard_objs)", k)
+		}
+	}
+	if err := quota.ValidateAsProps(); err != nil {
+		return err
+	}
+	if err := api.SetNsQuota(apiBP, ns, &quota); err != nil {
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("namespace %s quota: %s", ns.Uname(), quota.String()))
+	return nil
+}
+
 func downloadAllLogs(c *cli.Context) error {
 	sev, err := parseLogSev(c)
 	if err != nil {
@@ -55,6 +55,7 @@ var (
 		"compression.checksum":                apc.SupportedCompression[:],
 		"rebalance.compression":               apc.SupportedCompression[:],
 		"distributed_sort.compression":        apc.SupportedCompression[:],
+		"transport.codec":                     apc.SupportedWireCodecs[:],
 		"distributed_sort.duplicated_records": dsortSupportedReactions,
 		"distributed_sort.ekm_malformed_line": dsortSupportedReactions,
 		"distributed_sort.ekm_missing_key":    dsortSupportedReactions,
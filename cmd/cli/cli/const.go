@@ -138,6 +138,8 @@ const (
 
 	cmdReloadCreds = "reload-backend-creds"
 
+	cmdNsQuota = "ns-quota"
+
 	cmdDownloadLogs = "download-logs"
 	cmdViewLogs     = "view-logs" // etl
 
@@ -441,7 +443,7 @@ const (
 	deleteAuthTokenArgument   = "[TOKEN]"
 
 	// Alias
-	aliasURLPairArgument = "ALIAS=URL (or UUID=URL)"
+	aliasURLPairArgument = "ALIAS=URL[,URL...] (or UUID=URL[,URL...])"
 	aliasArgument        = "ALIAS (or UUID)"
 	aliasCmdArgument     = "COMMAND"
 	aliasSetCmdArgument  = "ALIAS COMMAND"
@@ -679,6 +681,16 @@ var (
 		Usage: "Remove local copies of misplaced objects - monolithic and chunked (non-EC);\n" +
 			indent1 + "\tfails if rebalance is running; incompatible with '--latest' and '--sync'",
 	}
+	rebalanceDryRunFlag = cli.BoolFlag{
+		Name: dryRunFlag.Name,
+		Usage: "Do not start rebalance; instead, estimate the expected data movement given (proposed) target weights\n" +
+			indent1 + "\t(see '--weights' and 'hrw_weight' in the node configuration)",
+	}
+	rebalanceWeightsFlag = cli.StringFlag{
+		Name: "weights",
+		Usage: "With '--dry-run': comma-separated list of proposed target weights, e.g.:\n" +
+			indent1 + "\t'--weights t[QrmZvKdN]=60,t[HbjTwLpS]=60' (zero to remove the weight)",
+	}
 	rebalanceForceFlag = cli.BoolFlag{
 		Name: forceFlag.Name,
 		Usage: "With '--cleanup': also remove local misplaced copies that fail the safe identity check against copies\n" +
@@ -1012,6 +1024,16 @@ var (
 		Name:  listCachedFlag.Name,
 		Usage: "Only visit " + _onlyin,
 	}
+	scrubCksumFlag = cli.BoolFlag{
+		Name: cksumFlag.Name,
+		Usage: "Run server-side scrub job that walks all target mountpaths to recompute and validate\n" +
+			indent4 + "\tcontent checksums of the bucket's objects and their local replicas (slow)",
+	}
+	scrubFixFlag = cli.BoolFlag{
+		Name: "fix",
+		Usage: "When used with " + qflprn(scrubCksumFlag) + ": repair corrupted or missing replicas\n" +
+			indent4 + "\tfrom local mirrors, EC slices, or remote backend",
+	}
 
 	// when '--all' is used for/by another flag
 	objNotCachedPropsFlag = cli.BoolFlag{
@@ -1095,6 +1117,17 @@ var (
 			indent1 + "\tapplies only to buckets with remote backend (e.g., S3, GCS, remote AIS)",
 	}
 
+	// usage: bucket props set
+	rechunkFlag = cli.BoolFlag{
+		Name: "rechunk",
+		Usage: "When 'chunks.objsize_limit' and/or 'chunks.chunk_size' change, also start (throttled) rechunking\n" +
+			indent1 + "\tof the existing objects to conform to the new layout (see 'ais bucket rechunk --help')",
+	}
+	rechunkDryRunFlag = cli.BoolFlag{
+		Name:  "rechunk-dry-run",
+		Usage: "Same as '--rechunk' but only estimate the number and size of objects to be converted",
+	}
+
 	// usage: shard-index build
 	skipVerifyFlag = cli.BoolFlag{
 		Name: "skip-verify",
@@ -112,6 +112,50 @@ func getDiskStats(c *cli.Context, smap *meta.Smap, tid string) (_ []*teb.DiskSta
 	return dsh, withCap, nil
 }
 
+// aggregate mountpath capacities by storage class (mountpath label);
+// returns nil when none of the mountpaths is labeled
+func storageClasses(dsh []*teb.DiskStatsHelper) []*teb.StorageClassHelper {
+	var (
+		seen    = make(cos.StrSet, 8)
+		classes = make(map[string]*teb.StorageClassHelper, 4)
+		labeled bool
+	)
+	for _, ds := range dsh {
+		if ds.Tcdf == nil || seen.Contains(ds.TargetID) {
+			continue
+		}
+		seen.Set(ds.TargetID)
+		for _, cdf := range ds.Tcdf.Mountpaths {
+			label := string(cdf.Label)
+			if label == "" {
+				label = teb.NotSetVal
+			} else {
+				labeled = true
+			}
+			sc, ok := classes[label]
+			if !ok {
+				sc = &teb.StorageClassHelper{Label: label}
+				classes[label] = sc
+			}
+			sc.Mountpaths++
+			sc.Used += cdf.Used
+			sc.Avail += cdf.Avail
+		}
+	}
+	if !labeled {
+		return nil
+	}
+	res := make([]*teb.StorageClassHelper, 0, len(classes))
+	for _, sc := range classes {
+		if total := sc.Used + sc.Avail; total > 0 {
+			sc.PctUsed = int64(sc.Used * 100 / total)
+		}
+		res = append(res, sc)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Label < res[j].Label })
+	return res
+}
+
 func collapseDisks(dsh []*teb.DiskStatsHelper, numTs int) {
 	dnums := make(map[string]int, numTs)
 	for _, src := range dsh {
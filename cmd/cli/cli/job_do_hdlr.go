@@ -105,6 +105,7 @@ var (
 		jobStopSub,
 		jobWaitSub,
 		jobRemoveSub,
+		jobScheduleSub,
 		makeAlias(&showCmdJob, &mkaliasOpts{newName: commandShow}),
 	}
 )
@@ -115,6 +116,8 @@ var (
 		waitFlag,
 		waitJobXactFinishedFlag,
 		nonverboseFlag,
+		queuePrioFlag,
+		queueDeadlineFlag,
 	}
 	startSpecialFlags = map[string][]cli.Flag{
 		commandRebalance: {
@@ -123,6 +126,8 @@ var (
 			syncFlag,
 			rebalanceCleanupModeFlag,
 			rebalanceForceFlag,
+			rebalanceDryRunFlag,
+			rebalanceWeightsFlag,
 		},
 		cmdDownload: {
 			dloadTimeoutFlag,
@@ -261,6 +266,7 @@ var (
 		regexJobsFlag,
 		forceFlag,
 		yesFlag,
+		queuedJobsFlag,
 	}
 	jobStopSub = cli.Command{
 		Name:         commandStop,
@@ -458,10 +464,21 @@ func startXaction(c *cli.Context, xargs *xact.ArgsMsg, extra string) error {
 		return fmt.Errorf("%q requires bucket to run", xargs.Kind)
 	}
 
-	xid, err := xstart(xargs, extra)
+	var (
+		xid, qid string
+		err      error
+	)
+	if flagIsSet(c, queuePrioFlag) {
+		xid, qid, err = xstartAdmit(c, xargs, extra)
+	} else {
+		xid, err = xstart(xargs, extra)
+	}
 	if err != nil {
 		return err
 	}
+	if qid != "" {
+		return nil // queued
+	}
 	if xid == "" {
 		actionWarnf(c, "The operation returned an empty UUID (a no-op?). %s\n",
 			toShowMsg(c, "", "To investigate", false))
@@ -1148,6 +1165,9 @@ func stopJobHandler(c *cli.Context) error {
 	if c.Args().Get(0) == commandJob {
 		shift = 1
 	}
+	if flagIsSet(c, queuedJobsFlag) {
+		return dequeueXactionHandler(c, shift)
+	}
 	name, xid, daemonID, bck, err := jobArgs(c, shift, true /*ignore daemonID*/)
 	if err != nil {
 		return err
@@ -92,6 +92,7 @@ var showJobFlags = append(
 	unitsFlag,
 	dateTimeFlag,
 	topFlag,
+	queuedJobsFlag,
 	// download and dsort only
 	progressFlag,
 	dsortLogFlag,
@@ -110,6 +111,9 @@ var showCmdJob = cli.Command{
 // - be omitted, in part or in total, and may
 // - come in arbitrary order
 func showJobsHandler(c *cli.Context) error {
+	if flagIsSet(c, queuedJobsFlag) {
+		return showXactQueueHandler(c)
+	}
 	// validation of --filter flag
 	if filterStr := parseStrFlag(c, columnFilterFlag); filterStr != "" {
 		parts := strings.SplitN(filterStr, "=", 2)
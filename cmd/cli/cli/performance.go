@@ -190,6 +190,7 @@ func perfCptn(c *cli.Context, tab string) {
 }
 
 // show non-zero counters _and_ sizes (unless `allColumnsFlag`)
+// plus the number of objects pending write-back (gauge)
 func showCountersHandler(c *cli.Context) error {
 	metrics, err := getMetricNames(c)
 	if err != nil {
@@ -201,7 +202,7 @@ func showCountersHandler(c *cli.Context) error {
 		verbose  = flagIsSet(c, verboseFlag)
 	)
 	for name, kind := range metrics {
-		if metrics[name] == stats.KindCounter || metrics[name] == stats.KindSize {
+		if metrics[name] == stats.KindCounter || metrics[name] == stats.KindSize || name == stats.WritebackPendingCount {
 			//
 			// skip assorted internal counters and sizes, unless verbose or regex
 			//
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/http"
 	"sort"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 	"time"
@@ -18,6 +19,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/xact"
 
 	"github.com/urfave/cli"
@@ -424,3 +426,90 @@ func fmtRebTimes(snap *core.Snap, datedTime bool) (startTime, endTime string) {
 	}
 	return
 }
+
+//
+// rebalance --dry-run: expected data movement (weighted HRW)
+//
+
+const (
+	rebDryRunHdr     = "TARGET\t WEIGHT\t NEW WEIGHT\t CURRENT SHARE\t EXPECTED SHARE"
+	rebDryRunSamples = 100_000
+)
+
+func rebDryRun(c *cli.Context) error {
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return err
+	}
+	weights, err := parseRebWeights(c, smap)
+	if err != nil {
+		return err
+	}
+	est, err := smap.EstimateHrw(weights, rebDryRunSamples)
+	if err != nil {
+		return err
+	}
+
+	tids := make([]string, 0, len(smap.Tmap))
+	for tid, tsi := range smap.Tmap {
+		if !tsi.InMaintOrDecomm() {
+			tids = append(tids, tid)
+		}
+	}
+	sort.Strings(tids)
+
+	tw := newTabWriter(c)
+	fmt.Fprintln(tw, rebDryRunHdr)
+	for _, tid := range tids {
+		var (
+			tsi  = smap.GetTarget(tid)
+			wcur = _fmtWeight(smap.Weight(tsi))
+			wnew = wcur
+		)
+		if w, ok := weights[tid]; ok {
+			wnew = _fmtWeight(w)
+		}
+		fmt.Fprintf(tw, "%s\t %s\t %s\t %.2f%%\t %.2f%%\n", tsi.StringEx(), wcur, wnew, est.Before[tid]*100, est.After[tid]*100)
+	}
+	tw.Flush()
+
+	fmt.Fprintf(c.App.Writer, "\nExpected data movement: %.2f%% of all objects (estimated by sampling).\n", est.Moved*100)
+	if len(weights) > 0 {
+		fmt.Fprintln(c.App.Writer, "To apply, set 'hrw_weight' in the respective target configuration(s) and restart the target(s).")
+	}
+	return nil
+}
+
+func _fmtWeight(w uint64) string {
+	if w == 0 {
+		return teb.NotSetVal
+	}
+	return strconv.FormatUint(w, 10)
+}
+
+// e.g.: "t[QrmZvKdN]=60,HbjTwLpS=15"
+func parseRebWeights(c *cli.Context, smap *meta.Smap) (map[string]uint64, error) {
+	if !flagIsSet(c, rebalanceWeightsFlag) {
+		return nil, nil
+	}
+	var (
+		lst     = splitCsv(parseStrFlag(c, rebalanceWeightsFlag))
+		weights = make(map[string]uint64, len(lst))
+	)
+	for _, kv := range lst {
+		name, val, ok := strings.Cut(kv, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid %s value %q (expecting TARGET_ID=WEIGHT)", qflprn(rebalanceWeightsFlag), kv)
+		}
+		tid := meta.N2ID(strings.TrimSpace(name))
+		if smap.GetTarget(tid) == nil {
+			return nil, &errDoesNotExist{what: "target", name: name}
+		}
+		w, err := strconv.ParseUint(strings.TrimSpace(val), 10, 64)
+		if err != nil || w > cmn.MaxHrwWeight {
+			return nil, fmt.Errorf("invalid %s weight %q (expecting integer in the range [0, %d])", name, val, cmn.MaxHrwWeight)
+		}
+		weights[tid] = w
+	}
+	return weights, nil
+}
@@ -0,0 +1,239 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles recurring (cluster-side scheduled) jobs.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/xact"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/urfave/cli"
+)
+
+const cmdSchedule = "schedule"
+
+const scheduleAddUsage = "Add a named recurring job executed by the cluster (primary) as per cron expression (UTC), e.g.:\n" +
+	indent1 + "\t- 'ais job schedule add nightly-lru \"0 2 * * *\" lru'\t- run LRU eviction every night at 2am;\n" +
+	indent1 + "\t- 'ais job schedule add tier-photos @hourly tiering ais://photos'\t- hourly hot/cold tiering of a given bucket;\n" +
+	indent1 + "\t- 'ais job schedule add dr-copy \"0 3 * * 0\" copy-bck ais://src ais://dr-src --overlap queue'\t- weekly copy-bucket;\n" +
+	indent1 + "\t- 'ais job schedule add pf \"30 1 * * *\" prefetch-listrange s3://abc --value '{\"template\": \"new/\"}''\t- nightly prefetch.\n" +
+	indent1 + "JOB is either a startable xaction (see 'ais start --help') or one of the supported bucket actions:\n" +
+	indent1 + "\t%v"
+
+var (
+	overlapFlag = cli.StringFlag{
+		Name: "overlap",
+		Usage: "What to do when the job is due while its previous run is still running:\n" +
+			indent4 + "\t'skip' - skip this run;\n" +
+			indent4 + "\t'queue' - run as soon as the previous one finishes",
+		Value: cmn.SchedOverlapSkip,
+	}
+	schedValueFlag = cli.StringFlag{
+		Name:  "value",
+		Usage: "Bucket action's (JSON) value, e.g. '{\"prefix\": \"abc/\", \"latest-ver\": true}' (see api/apc for the respective message types)",
+	}
+
+	jobScheduleSub = cli.Command{
+		Name:  cmdSchedule,
+		Usage: "Manage recurring jobs executed by the cluster as per cron expressions",
+		Subcommands: []cli.Command{
+			{
+				Name:      "add",
+				Usage:     fmt.Sprintf(scheduleAddUsage, cmn.SchedBckActs),
+				ArgsUsage: "NAME CRON JOB [BUCKET [DST_BUCKET]]",
+				Flags:     sortFlags([]cli.Flag{overlapFlag, schedValueFlag}),
+				Action:    addJobScheduleHandler,
+			},
+			{
+				Name:      commandList,
+				Usage:     "Show job schedules or, given schedule name, its most recent runs",
+				ArgsUsage: "[NAME]",
+				Flags:     sortFlags([]cli.Flag{jsonFlag, noHeaderFlag}),
+				Action:    listJobSchedulesHandler,
+			},
+			{
+				Name:      commandRemove,
+				Usage:     "Remove job schedule",
+				ArgsUsage: "NAME",
+				Action:    rmJobScheduleHandler,
+			},
+		},
+	}
+)
+
+func addJobScheduleHandler(c *cli.Context) error {
+	if c.NArg() < 3 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	if c.NArg() > 5 {
+		return incorrectUsageMsg(c, "too many arguments")
+	}
+	var (
+		name = c.Args().Get(0)
+		job  = c.Args().Get(2)
+	)
+	sched := &cmn.JobSchedule{
+		Name:    name,
+		Cron:    c.Args().Get(1),
+		Overlap: parseStrFlag(c, overlapFlag),
+	}
+	var bck *cmn.Bck
+	if c.NArg() > 3 {
+		b, err := parseBckURI(c, c.Args().Get(3), false)
+		if err != nil {
+			return err
+		}
+		bck = &b
+	}
+
+	if slices.Contains(cmn.SchedBckActs, job) {
+		if bck == nil {
+			return missingArgumentsError(c, "BUCKET")
+		}
+		sched.Bck = bck
+		sched.Msg.Action = job
+		if c.NArg() > 4 {
+			bckTo, err := parseBckURI(c, c.Args().Get(4), false)
+			if err != nil {
+				return err
+			}
+			sched.BckTo = &bckTo
+		}
+		if flagIsSet(c, schedValueFlag) {
+			var v map[string]any
+			if err := jsoniter.UnmarshalFromString(parseStrFlag(c, schedValueFlag), &v); err != nil {
+				return fmt.Errorf("invalid %s: %v", qflprn(schedValueFlag), err)
+			}
+			sched.Msg.Value = v
+		}
+	} else {
+		kind, _, err := xact.GetDescriptor(job)
+		if err != nil {
+			return fmt.Errorf("%q is neither a startable xaction nor a bucket action that can be scheduled", job)
+		}
+		if c.NArg() > 4 || flagIsSet(c, schedValueFlag) {
+			return incorrectUsageMsg(c, "destination bucket and %s apply only to bucket actions", qflprn(schedValueFlag))
+		}
+		xargs := xact.ArgsMsg{Kind: kind}
+		if bck != nil {
+			xargs.Bck = *bck
+		}
+		sched.Msg = apc.ActMsg{Action: apc.ActXactStart, Value: xargs}
+	}
+	if err := sched.Validate(); err != nil {
+		return err
+	}
+	if err := api.AddJobSchedule(apiBP, sched); err != nil {
+		return V(err)
+	}
+	next := sched.Next(time.Now())
+	actionDone(c, fmt.Sprintf("Added job schedule %q (next run: %s)", name, teb.FmtDateTime(next.Local())))
+	return nil
+}
+
+func listJobSchedulesHandler(c *cli.Context) error {
+	scheds, err := api.GetJobSchedules(apiBP)
+	if err != nil {
+		return V(err)
+	}
+	var (
+		usejs      = flagIsSet(c, jsonFlag)
+		hideHeader = flagIsSet(c, noHeaderFlag)
+	)
+	// one schedule: run history
+	if c.NArg() > 0 {
+		name := c.Args().Get(0)
+		idx := slices.IndexFunc(scheds, func(s *cmn.JobScheduleInfo) bool { return s.Name == name })
+		if idx < 0 {
+			return fmt.Errorf("job schedule %q does not exist", name)
+		}
+		info := scheds[idx]
+		if usejs {
+			return teb.Print(info, "", teb.Jopts(true))
+		}
+		runs := make([]*teb.JobRunHelper, 0, len(info.Runs))
+		for i := len(info.Runs) - 1; i >= 0; i-- { // most recent first
+			run := &info.Runs[i]
+			runs = append(runs, &teb.JobRunHelper{
+				Time:  teb.FmtDateTime(time.Unix(0, run.Time)),
+				State: run.State,
+				Xid:   cos.Ternary(run.Xid == "", teb.NotSetVal, run.Xid),
+				Err:   cos.Ternary(run.Err == "", teb.NotSetVal, run.Err),
+			})
+		}
+		if len(runs) == 0 {
+			actionDone(c, fmt.Sprintf("Job schedule %q: no runs yet", name))
+			return nil
+		}
+		return teb.Print(runs, cos.Ternary(hideHeader, teb.JobRunsBody, teb.JobRunsTmpl))
+	}
+
+	if usejs {
+		return teb.Print(scheds, "", teb.Jopts(true))
+	}
+	if len(scheds) == 0 {
+		actionDone(c, "No job schedules")
+		return nil
+	}
+	rows := make([]*teb.JobScheduleHelper, 0, len(scheds))
+	for _, info := range scheds {
+		row := &teb.JobScheduleHelper{
+			Name:    info.Name,
+			Cron:    info.Cron,
+			Job:     info.Msg.Action,
+			Bucket:  teb.NotSetVal,
+			Overlap: info.Overlap,
+			Next:    teb.NotSetVal,
+			LastRun: teb.NotSetVal,
+		}
+		if info.IsBckAction() {
+			row.Bucket = info.Bck.Cname("")
+			if info.BckTo != nil {
+				row.Bucket += " => " + info.BckTo.Cname("")
+			}
+		} else {
+			var xargs xact.ArgsMsg
+			if err := cos.MorphMarshal(info.Msg.Value, &xargs); err == nil {
+				_, row.Job = xact.GetKindName(xargs.Kind)
+				if !xargs.Bck.IsEmpty() {
+					row.Bucket = xargs.Bck.Cname("")
+				}
+			}
+		}
+		if info.Next != 0 {
+			row.Next = teb.FmtDateTime(time.Unix(0, info.Next))
+		}
+		if l := len(info.Runs); l > 0 {
+			run := &info.Runs[l-1]
+			row.LastRun = teb.FmtDateTime(time.Unix(0, run.Time)) + " (" + run.State + ")"
+		}
+		if info.Pending {
+			row.LastRun += " [queued]"
+		}
+		rows = append(rows, row)
+	}
+	return teb.Print(rows, cos.Ternary(hideHeader, teb.JobSchedulesBody, teb.JobSchedulesTmpl))
+}
+
+func rmJobScheduleHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	name := c.Args().Get(0)
+	if err := api.RemoveJobSchedule(apiBP, name); err != nil {
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("Removed job schedule %q", name))
+	return nil
+}
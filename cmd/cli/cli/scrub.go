@@ -23,13 +23,12 @@ import (
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/sys"
+	"github.com/NVIDIA/aistore/xact"
 
 	"github.com/urfave/cli"
 )
 
 // [TODO]
-// - '--checksum' option (slow)
-// - '--fix' option (***)
 // - multiple buckets vs one-log-per-scrub-metric - a problem
 // - async execution with '--wait' option
 // - speed-up `ls` via multiple workers
@@ -97,6 +96,10 @@ var (
 		largeSizeFlag,
 		scrubObjCachedFlag,
 		allColumnsFlag,
+		scrubCksumFlag,
+		scrubFixFlag,
+		waitFlag,
+		waitJobXactFinishedFlag,
 	)
 )
 
@@ -127,6 +130,14 @@ func scrubHandler(c *cli.Context) (err error) {
 		ctx.pref = prefix
 	}
 
+	// server-side
+	if flagIsSet(c, scrubCksumFlag) {
+		return scrubCksum(c, ctx.qbck, ctx.pref)
+	}
+	if flagIsSet(c, scrubFixFlag) {
+		return fmt.Errorf("option %s requires %s", qflprn(scrubFixFlag), qflprn(scrubCksumFlag))
+	}
+
 	now := mono.NanoTime()
 
 	// setup progress updates
@@ -192,6 +203,70 @@ func scrubHandler(c *cli.Context) (err error) {
 	return err
 }
 
+// run x-scrub (apc.ActScrub) on all targets
+func scrubCksum(c *cli.Context, qbck cmn.QueryBcks, prefix string) error {
+	if !qbck.IsBucket() {
+		return fmt.Errorf("option %s requires a bucket", qflprn(scrubCksumFlag))
+	}
+	if prefix != "" {
+		return fmt.Errorf("option %s does not support prefix (%q) - scrubbing entire bucket", qflprn(scrubCksumFlag), prefix)
+	}
+	bck := cmn.Bck(qbck)
+	if _, err := headBucket(bck, false /* don't add */); err != nil {
+		return err
+	}
+	xargs := xact.ArgsMsg{Kind: apc.ActScrub, Bck: bck}
+	if flagIsSet(c, scrubFixFlag) {
+		xargs.Flags |= xact.FlagRepair
+	}
+	xid, err := xstart(&xargs, "")
+	if err != nil {
+		return err
+	}
+
+	xargs.ID = xid
+	if !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag) {
+		actionX(c, &xargs, "")
+		return nil
+	}
+	fmt.Fprintf(c.App.Writer, "Started %s[%s]...\n", apc.ActScrub, xid)
+	if flagIsSet(c, waitJobXactFinishedFlag) {
+		xargs.Timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
+	}
+	if err := waitXact(&xargs); err != nil {
+		return err
+	}
+	fmt.Fprint(c.App.Writer, fmtXactSucceeded)
+
+	// failure domains: EC objects in violation of placement policy (the targets log the names)
+	if n := scrubDomainViolations(&xargs); n > 0 {
+		actionWarn(c, fmt.Sprintf("%s: %d erasure-coded object%s not spread across failure domains (see target logs)",
+			bck.Cname(""), n, cos.Plural(int(n))))
+	}
+	return nil
+}
+
+func scrubDomainViolations(xargs *xact.ArgsMsg) (n int64) {
+	xs, err := api.QueryXactionSnaps(apiBP, xargs)
+	if err != nil {
+		return 0
+	}
+	for _, snaps := range xs {
+		for _, snap := range snaps {
+			if snap.ID != xargs.ID {
+				continue
+			}
+			for kv := range strings.SplitSeq(snap.CtlMsg, ", ") {
+				if v, ok := strings.CutPrefix(kv, apc.ScrubCtlMsgDomains+":"); ok {
+					cnt, _ := strconv.ParseInt(v, 10, 64)
+					n += cnt
+				}
+			}
+		}
+	}
+	return n
+}
+
 ////////////
 // scrCtx //
 ////////////
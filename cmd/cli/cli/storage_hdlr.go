@@ -57,7 +57,9 @@ const scrubUsage = "Validate in-cluster content for inconsistencies and data pla
 	indent1 + "\t- 'ais scrub'\t- same as above;\n" +
 	indent1 + "\t- 'ais scrub ais://bucket'\t- validate a specific AIS bucket;\n" +
 	indent1 + "\t- 'ais scrub gs://abc/images/'\t- validate part of the GCP bucket under \"images/\";\n" +
-	indent1 + "\t- 'ais scrub gs://abc --prefix images/'\t- same as above using an explicit prefix."
+	indent1 + "\t- 'ais scrub gs://abc --prefix images/'\t- same as above using an explicit prefix;\n" +
+	indent1 + "\t- 'ais scrub ais://bucket --checksum'\t- run server-side job to recompute and validate content checksums;\n" +
+	indent1 + "\t- 'ais scrub ais://bucket --checksum --fix --wait'\t- same, and also repair corrupted or missing replicas."
 
 // {verb}-mountpath usage:
 const (
@@ -459,7 +461,21 @@ func showDiskStats(c *cli.Context, tid string) error {
 
 	table := teb.NewDiskTab(dsh, smap, regex, units, totalsHdr, withCap)
 	out := table.Template(hideHeader)
-	return teb.Print(dsh, out)
+	if err := teb.Print(dsh, out); err != nil {
+		return err
+	}
+
+	// capacity per storage class, if any
+	classes := storageClasses(dsh)
+	if len(classes) == 0 {
+		return nil
+	}
+	fmt.Fprintln(c.App.Writer)
+	opts := teb.Opts{AltMap: teb.FuncMapUnits(units, false /*incl. calendar date*/)}
+	if hideHeader {
+		return teb.Print(classes, teb.StorageClassBody, opts)
+	}
+	return teb.Print(classes, teb.StorageClassTmpl, opts)
 }
 
 // storage summary (a.k.a. bucket summary)
@@ -542,9 +558,27 @@ func summaryStorageHandler(c *cli.Context) error {
 	opts := teb.Opts{AltMap: altMap}
 	hideHeader := flagIsSet(c, noHeaderFlag)
 	if hideHeader {
-		return teb.Print(summaries, teb.BucketsSummariesBody, opts)
+		err = teb.Print(summaries, teb.BucketsSummariesBody, opts)
+	} else {
+		err = teb.Print(summaries, teb.BucketsSummariesTmpl, opts)
+	}
+	if err != nil || !hasQuota(summaries) {
+		return err
 	}
-	return teb.Print(summaries, teb.BucketsSummariesTmpl, opts)
+	fmt.Fprintln(c.App.Writer)
+	if hideHeader {
+		return teb.Print(summaries, teb.BucketsQuotaBody, opts)
+	}
+	return teb.Print(summaries, teb.BucketsQuotaTmpl, opts)
+}
+
+func hasQuota(summaries cmn.AllBsummResults) bool {
+	for _, summ := range summaries {
+		if summ.Quota != nil {
+			return true
+		}
+	}
+	return false
 }
 
 func newBsummCtxMsg(c *cli.Context, qbck cmn.QueryBcks, prefix string, objCached, bckPresent bool) (*bsummCtx, error) {
@@ -580,6 +614,14 @@ func (ctx *bsummCtx) get() (err error) {
 	return
 }
 
+// physical (on-disk) size when different, e.g. due to at-rest compression
+func (ctx *bsummCtx) physical(res *cmn.BsummResult) string {
+	if res.TotalSize.PresentPhys == 0 || res.TotalSize.PresentPhys == res.TotalSize.PresentObjs {
+		return ""
+	}
+	return ", physical=" + teb.FmtSize(int64(res.TotalSize.PresentPhys), ctx.units, 2)
+}
+
 // re-print line per bucket
 func (ctx *bsummCtx) progress(summaries *cmn.AllBsummResults, done bool) {
 	if done {
@@ -607,8 +649,8 @@ func (ctx *bsummCtx) progress(summaries *cmn.AllBsummResults, done bool) {
 		}
 		if res.Bck.IsAIS() {
 			debug.Assert(res.ObjCount.Remote == 0 && res.ObjCount.Present != 0)
-			s += fmt.Sprintf("(%s, size=%s)", cos.FormatBigI64(int64(res.ObjCount.Present)),
-				teb.FmtSize(int64(res.TotalSize.PresentObjs), ctx.units, 2))
+			s += fmt.Sprintf("(%s, size=%s%s)", cos.FormatBigI64(int64(res.ObjCount.Present)),
+				teb.FmtSize(int64(res.TotalSize.PresentObjs), ctx.units, 2), ctx.physical(res))
 			goto emit
 		}
 
@@ -616,8 +658,9 @@ func (ctx *bsummCtx) progress(summaries *cmn.AllBsummResults, done bool) {
 		if res.ObjCount.Present == 0 {
 			s += "[cluster: none"
 		} else {
-			s += fmt.Sprintf("[cluster: (%s, size=%s)",
-				cos.FormatBigI64(int64(res.ObjCount.Present)), teb.FmtSize(int64(res.TotalSize.PresentObjs), ctx.units, 2))
+			s += fmt.Sprintf("[cluster: (%s, size=%s%s)",
+				cos.FormatBigI64(int64(res.ObjCount.Present)), teb.FmtSize(int64(res.TotalSize.PresentObjs), ctx.units, 2),
+				ctx.physical(res))
 		}
 		if res.ObjCount.Remote == 0 {
 			s += "]"
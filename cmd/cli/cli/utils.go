@@ -239,9 +239,12 @@ func parseRemAliasURL(c *cli.Context) (alias, remAisURL string, err error) {
 	}
 	alias, remAisURL = parts[0], parts[1]
 ret:
-	if _, err = url.ParseRequestURI(remAisURL); err == nil {
-		err = cmn.ValidateRemAlias(alias)
+	for u := range strings.SplitSeq(remAisURL, ",") {
+		if _, err = url.ParseRequestURI(strings.TrimSpace(u)); err != nil {
+			return
+		}
 	}
+	err = cmn.ValidateRemAlias(alias)
 	return
 }
 
@@ -535,8 +538,20 @@ func bckPropList(props *cmn.Bprops, verbose bool) (propList nvpairList) {
 			{"ec", props.EC.String()},
 			{"chunks", props.Chunks.String()},
 			{"lru", props.LRU.String()},
+			{"lifecycle", props.Lifecycle.String()},
+			{"compression", props.Compression.String()},
+			{"encryption", props.Encryption.String()},
+			{"policy", props.Policy.String()},
+			{"cors", props.CORS.String()},
+			{"object_lock", props.ObjectLock.String()},
+			{"quota", props.Quota.String()},
+			{"tiering", props.Tiering.String()},
+			{"events", props.Events.String()},
 			{"versioning", props.Versioning.String()},
 		}
+		if len(props.Snapshots) > 0 {
+			propList = append(propList, nvpair{Name: "snapshots", Value: fmtSnapshots(props.Snapshots)})
+		}
 	} else {
 		err := cmn.IterFields(props, func(tag string, field cmn.IterField) (error, bool) {
 			var value string
@@ -551,6 +566,18 @@ func bckPropList(props *cmn.Bprops, verbose bool) (propList nvpairList) {
 				if props.Chunks.ObjSizeLimit == 0 {
 					value += " (auto-chunking disabled)"
 				}
+			case "lifecycle.rules":
+				value = fmtLifecycleRules(props.Lifecycle.Rules)
+			case "policy.statements":
+				value = fmtPolicyStatements(props.Policy.Statements)
+			case "cors.rules":
+				value = fmtCORSRules(props.CORS.Rules)
+			case "events.rules":
+				value = fmtEventRules(props.Events.Rules)
+			case "snapshots":
+				value = fmtSnapshots(props.Snapshots)
+			case "encryption.key":
+				value = cos.Ternary(props.Encryption.Key == "", "-", "(assigned)") // wrapped data key: not to display
 			default:
 				v := field.Value()
 				value = _toStr(v)
@@ -574,6 +601,100 @@ func bckPropList(props *cmn.Bprops, verbose bool) (propList nvpairList) {
 	return propList
 }
 
+func fmtLifecycleRules(rules []cmn.LifecycleRule) string {
+	if len(rules) == 0 {
+		return teb.NotSetVal
+	}
+	lines := make([]string, 0, len(rules))
+	for i := range rules {
+		var (
+			rule  = &rules[i]
+			parts = make([]string, 0, 5)
+		)
+		if rule.Prefix != "" {
+			parts = append(parts, "prefix="+rule.Prefix)
+		}
+		if len(rule.Tags) > 0 {
+			parts = append(parts, fmt.Sprintf("tags=%v", rule.Tags))
+		}
+		if rule.ExpirationDays > 0 {
+			parts = append(parts, fmt.Sprintf("expire=%dd", rule.ExpirationDays))
+		}
+		if rule.AbortMptDays > 0 {
+			parts = append(parts, fmt.Sprintf("abort-mpt=%dd", rule.AbortMptDays))
+		}
+		if rule.Disabled {
+			parts = append(parts, "disabled")
+		}
+		lines = append(lines, rule.ID+"["+strings.Join(parts, ", ")+"]")
+	}
+	return strings.Join(lines, "\n\t ")
+}
+
+func fmtPolicyStatements(stmts []cmn.PolicyStatement) string {
+	if len(stmts) == 0 {
+		return teb.NotSetVal
+	}
+	lines := make([]string, 0, len(stmts))
+	for i := range stmts {
+		stmt := &stmts[i]
+		line := fmt.Sprintf("%s %s: %s on %s", stmt.Effect, strings.Join(stmt.Principals, ","),
+			strings.Join(stmt.Actions, ","), strings.Join(stmt.Resources, ","))
+		if stmt.Sid != "" {
+			line = stmt.Sid + "[" + line + "]"
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n\t ")
+}
+
+func fmtCORSRules(rules []cmn.CORSRule) string {
+	if len(rules) == 0 {
+		return teb.NotSetVal
+	}
+	lines := make([]string, 0, len(rules))
+	for i := range rules {
+		rule := &rules[i]
+		line := fmt.Sprintf("%s from %s", strings.Join(rule.AllowedMethods, ","), strings.Join(rule.AllowedOrigins, ","))
+		if rule.ID != "" {
+			line = rule.ID + "[" + line + "]"
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n\t ")
+}
+
+func fmtEventRules(rules []cmn.EventRule) string {
+	if len(rules) == 0 {
+		return teb.NotSetVal
+	}
+	lines := make([]string, 0, len(rules))
+	for i := range rules {
+		rule := &rules[i]
+		dst := cos.Ternary(rule.Webhook != "", rule.Webhook, "queue:"+rule.Queue)
+		line := fmt.Sprintf("%s => %s", strings.Join(rule.Events, ","), dst)
+		if rule.Prefix != "" || rule.Suffix != "" {
+			line = rule.Prefix + "*" + rule.Suffix + ": " + line
+		}
+		if rule.ID != "" {
+			line = rule.ID + "[" + line + "]"
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n\t ")
+}
+
+func fmtSnapshots(snaps []cmn.BckSnapshot) string {
+	if len(snaps) == 0 {
+		return teb.NotSetVal
+	}
+	lines := make([]string, 0, len(snaps))
+	for i := range snaps {
+		lines = append(lines, snaps[i].Name+" ("+fmtBucketCreatedTime(snaps[i].Created)+")")
+	}
+	return strings.Join(lines, "\n\t ")
+}
+
 func fmtBucketCreatedTime(created int64) string {
 	if created == 0 {
 		return teb.NotSetVal
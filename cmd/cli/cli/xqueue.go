@@ -0,0 +1,114 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles xaction admission queue (jobs queued behind conflicting running jobs).
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/xact"
+
+	"github.com/urfave/cli"
+)
+
+var (
+	queuePrioFlag = cli.IntFlag{
+		Name: "queue-prio",
+		Usage: "When conflicting with a running job, do not fail - queue the request to start as soon as the conflict clears;\n" +
+			indent4 + "\tthe value is the request's priority (higher starts first), e.g.: '--queue-prio 10';\n" +
+			indent4 + "\tsee also: 'ais show job --queued'",
+	}
+	queueDeadlineFlag = DurationFlag{
+		Name: "queue-deadline",
+		Usage: "Max time to remain queued (used with " + qflprn(queuePrioFlag) + "), e.g.: '--queue-deadline 2h';\n" +
+			indent4 + "\tvalid time units: " + timeUnits,
+	}
+	queuedJobsFlag = cli.BoolFlag{
+		Name: "queued",
+		Usage: "Jobs queued behind conflicting running jobs (see " + qflprn(queuePrioFlag) + "), e.g.:\n" +
+			indent4 + "\t- 'ais show job --queued'\t- show pending and recently resolved requests;\n" +
+			indent4 + "\t- 'ais stop job --queued QUEUE_ID'\t- cancel pending request",
+	}
+)
+
+// start xaction or, when conflicting with running job(s), queue it
+// returns queue ID when queued
+func xstartAdmit(c *cli.Context, xargs *xact.ArgsMsg, extra string) (xid, qid string, err error) {
+	admit := &api.AdmitArgs{
+		Prio:     parseIntFlag(c, queuePrioFlag),
+		Deadline: parseDurationFlag(c, queueDeadlineFlag),
+	}
+	xid, qid, err = api.StartXactionAdmit(apiBP, xargs, extra, admit)
+	if err != nil || qid == "" {
+		return xid, "", err
+	}
+	_, xname := xact.GetKindName(xargs.Kind)
+	actionDone(c, fmt.Sprintf("Conflicting with running job(s): queued %s (queue ID %q, priority %d). To monitor, run 'ais show job --queued'",
+		xname, qid, admit.Prio))
+	return "", qid, nil
+}
+
+func showXactQueueHandler(c *cli.Context) error {
+	xq, err := api.GetXactQueue(apiBP)
+	if err != nil {
+		return V(err)
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(xq, "", teb.Jopts(true))
+	}
+	if len(xq) == 0 {
+		actionDone(c, "No queued jobs")
+		return nil
+	}
+	rows := make([]*teb.XactQueueHelper, 0, len(xq))
+	for _, e := range xq {
+		row := &teb.XactQueueHelper{
+			ID:       e.ID,
+			Job:      e.Action,
+			Bucket:   cos.Ternary(e.Bck == "", teb.NotSetVal, e.Bck),
+			Prio:     strconv.Itoa(e.Prio),
+			State:    e.State,
+			Queued:   teb.FmtDateTime(time.Unix(0, e.Added)),
+			Deadline: teb.NotSetVal,
+			Attempts: strconv.Itoa(e.Attempts),
+			Info:     teb.NotSetVal,
+		}
+		if e.Kind != "" {
+			row.Job = e.Kind
+		}
+		if e.Deadline != 0 {
+			row.Deadline = teb.FmtDateTime(time.Unix(0, e.Deadline))
+		}
+		switch {
+		case e.Xid != "":
+			row.Info = e.Xid
+		case e.Err != "":
+			row.Info = e.Err
+		}
+		rows = append(rows, row)
+	}
+	return teb.Print(rows, cos.Ternary(flagIsSet(c, noHeaderFlag), teb.XactQueueBody, teb.XactQueueTmpl))
+}
+
+func dequeueXactionHandler(c *cli.Context, shift int) error {
+	qid := c.Args().Get(shift)
+	if qid == "" {
+		return missingArgumentsError(c, "QUEUE_ID")
+	}
+	if err := api.DequeueXaction(apiBP, qid); err != nil {
+		if cmn.IsStatusNotFound(err) {
+			return fmt.Errorf("queued job %q not found (see 'ais show job --queued')", qid)
+		}
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("Canceled queued job %q", qid))
+	return nil
+}
@@ -6,6 +6,7 @@ package teb
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -16,6 +17,8 @@ import (
 const (
 	colProxy      = "PROXY"
 	colTarget     = "TARGET"
+	colDomain     = "FAILURE DOMAIN"
+	colWeight     = "HRW WEIGHT"
 	colMemUsed    = "MEM USED(%)"
 	colMemAvail   = "MEM AVAIL"
 	colCapUsed    = "CAP USED(%)"
@@ -163,6 +166,8 @@ func (h *StatsAndStatusHelper) MakeTabT(smap *meta.Smap, opts ClusterTabOpts) *T
 		showThrottle = anyThrottled(h.Tmap) // ditto
 		cols         = []*header{
 			{name: colTarget},
+			{name: colDomain, hide: !smap.HasDomains()},
+			{name: colWeight, hide: !smap.IsWeighted()},
 			{name: colMemUsed},
 			{name: colMemAvail},
 			{name: colCapUsed},
@@ -188,6 +193,8 @@ func (h *StatsAndStatusHelper) MakeTabT(smap *meta.Smap, opts ClusterTabOpts) *T
 			nid, nstatus := fmtStatusSID(ds.Snode.ID(), smap, ds.Status)
 			table.addRow(row{
 				nid,
+				ds.Snode.FailureDomain,
+				_weight(smap, ds.Snode),
 				unknownVal, // mem used
 				unknownVal, // mem avail
 				unknownVal, // cap used
@@ -224,6 +231,8 @@ func (h *StatsAndStatusHelper) MakeTabT(smap *meta.Smap, opts ClusterTabOpts) *T
 
 		table.addRow(row{
 			fmtDaemonID(ds.Snode.ID(), smap, ds.Status),
+			ds.Snode.FailureDomain,
+			_weight(smap, ds.Snode),
 			memUsed,
 			memAvail,
 			capUsed,
@@ -243,6 +252,16 @@ func (h *StatsAndStatusHelper) MakeTabT(smap *meta.Smap, opts ClusterTabOpts) *T
 	return table
 }
 
+func _weight(smap *meta.Smap, si *meta.Snode) string {
+	if si.HrwWeight == 0 {
+		if w := smap.Weight(si); w != 0 {
+			return strconv.FormatUint(w, 10) + " (default)"
+		}
+		return NotSetVal
+	}
+	return strconv.FormatUint(si.HrwWeight, 10)
+}
+
 func _sysCPU(info *apc.MemCPUInfo) string {
 	if info.CPUUtil == 0 &&
 		info.LoadAvg.One == 0 &&
@@ -342,12 +342,47 @@ const (
 	ListBucketsTmplNoSummary = ListBucketsHdrNoSummary + ListBucketsBodyNoSummary
 
 	// Bucket summary templates
-	BucketsSummariesTmpl = "NAME\t OBJECTS (cached, remote)\t OBJECT SIZES (min, avg, max)\t TOTAL OBJECT SIZE (cached, remote)\t USAGE(%)\n" +
+	BucketsSummariesTmpl = "NAME\t OBJECTS (cached, remote)\t OBJECT SIZES (min, avg, max)\t TOTAL OBJECT SIZE (cached, remote)\t PHYSICAL SIZE\t USAGE(%)\n" +
 		BucketsSummariesBody
 	BucketsSummariesBody = "{{range $k, $v := . }}" +
 		"{{FormatBckName $v.Bck}}\t {{$v.ObjCount.Present}} {{$v.ObjCount.Remote}}\t " +
 		"{{FormatMAM $v.ObjSize.Min}} {{FormatMAM $v.ObjSize.Avg}} {{FormatMAM $v.ObjSize.Max}}\t " +
-		"{{FormatBytesUns $v.TotalSize.PresentObjs 2}} {{FormatBytesUns $v.TotalSize.RemoteObjs 2}}\t {{$v.UsedPct}}%\n" +
+		"{{FormatBytesUns $v.TotalSize.PresentObjs 2}} {{FormatBytesUns $v.TotalSize.RemoteObjs 2}}\t " +
+		"{{FormatBytesUns $v.TotalSize.PresentPhys 2}}\t {{$v.UsedPct}}%\n" +
+		"{{end}}"
+
+	// usage vs. bucket and namespace quotas (see cmn.BsummQuota)
+	BucketsQuotaTmpl = "NAME\t USED (size, objects)\t QUOTA\t NAMESPACE USED (size, objects)\t NAMESPACE QUOTA\n" +
+		BucketsQuotaBody
+	BucketsQuotaBody = "{{range $k, $v := . }}{{if $v.Quota}}" +
+		"{{FormatBckName $v.Bck}}\t {{FormatBytesSig $v.Quota.BckUsed.Size 2}} {{$v.Quota.BckUsed.Objs}}\t {{$v.Quota.Bck.String}}\t " +
+		"{{FormatBytesSig $v.Quota.NsUsed.Size 2}} {{$v.Quota.NsUsed.Objs}}\t {{$v.Quota.Ns.String}}\n" +
+		"{{end}}{{end}}"
+
+	// capacity per storage class (mountpath label)
+	StorageClassTmpl = "STORAGE CLASS\t MOUNTPATHS\t USED\t AVAIL\t USED(%)\n" +
+		StorageClassBody
+	StorageClassBody = "{{range $v := . }}" +
+		"{{$v.Label}}\t {{$v.Mountpaths}}\t {{FormatBytesUns $v.Used 2}}\t {{FormatBytesUns $v.Avail 2}}\t {{$v.PctUsed}}%\n" +
+		"{{end}}"
+
+	// recurring jobs
+	JobSchedulesTmpl = "NAME\t CRON\t JOB\t BUCKET\t OVERLAP\t NEXT RUN\t LAST RUN\n" +
+		JobSchedulesBody
+	JobSchedulesBody = "{{range $v := . }}" +
+		"{{$v.Name}}\t {{$v.Cron}}\t {{$v.Job}}\t {{$v.Bucket}}\t {{$v.Overlap}}\t {{$v.Next}}\t {{$v.LastRun}}\n" +
+		"{{end}}"
+	JobRunsTmpl = "TIME\t STATE\t JOB ID\t ERROR\n" +
+		JobRunsBody
+	JobRunsBody = "{{range $v := . }}" +
+		"{{$v.Time}}\t {{$v.State}}\t {{$v.Xid}}\t {{$v.Err}}\n" +
+		"{{end}}"
+
+	// xaction admission queue
+	XactQueueTmpl = "QUEUE ID\t JOB\t BUCKET\t PRIORITY\t STATE\t QUEUED\t DEADLINE\t ATTEMPTS\t JOB ID / ERROR\n" +
+		XactQueueBody
+	XactQueueBody = "{{range $v := . }}" +
+		"{{$v.ID}}\t {{$v.Job}}\t {{$v.Bucket}}\t {{$v.Prio}}\t {{$v.State}}\t {{$v.Queued}}\t {{$v.Deadline}}\t {{$v.Attempts}}\t {{$v.Info}}\n" +
 		"{{end}}"
 
 	// Shard index summary templates
@@ -38,6 +38,42 @@ type (
 		Stat     cos.DiskStats
 		Tcdf     *fs.Tcdf
 	}
+	// per storage class (mountpath label) capacity - see cmn.TieringConf
+	StorageClassHelper struct {
+		Label      string
+		Mountpaths int
+		Used       uint64
+		Avail      uint64
+		PctUsed    int64
+	}
+	// recurring jobs - see cmn.JobSchedule
+	JobScheduleHelper struct {
+		Name    string
+		Cron    string
+		Job     string
+		Bucket  string
+		Overlap string
+		Next    string
+		LastRun string
+	}
+	JobRunHelper struct {
+		Time  string
+		State string
+		Xid   string
+		Err   string
+	}
+	// xaction admission queue - see cmn.XactQueue
+	XactQueueHelper struct {
+		ID       string
+		Job      string
+		Bucket   string
+		Prio     string
+		State    string
+		Queued   string
+		Deadline string
+		Attempts string
+		Info     string // xaction ID (started) or error (most recent conflict or failure)
+	}
 	SmapHelper struct {
 		Smap         *meta.Smap
 		ExtendedURLs bool
@@ -57,22 +57,32 @@ const (
 
 type (
 	Bprops struct {
-		BackendBck  Bck             `json:"backend_bck,omitempty"`            // makes a remote bucket out of a given ais://
-		WritePolicy WritePolicyConf `json:"write_policy"`                     // write object metadata (immediate | delayed | never)
-		Provider    string          `json:"provider" list:"readonly"`         // backend provider
-		Renamed     string          `list:"omit"`                             // Deprecated: non-empty iff the bucket has been renamed
-		Cksum       CksumConf       `json:"checksum"`                         // this bucket's checksum (for supported enum, see cmn/cos.cksum)
-		Extra       ExtraProps      `json:"extra,omitempty" list:"omitempty"` // e.g., AWS.Endpoint for this bucket
-		RateLimit   RateLimitConf   `json:"rate_limit"`                       // frontend and backend rate limiting - bursty and adaptive, respectively
-		EC          ECConf          `json:"ec"`                               // erasure coding
-		Chunks      ChunksConf      `json:"chunks"`                           // chunks and chunk manifests; multipart upload
-		Mirror      MirrorConf      `json:"mirror"`                           // n-way mirroring
-		LRU         LRUConf         `json:"lru"`                              // LRU watermarks and enable/disable
-		Access      apc.AccessAttrs `json:"access,string"`                    // access permissions
-		Features    feat.Flags      `json:"features,string"`                  // to flip assorted enumerated defaults (e.g. "S3-Use-Path-Style"; see cmn/feat)
-		BID         uint64          `json:"bid,string" list:"omit"`           // unique ID
-		Created     int64           `json:"created,string" list:"readonly"`   // creation timestamp
-		Versioning  VersionConf     `json:"versioning"`                       // see "inherit"
+		BackendBck  Bck             `json:"backend_bck,omitempty"`                // makes a remote bucket out of a given ais://
+		WritePolicy WritePolicyConf `json:"write_policy"`                         // write object metadata (immediate | delayed | never)
+		Provider    string          `json:"provider" list:"readonly"`             // backend provider
+		Renamed     string          `list:"omit"`                                 // Deprecated: non-empty iff the bucket has been renamed
+		Cksum       CksumConf       `json:"checksum"`                             // this bucket's checksum (for supported enum, see cmn/cos.cksum)
+		Extra       ExtraProps      `json:"extra,omitempty" list:"omitempty"`     // e.g., AWS.Endpoint for this bucket
+		RateLimit   RateLimitConf   `json:"rate_limit"`                           // frontend and backend rate limiting - bursty and adaptive, respectively
+		EC          ECConf          `json:"ec"`                                   // erasure coding
+		Chunks      ChunksConf      `json:"chunks"`                               // chunks and chunk manifests; multipart upload
+		Mirror      MirrorConf      `json:"mirror"`                               // n-way mirroring
+		LRU         LRUConf         `json:"lru"`                                  // LRU watermarks and enable/disable
+		Lifecycle   LifecycleConf   `json:"lifecycle"`                            // object expiration and incomplete-upload cleanup rules
+		Compression CompressionConf `json:"compression"`                          // at-rest compression of objects and chunks
+		Encryption  EncryptionConf  `json:"encryption"`                           // at-rest encryption of objects, chunks, and EC slices
+		Policy      PolicyConf      `json:"policy"`                               // bucket policy: per-principal allow/deny statements (see also "access")
+		CORS        CORSConf        `json:"cors"`                                 // cross-origin resource sharing (S3 API)
+		ObjectLock  ObjectLockConf  `json:"object_lock"`                          // WORM: object retention and legal hold
+		Quota       QuotaConf       `json:"quota"`                                // soft and hard capacity and object-count limits
+		Tiering     TieringConf     `json:"tiering"`                              // hot/cold storage-class tiering across mountpath labels
+		Events      EventsConf      `json:"events"`                               // event notifications upon object create, delete, and rename
+		Access      apc.AccessAttrs `json:"access,string"`                        // access permissions
+		Features    feat.Flags      `json:"features,string"`                      // to flip assorted enumerated defaults (e.g. "S3-Use-Path-Style"; see cmn/feat)
+		BID         uint64          `json:"bid,string" list:"omit"`               // unique ID
+		Created     int64           `json:"created,string" list:"readonly"`       // creation timestamp
+		Versioning  VersionConf     `json:"versioning"`                           // see "inherit"
+		Snapshots   []BckSnapshot   `json:"snapshots,omitempty" list:"omitempty"` // point-in-time bucket views (apc.ActCreateSnapshot)
 	}
 
 	ExtraProps struct {
@@ -192,6 +202,24 @@ type (
 		Mirror *MirrorConfToSet `json:"mirror,omitempty"` // +gen:optional
 		// Large-object chunking.
 		Chunks *ChunksConfToSet `json:"chunks,omitempty"` // +gen:optional
+		// Object expiration and incomplete multipart upload cleanup rules.
+		Lifecycle *LifecycleConfToSet `json:"lifecycle,omitempty"` // +gen:optional
+		// At-rest compression codec for new writes.
+		Compression *CompressionConfToSet `json:"compression,omitempty"` // +gen:optional
+		// At-rest encryption of new writes.
+		Encryption *EncryptionConfToSet `json:"encryption,omitempty"` // +gen:optional
+		// Bucket policy (allow/deny statements).
+		Policy *PolicyConfToSet `json:"policy,omitempty"` // +gen:optional
+		// Cross-origin resource sharing (CORS) rules.
+		CORS *CORSConfToSet `json:"cors,omitempty"` // +gen:optional
+		// Object lock (WORM) and default retention.
+		ObjectLock *ObjectLockConfToSet `json:"object_lock,omitempty"` // +gen:optional
+		// Soft and hard capacity and object-count quotas.
+		Quota *QuotaConfToSet `json:"quota,omitempty"` // +gen:optional
+		// Hot/cold storage-class tiering across mountpath labels.
+		Tiering *TieringConfToSet `json:"tiering,omitempty"` // +gen:optional
+		// Event notification rules (webhook or on-disk queue destinations).
+		Events *EventsConfToSet `json:"events,omitempty"` // +gen:optional
 		// Erasure coding (data and parity slices).
 		EC *ECConfToSet `json:"ec,omitempty"` // +gen:optional
 		// Bitwise access-permission mask. See `apc.AccessAttrs` for
@@ -317,7 +345,7 @@ func (bp *Bprops) Validate(targetCnt int) error {
 
 	// run assorted props validators
 	var softErr error
-	for _, pv := range []propsValidator{&bp.Cksum, &bp.Mirror, &bp.EC, &bp.Extra, &bp.WritePolicy, &bp.RateLimit, &bp.Chunks, &bp.LRU, &bp.Features} {
+	for _, pv := range []propsValidator{&bp.Cksum, &bp.Mirror, &bp.EC, &bp.Extra, &bp.WritePolicy, &bp.RateLimit, &bp.Chunks, &bp.LRU, &bp.Lifecycle, &bp.Compression, &bp.Encryption, &bp.Policy, &bp.CORS, &bp.ObjectLock, &bp.Quota, &bp.Tiering, &bp.Events, &bp.Versioning, &bp.Features} {
 		var err error
 		switch {
 		case pv == &bp.EC:
@@ -338,9 +366,18 @@ func (bp *Bprops) Validate(targetCnt int) error {
 	}
 
 	// limitations
+	if bp.Versioning.KeepHistory() && (bp.Provider != apc.AIS || !bp.BackendBck.IsEmpty()) {
+		return errors.New("versioning: keep_versions and keep_days are only supported for ais:// buckets without remote backend")
+	}
+	if len(bp.Snapshots) > 0 && (bp.Provider != apc.AIS || !bp.BackendBck.IsEmpty()) {
+		return errors.New("bucket snapshots are only supported for ais:// buckets without remote backend (hint: destroy snapshots first)")
+	}
 	if bp.Mirror.Enabled && bp.EC.Enabled {
 		nlog.Warningln("n-way mirroring and EC are both enabled at the same time on the same bucket")
 	}
+	if bp.Tiering.Enabled && (bp.Mirror.Enabled || bp.EC.Enabled) {
+		return errors.New("tiering cannot be enabled together with n-way mirroring or EC on the same bucket")
+	}
 	if bp.Mirror.Enabled && bp.Chunks.AutoEnabled() {
 		return errors.New("n-way mirroring and chunking cannot be enabled at the same time on the same bucket (MPU chunking is still allowed)")
 	}
@@ -543,6 +580,7 @@ func (conf *ExtraPropsOCI) validate() error {
 
 type (
 	BsummResult struct {
+		Quota *BsummQuota `json:"quota,omitempty"` // usage vs. bucket and namespace quotas, if any
 		Bck
 		apc.BsummResult
 	}
@@ -575,6 +613,7 @@ func aggr(from, to *BsummResult) {
 	to.ObjCount.Remote += from.ObjCount.Remote
 	to.TotalSize.OnDisk += from.TotalSize.OnDisk
 	to.TotalSize.PresentObjs += from.TotalSize.PresentObjs
+	to.TotalSize.PresentPhys += from.TotalSize.PresentPhys
 	to.TotalSize.RemoteObjs += from.TotalSize.RemoteObjs
 }
 
@@ -157,7 +157,7 @@ func (n Ns) _copy(b []byte, l int) int {
 	return off
 }
 
-func (n Ns) validate() (err error) {
+func (n Ns) Validate() (err error) {
 	if n.IsGlobal() {
 		return nil
 	}
@@ -272,7 +272,7 @@ func (b *Bck) Less(other *Bck) bool {
 func (b *Bck) Validate() (err error) {
 	err = b.ValidateName()
 	if err == nil {
-		err = b.Ns.validate()
+		err = b.Ns.Validate()
 	}
 	return err
 }
@@ -532,7 +532,7 @@ func (qbck *QueryBcks) Validate() (err error) {
 		}
 	}
 	if qbck.Ns != NsGlobal && qbck.Ns != NsAnyRemote {
-		return qbck.Ns.validate()
+		return qbck.Ns.Validate()
 	}
 	return nil
 }
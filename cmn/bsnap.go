@@ -0,0 +1,60 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// custom metadata key: time (unix nano) the object was written while the bucket had
+// snapshot(s); absent when written with no snapshots (that is, prior to all existing ones)
+const SnapWrittenObjMD = ReservedObjMD + "snap-written"
+
+// BckSnapshot is a named, point-in-time view of an ais:// bucket with copy-on-write semantics:
+// objects written prior to `Created` and overwritten or deleted afterwards are preserved
+// (see core/lsnap for details and limitations)
+type BckSnapshot struct {
+	Name    string `json:"name"`
+	Created int64  `json:"created,string"` // (unix nano)
+}
+
+// snapshot's content directory (see fs.ObjSnapCT); unique even when the name gets reused
+func (s *BckSnapshot) ID() string { return strconv.FormatInt(s.Created, 10) }
+
+// Has returns true if the object written at `written` (SnapWrittenObjMD; zero when
+// not stamped) belongs to the snapshot
+func (s *BckSnapshot) Has(written int64) bool { return written < s.Created }
+
+func (s *BckSnapshot) String() string {
+	return s.Name + "[" + cos.FormatNanoTime(s.Created, "") + "]"
+}
+
+// Snapshot returns the named snapshot, or nil if not found
+func (bp *Bprops) Snapshot(name string) *BckSnapshot {
+	for i := range bp.Snapshots {
+		if bp.Snapshots[i].Name == name {
+			return &bp.Snapshots[i]
+		}
+	}
+	return nil
+}
+
+func ValidateSnapshot(bck *Bck, bp *Bprops, name string) error {
+	if !bck.IsAIS() || !bp.BackendBck.IsEmpty() {
+		return errors.New("bucket snapshots are only supported for ais:// buckets without remote backend (" + bck.Cname("") + ")")
+	}
+	if name == "" {
+		return errors.New("snapshot name cannot be empty (" + bck.Cname("") + ")")
+	}
+	return cos.CheckAlphaPlus(name, "snapshot name")
+}
+
+func NewErrSnapshotNotFound(bck *Bck, name string) error {
+	return cos.NewErrNotFound(nil, bck.Cname("")+" snapshot "+strconv.Quote(name))
+}
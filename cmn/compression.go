@@ -0,0 +1,51 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/aistore/api/apc"
+)
+
+// At-rest compression: when enabled, objects (and chunks) get compressed
+// upon PUT (and any other write that creates new content) and transparently
+// decompressed on GET, including range reads.
+//
+// Changing the codec affects only new writes - existing objects remain
+// as they were stored and stay readable.
+//
+// Object size and checksum always refer to the logical (uncompressed) content;
+// physical (on-disk) size is reported separately by `ais bucket summary`.
+
+type (
+	CompressionConf struct {
+		// Codec: one of apc.SupportedCodecs; empty or "none" - no compression.
+		Codec string `json:"codec,omitempty"`
+	}
+
+	// CompressionConfToSet is the partial-update counterpart of CompressionConf.
+	CompressionConfToSet struct {
+		// Compression codec applied to new writes: "none", "zstd", or "lz4".
+		Codec *string `json:"codec,omitempty"` // +gen:optional
+	}
+)
+
+func (c *CompressionConf) ValidateAsProps(...any) error {
+	if !apc.IsValidCodec(c.Codec) {
+		return fmt.Errorf("invalid compression codec %q (expecting one of %v)", c.Codec, apc.SupportedCodecs)
+	}
+	return nil
+}
+
+func (c *CompressionConf) String() string {
+	if !c.IsEnabled() {
+		return confDisabled
+	}
+	return c.Codec
+}
+
+func (c *CompressionConf) IsEnabled() bool { return c.Codec != "" && c.Codec != apc.CodecNone }
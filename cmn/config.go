@@ -35,6 +35,12 @@ const (
 	confDisabled = "Disabled" // common conf.String()
 )
 
+// LocalConfig.HrwWeight
+const (
+	HrwWeightAuto = "auto"
+	MaxHrwWeight  = 1 << 30 // (ie., 1 EiB when expressed in GiB)
+)
+
 type (
 	validator interface {
 		// validate _and_ mutate live section -
@@ -70,6 +76,13 @@ type (
 		LogDir    string         `json:"log_dir"`
 		TestFSP   TestFSPConf    `json:"test_fspaths"`
 		HostNet   LocalNetConfig `json:"host_net"`
+		// optional failure domain (e.g., rack or zone) label - targets only;
+		// EC spreads slices and replicas across failure domains (see meta.Smap.HrwTargetList)
+		FailureDomain string `json:"failure_domain,omitempty"`
+		// optional capacity weight - targets only (see meta.Snode.HrwWeight):
+		// "auto" (derive from total mountpath capacity) or positive integer (GiB, by convention);
+		// when set, mountpaths are weighted by their respective capacities as well
+		HrwWeight string `json:"hrw_weight,omitempty"`
 	}
 
 	// ais node: (local) network config
@@ -303,7 +316,8 @@ type (
 		// duration of the upload.
 		CheckpointEvery int `json:"checkpoint_every,omitempty"`
 
-		// Reserved bitwise field for future advanced behaviors (compression, GC, placement, etc.)
+		// Reserved bitwise field for future advanced behaviors (GC, placement, etc.)
+		// (compression is configured separately - see CompressionConf)
 		Flags uint64 `json:"flags,omitempty"`
 	}
 
@@ -643,6 +657,12 @@ type (
 		// - deleting in-cluster object if its remote ("cached") counterpart does not exist
 		// See also: apc.QparamSync, apc.CopyBckMsg
 		Sync bool `json:"synchronize"`
+
+		// ais:// buckets only: retain prior (overwritten and deleted) object versions -
+		// up to `KeepVersions` versions per object and/or for up to `KeepDays` days;
+		// zero value means "no limit" while both zeros disable version history
+		KeepVersions int `json:"keep_versions,omitempty"`
+		KeepDays     int `json:"keep_days,omitempty"`
 	}
 	// VersionConfToSet is the partial-update counterpart of VersionConf.
 	VersionConfToSet struct {
@@ -655,6 +675,10 @@ type (
 		// Stronger form of `validate_warm_get`: additionally, delete
 		// in-cluster objects whose remote counterpart no longer exists.
 		Sync *bool `json:"synchronize,omitempty"` // +gen:optional
+		// Maximum number of retained prior versions per object (ais:// buckets).
+		KeepVersions *int `json:"keep_versions,omitempty"` // +gen:optional
+		// Maximum age (days) of retained prior versions (ais:// buckets).
+		KeepDays *int `json:"keep_days,omitempty"` // +gen:optional
 	}
 
 	// NetConf: network configuration
@@ -935,6 +959,13 @@ type (
 		// fastcompression.blogspot.com/2013/04/lz4-streaming-format-final.html
 		LZ4BlockMaxSize  cos.SizeIEC `json:"lz4_block"`
 		LZ4FrameChecksum bool        `json:"lz4_frame_checksum"`
+
+		// codec used by compressed streams (see XactConf.Compression):
+		// "lz4" (default) or "zstd" - the latter being slower but stronger
+		Codec string `json:"codec"`
+		// zstd compression level, one of [1, 22] with 1 (default) being the fastest
+		// (github.com/klauspost/compress/zstd maps it to one of its 4 speed levels)
+		ZstdLevel int `json:"zstd_level"`
 	}
 	TransportConfToSet struct {
 		MaxHeaderSize    *int          `json:"max_header,omitempty"`
@@ -943,6 +974,8 @@ type (
 		QuiesceTime      *cos.Duration `json:"quiescent,omitempty"`
 		LZ4BlockMaxSize  *cos.SizeIEC  `json:"lz4_block,omitempty"`
 		LZ4FrameChecksum *bool         `json:"lz4_frame_checksum,omitempty"`
+		Codec            *string       `json:"codec,omitempty"`
+		ZstdLevel        *int          `json:"zstd_level,omitempty"`
 	}
 
 	// MemsysConf: restart required for changes (see ConfigRestartRequired).
@@ -972,7 +1005,7 @@ type (
 	// XactConfToSet is the partial-update counterpart of XactConf.
 	XactConfToSet struct {
 		// On-wire compression policy for the xaction's data streams
-		// (`"never"`, `"always"`, etc.). See `api/apc/compression.go`
+		// (`"never"`, `"always"`, `"auto"`). See `api/apc/compression.go`
 		// for the full enum.
 		Compression *string `json:"compression,omitempty"` // +gen:optional
 		// Stream-bundle multiplier: number of parallel streams opened
@@ -1040,9 +1073,10 @@ type (
 	}
 	// WritePolicyConfToSet is the partial-update counterpart of WritePolicyConf.
 	WritePolicyConfToSet struct {
-		// When to persist object data writes. Read-only (not yet
-		// implemented).
-		Data *apc.WritePolicy `json:"data,omitempty" list:"readonly"` // +gen:optional
+		// When to write object data to the remote backend. One of
+		// `"immediate"` (write-through) or `"delayed"` (write-back:
+		// upload asynchronously after local write).
+		Data *apc.WritePolicy `json:"data,omitempty"` // +gen:optional
 		// When to persist object metadata writes. One of `"immediate"`,
 		// `"delayed"`, or `"never"`.
 		MD *apc.WritePolicy `json:"md,omitempty"` // +gen:optional
@@ -1303,6 +1337,16 @@ var (
 	_ propsValidator = (*RateLimitConf)(nil)
 	_ propsValidator = (*ChunksConf)(nil)
 	_ propsValidator = (*LRUConf)(nil)
+	_ propsValidator = (*LifecycleConf)(nil)
+	_ propsValidator = (*CompressionConf)(nil)
+	_ propsValidator = (*EncryptionConf)(nil)
+	_ propsValidator = (*PolicyConf)(nil)
+	_ propsValidator = (*CORSConf)(nil)
+	_ propsValidator = (*ObjectLockConf)(nil)
+	_ propsValidator = (*QuotaConf)(nil)
+	_ propsValidator = (*TieringConf)(nil)
+	_ propsValidator = (*EventsConf)(nil)
+	_ propsValidator = (*VersionConf)(nil)
 )
 
 // interface guard: special (un)marshaling
@@ -1340,6 +1384,14 @@ func (c *Config) Validate() error {
 	if err := c.LocalConfig.TestFSP.Validate(c); err != nil {
 		return err
 	}
+	if c.FailureDomain != "" {
+		if err := cos.CheckAlphaPlus(c.FailureDomain, "failure domain"); err != nil {
+			return err
+		}
+	}
+	if _, _, err := c.LocalConfig.ParseHrwWeight(); err != nil {
+		return err
+	}
 	// a) features vs other features b) features and vs other config
 	if err := c.Features.ValidateAsProps(c.Auth.RequiresProxyMediation()); err != nil {
 		return err
@@ -1450,6 +1502,22 @@ func (c *ClusterConfig) String() string {
 // LocalConfig //
 /////////////////
 
+// returns (auto, weight): (false, 0) when not weighted
+func (c *LocalConfig) ParseHrwWeight() (auto bool, weight uint64, _ error) {
+	switch c.HrwWeight {
+	case "":
+		return false, 0, nil
+	case HrwWeightAuto:
+		return true, 0, nil
+	}
+	w, err := strconv.ParseUint(c.HrwWeight, 10, 64)
+	if err != nil || w == 0 || w > MaxHrwWeight {
+		return false, 0, fmt.Errorf("invalid hrw_weight %q (expecting %q or integer in the range [1, %d])",
+			c.HrwWeight, HrwWeightAuto, MaxHrwWeight)
+	}
+	return false, w, nil
+}
+
 func (c *LocalConfig) TestingEnv() bool {
 	return c.TestFSP.Count > 0
 }
@@ -1925,9 +1993,32 @@ func (c *VersionConf) Validate() error {
 	if !c.Enabled && c.ValidateWarmGet {
 		return errors.New("versioning.validate_warm_get requires versioning to be enabled")
 	}
+	if c.KeepVersions != 0 || c.KeepDays != 0 {
+		return errors.New("versioning.keep_versions and versioning.keep_days are bucket-scope properties")
+	}
 	return nil
 }
 
+// (validating version history only - see `VersionConf.Validate` and Bprops.Validate)
+func (c *VersionConf) ValidateAsProps(...any) error {
+	if c.KeepVersions < 0 || c.KeepDays < 0 {
+		return fmt.Errorf("versioning: invalid keep_versions (%d) or keep_days (%d)", c.KeepVersions, c.KeepDays)
+	}
+	if !c.Enabled && (c.KeepVersions > 0 || c.KeepDays > 0) {
+		return errors.New("versioning: keep_versions and keep_days require versioning to be enabled")
+	}
+	return nil
+}
+
+// custom metadata key of a retained prior version (or delete marker): time (unix nano)
+// the version was superseded, that is, overwritten or deleted
+const VerSupersededObjMD = ReservedObjMD + "superseded"
+
+// KeepHistory returns true when prior object versions are to be retained
+func (c *VersionConf) KeepHistory() bool {
+	return c.Enabled && (c.KeepVersions > 0 || c.KeepDays > 0)
+}
+
 func (c *VersionConf) String() string {
 	if !c.Enabled {
 		return confDisabled
@@ -1939,6 +2030,9 @@ func (c *VersionConf) String() string {
 	} else {
 		text += "no"
 	}
+	if c.KeepHistory() {
+		text += fmt.Sprintf(" | Keep versions: %d, days: %d", c.KeepVersions, c.KeepDays)
+	}
 
 	return text
 }
@@ -2162,8 +2256,8 @@ func (c *WritePolicyConf) Validate() (err error) {
 	}
 	err = c.Data.Validate()
 	if err == nil {
-		if !c.Data.IsImmediate() {
-			return fmt.Errorf("invalid write policy for data: %q not implemented yet", c.Data)
+		if c.Data == apc.WriteNever {
+			return fmt.Errorf("invalid write policy for data: %q not supported", c.Data)
 		}
 		err = c.MD.Validate()
 	}
@@ -3099,6 +3193,7 @@ const (
 	DfltTransportIdleTeardown = 4 * DfltTransportTick // note: request scope
 	DfltTransportQuiesce      = 10 * time.Second
 	DfltTransportLZ4Block     = 256 * cos.KiB
+	DfltTransportZstdLevel    = 1
 )
 
 func (c *TransportConf) Validate() (err error) {
@@ -3114,6 +3209,12 @@ func (c *TransportConf) Validate() (err error) {
 	if c.LZ4BlockMaxSize == 0 {
 		c.LZ4BlockMaxSize = DfltTransportLZ4Block
 	}
+	if c.Codec == "" {
+		c.Codec = apc.LZ4Compression
+	}
+	if c.ZstdLevel == 0 {
+		c.ZstdLevel = DfltTransportZstdLevel
+	}
 	// derived default: use at least 10s and twice the configured teardown interval
 	// (cf. DiskConf.Validate: iostat_time_smooth off iostat_time_long)
 	if c.QuiesceTime == 0 {
@@ -3128,6 +3229,12 @@ func (c *TransportConf) Validate() (err error) {
 		return fmt.Errorf("invalid transport.block_size %s, expecting one of: [64K, 256K, 1MB, 4MB]",
 			c.LZ4BlockMaxSize)
 	}
+	if !apc.IsValidWireCodec(c.Codec) {
+		return fmt.Errorf("invalid transport.codec %q, expecting one of: %v", c.Codec, apc.SupportedWireCodecs)
+	}
+	if c.ZstdLevel < 1 || c.ZstdLevel > 22 {
+		return fmt.Errorf("invalid transport.zstd_level %d, expecting [1, 22] range or 0 (default)", c.ZstdLevel)
+	}
 	// system-wide default; xactions that utilize intra-cluster transport may override
 	// this knob for themselves but only indirectly and only by increasing
 	// their respective (work channel) XactConf.Burst
@@ -0,0 +1,206 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Bucket CORS: a list of rules, each specifying allowed origins, methods,
+// and request headers. Evaluated by the S3 API handlers of both gateways
+// and targets (the latter - upon redirect), that:
+// - respond to preflight (OPTIONS) requests;
+// - add `Access-Control-*` headers to the responses to cross-origin requests.
+// The first matching rule applies.
+//
+// See also:
+// - https://docs.aws.amazon.com/AmazonS3/latest/userguide/cors.html
+
+const (
+	CORSMaxRules  = 100 // (as per S3)
+	corsMaxIDLen  = 255
+	corsMaxMaxAge = 7 * 24 * 3600 // seconds
+	corsWildcard  = "*"
+)
+
+type (
+	CORSRule struct {
+		// Optional rule ID.
+		ID string `json:"id,omitempty"`
+
+		// Origins (e.g. "https://app.example.com") allowed to make cross-origin requests;
+		// each may contain at most one "*" wildcard ("https://*.example.com", "*").
+		AllowedOrigins []string `json:"allowed_origins"`
+
+		// HTTP methods: GET, PUT, POST, DELETE, and/or HEAD.
+		AllowedMethods []string `json:"allowed_methods"`
+
+		// Request headers allowed in preflight requests (Access-Control-Request-Headers);
+		// each may contain at most one "*" wildcard.
+		AllowedHeaders []string `json:"allowed_headers,omitempty"`
+
+		// Response headers the browser is allowed to expose to the application (e.g. "ETag").
+		ExposeHeaders []string `json:"expose_headers,omitempty"`
+
+		// How long (in seconds) the browser may cache preflight response; 0 (zero) - not specified.
+		MaxAgeSeconds int `json:"max_age_seconds,omitempty"`
+	}
+
+	CORSConf struct {
+		// CORS rules; can only be set in their entirety (see CORSConfToSet).
+		Rules []CORSRule `json:"rules,omitempty" list:"readonly"`
+	}
+
+	// CORSConfToSet is the partial-update counterpart of CORSConf.
+	CORSConfToSet struct {
+		// CORS rules. When specified, replaces all existing rules;
+		// an empty list removes them.
+		Rules *[]CORSRule `json:"rules,omitempty" list:"readonly"` // +gen:optional
+	}
+)
+
+//////////////
+// CORSConf //
+//////////////
+
+func (c *CORSConf) String() string {
+	if len(c.Rules) == 0 {
+		return "none"
+	}
+	return fmt.Sprintf("%d rule(s)", len(c.Rules))
+}
+
+func (c *CORSConf) IsActive() bool { return len(c.Rules) > 0 }
+
+func (c *CORSConf) ValidateAsProps(...any) error {
+	if len(c.Rules) > CORSMaxRules {
+		return fmt.Errorf("invalid CORS configuration: number of rules %d exceeds the maximum %d", len(c.Rules), CORSMaxRules)
+	}
+	for i := range c.Rules {
+		if err := c.Rules[i].validate(i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Match returns the first rule that allows a given origin, method, and
+// (preflight) request headers, or nil.
+func (c *CORSConf) Match(origin, method string, headers []string) *CORSRule {
+	for i := range c.Rules {
+		rule := &c.Rules[i]
+		if rule.matchOrigin(origin) && rule.matchMethod(method) && rule.matchHeaders(headers) {
+			return rule
+		}
+	}
+	return nil
+}
+
+//////////////
+// CORSRule //
+//////////////
+
+func (rule *CORSRule) id(idx int) string {
+	if rule.ID != "" {
+		return rule.ID
+	}
+	return fmt.Sprintf("#%d", idx)
+}
+
+func (rule *CORSRule) validate(idx int) error {
+	etag := "invalid CORS rule " + rule.id(idx)
+	if len(rule.ID) > corsMaxIDLen {
+		return fmt.Errorf("%s: ID is too long (max %d)", etag, corsMaxIDLen)
+	}
+	if len(rule.AllowedOrigins) == 0 {
+		return fmt.Errorf("%s: no allowed origins", etag)
+	}
+	for _, origin := range rule.AllowedOrigins {
+		if origin == "" || strings.Count(origin, corsWildcard) > 1 {
+			return fmt.Errorf("%s: invalid origin %q (expecting non-empty value with at most one %q)", etag, origin, corsWildcard)
+		}
+	}
+	if len(rule.AllowedMethods) == 0 {
+		return fmt.Errorf("%s: no allowed methods", etag)
+	}
+	for _, method := range rule.AllowedMethods {
+		switch method {
+		case http.MethodGet, http.MethodPut, http.MethodPost, http.MethodDelete, http.MethodHead:
+		default:
+			return fmt.Errorf("%s: unsupported method %q", etag, method)
+		}
+	}
+	for _, hdr := range rule.AllowedHeaders {
+		if hdr == "" || strings.Count(hdr, corsWildcard) > 1 {
+			return fmt.Errorf("%s: invalid allowed header %q", etag, hdr)
+		}
+	}
+	for _, hdr := range rule.ExposeHeaders {
+		if hdr == "" || strings.Contains(hdr, corsWildcard) {
+			return fmt.Errorf("%s: invalid expose header %q", etag, hdr)
+		}
+	}
+	if rule.MaxAgeSeconds < 0 || rule.MaxAgeSeconds > corsMaxMaxAge {
+		return fmt.Errorf("%s: max-age %d is out of range [0, %d]", etag, rule.MaxAgeSeconds, corsMaxMaxAge)
+	}
+	return nil
+}
+
+// AnyOrigin returns true if the rule allows all origins
+// (in which case the response carries "Access-Control-Allow-Origin: *").
+func (rule *CORSRule) AnyOrigin() bool {
+	for _, origin := range rule.AllowedOrigins {
+		if origin == corsWildcard {
+			return true
+		}
+	}
+	return false
+}
+
+func (rule *CORSRule) matchOrigin(origin string) bool {
+	for _, pattern := range rule.AllowedOrigins {
+		if corsMatch(pattern, origin, false) {
+			return true
+		}
+	}
+	return false
+}
+
+func (rule *CORSRule) matchMethod(method string) bool {
+	for _, m := range rule.AllowedMethods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+func (rule *CORSRule) matchHeaders(headers []string) bool {
+outer:
+	for _, hdr := range headers {
+		for _, pattern := range rule.AllowedHeaders {
+			if corsMatch(pattern, hdr, true) {
+				continue outer
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// match with at most one wildcard
+func corsMatch(pattern, s string, icase bool) bool {
+	if icase {
+		pattern, s = strings.ToLower(pattern), strings.ToLower(s)
+	}
+	prefix, suffix, ok := strings.Cut(pattern, corsWildcard)
+	if !ok {
+		return pattern == s
+	}
+	return len(s) >= len(prefix)+len(suffix) && strings.HasPrefix(s, prefix) && strings.HasSuffix(s, suffix)
+}
@@ -0,0 +1,180 @@
+// Package cos provides common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cos
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Standard 5-field cron expression: "minute hour day-of-month month day-of-week"
+// - each field: "*", a number, a range "a-b", a step "*/n" or "a-b/n", or a comma-separated list thereof
+// - day-of-week: 0 through 7 (both 0 and 7 denote Sunday)
+// - when both day-of-month and day-of-week are restricted, either one matching will do (as in Vixie cron)
+// - macros: @hourly, @daily (@midnight), @weekly, @monthly, @yearly (@annually)
+// - no seconds, no names (such as "MON" or "JAN")
+
+type Cron struct {
+	spec   string
+	min    uint64 // bitmask: 0..59
+	hour   uint64 // 0..23
+	dom    uint64 // 1..31
+	month  uint64 // 1..12
+	dow    uint64 // 0..6
+	domAny bool
+	dowAny bool
+}
+
+var cronMacros = map[string]string{
+	"@hourly":   "0 * * * *",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@weekly":   "0 0 * * 0",
+	"@monthly":  "0 0 1 * *",
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+}
+
+// the search for the next matching time gives up after this many years
+const cronMaxYears = 5
+
+func ParseCron(spec string) (*Cron, error) {
+	c := &Cron{spec: strings.TrimSpace(spec)}
+	s := c.spec
+	if strings.HasPrefix(s, "@") {
+		m, ok := cronMacros[s]
+		if !ok {
+			return nil, fmt.Errorf("invalid cron expression %q: unknown macro", spec)
+		}
+		s = m
+	}
+	fields := strings.Fields(s)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid cron expression %q: expecting 5 fields (minute hour day-of-month month day-of-week), got %d",
+			spec, len(fields))
+	}
+	var err error
+	if c.min, err = cronField(fields[0], 0, 59); err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: minute: %w", spec, err)
+	}
+	if c.hour, err = cronField(fields[1], 0, 23); err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: hour: %w", spec, err)
+	}
+	if c.dom, err = cronField(fields[2], 1, 31); err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: day-of-month: %w", spec, err)
+	}
+	if c.month, err = cronField(fields[3], 1, 12); err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: month: %w", spec, err)
+	}
+	if c.dow, err = cronField(fields[4], 0, 7); err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: day-of-week: %w", spec, err)
+	}
+	if c.dow&(1<<7) != 0 {
+		c.dow = (c.dow | 1) &^ (1 << 7) // 7 => Sunday
+	}
+	c.domAny = fields[2] == "*"
+	c.dowAny = fields[4] == "*"
+	return c, nil
+}
+
+func cronField(field string, lo, hi int) (mask uint64, _ error) {
+	for part := range strings.SplitSeq(field, ",") {
+		var (
+			rng  = part
+			step = 1
+		)
+		if i := strings.IndexByte(part, '/'); i >= 0 {
+			n, err := strconv.Atoi(part[i+1:])
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid step in %q", part)
+			}
+			rng, step = part[:i], n
+		}
+		var from, to int
+		switch {
+		case rng == "*":
+			from, to = lo, hi
+		case strings.IndexByte(rng, '-') > 0:
+			i := strings.IndexByte(rng, '-')
+			a, err1 := strconv.Atoi(rng[:i])
+			b, err2 := strconv.Atoi(rng[i+1:])
+			if err1 != nil || err2 != nil || a > b {
+				return 0, fmt.Errorf("invalid range %q", rng)
+			}
+			from, to = a, b
+		default:
+			n, err := strconv.Atoi(rng)
+			if err != nil {
+				return 0, fmt.Errorf("invalid value %q", rng)
+			}
+			from, to = n, n
+			if step > 1 {
+				to = hi // e.g. "5/15" means "5-59/15"
+			}
+		}
+		if from < lo || to > hi {
+			return 0, fmt.Errorf("%q is out of range [%d, %d]", rng, lo, hi)
+		}
+		for v := from; v <= to; v += step {
+			mask |= 1 << uint(v)
+		}
+	}
+	if mask == 0 {
+		return 0, errors.New("empty field")
+	}
+	return mask, nil
+}
+
+func (c *Cron) String() string { return c.spec }
+
+func (c *Cron) dayMatches(t time.Time) bool {
+	var (
+		domOK = c.dom&(1<<uint(t.Day())) != 0
+		dowOK = c.dow&(1<<uint(t.Weekday())) != 0
+	)
+	switch {
+	case c.domAny && c.dowAny:
+		return true
+	case c.domAny:
+		return dowOK
+	case c.dowAny:
+		return domOK
+	default:
+		return domOK || dowOK
+	}
+}
+
+// Next returns the earliest matching time (in after's location) that is strictly after `after`;
+// zero time if there's none within the next few years (e.g., "0 0 30 2 *")
+func (c *Cron) Next(after time.Time) time.Time {
+	var (
+		t     = after.Truncate(time.Minute).Add(time.Minute)
+		limit = after.Year() + cronMaxYears
+		loc   = after.Location()
+	)
+	for t.Year() <= limit {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !c.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+		if c.min&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
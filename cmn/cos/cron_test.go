@@ -0,0 +1,74 @@
+// Package cos_test: unit tests
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cos_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+func TestParseCron(t *testing.T) {
+	valid := []string{
+		"* * * * *", "0 2 * * *", "*/15 * * * *", "0 0 * * 0", "0 0 * * 7",
+		"30 4 1,15 * 1-5", "0 9-17/2 * * *", "5/10 * * * *", "@daily", "@hourly", "  @weekly ",
+	}
+	for _, spec := range valid {
+		if _, err := cos.ParseCron(spec); err != nil {
+			t.Errorf("%q: unexpected error: %v", spec, err)
+		}
+	}
+	invalid := []string{
+		"", "* * * *", "* * * * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *",
+		"* * * * 8", "*/0 * * * *", "5-1 * * * *", "a * * * *", "1,,2 * * * *", "@often",
+	}
+	for _, spec := range invalid {
+		if _, err := cos.ParseCron(spec); err == nil {
+			t.Errorf("%q: expected error", spec)
+		}
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	base := time.Date(2026, time.March, 14, 10, 17, 42, 0, time.UTC) // Saturday
+	tests := []struct {
+		spec string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2026, time.March, 14, 10, 18, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2026, time.March, 14, 10, 30, 0, 0, time.UTC)},
+		{"17 * * * *", time.Date(2026, time.March, 14, 11, 17, 0, 0, time.UTC)},
+		{"0 2 * * *", time.Date(2026, time.March, 15, 2, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2026, time.March, 14, 11, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2026, time.March, 15, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2026, time.March, 15, 0, 0, 0, 0, time.UTC)},
+		{"30 4 * * 1-5", time.Date(2026, time.March, 16, 4, 30, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 1 *", time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 31 * *", time.Date(2026, time.March, 31, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		// either day-of-month or day-of-week
+		{"0 12 20 * 1", time.Date(2026, time.March, 16, 12, 0, 0, 0, time.UTC)},
+		// never
+		{"0 0 30 2 *", time.Time{}},
+	}
+	for _, test := range tests {
+		c, err := cos.ParseCron(test.spec)
+		if err != nil {
+			t.Fatalf("%q: %v", test.spec, err)
+		}
+		if got := c.Next(base); !got.Equal(test.want) {
+			t.Errorf("%q: next after %v: got %v, want %v", test.spec, base, got, test.want)
+		}
+	}
+
+	// strictly after
+	c, _ := cos.ParseCron("0 * * * *")
+	at := time.Date(2026, time.March, 14, 10, 0, 0, 0, time.UTC)
+	if got := c.Next(at); !got.Equal(at.Add(time.Hour)) {
+		t.Errorf("expected %v, got %v", at.Add(time.Hour), got)
+	}
+}
@@ -56,6 +56,25 @@ const (
 	// RFC1123GMT or, same, http.TimeFormat ("Mon, 02 Jan 2006 15:04:05 GMT")
 	// see also, and separately, cmn.LsoLastModified (list-objects)
 	HdrLastModified = "Last-Modified"
+
+	// conditional requests (see cmn.Precond)
+	HdrIfMatch           = "If-Match"
+	HdrIfNoneMatch       = "If-None-Match"
+	HdrIfModifiedSince   = "If-Modified-Since"
+	HdrIfUnmodifiedSince = "If-Unmodified-Since"
+
+	// CORS (see cmn.CORSConf)
+	// Ref: https://fetch.spec.whatwg.org/#http-cors-protocol
+	HdrOrigin             = "Origin"
+	HdrVary               = "Vary"
+	HdrACRequestMethod    = "Access-Control-Request-Method"
+	HdrACRequestHeaders   = "Access-Control-Request-Headers"
+	HdrACAllowOrigin      = "Access-Control-Allow-Origin"
+	HdrACAllowMethods     = "Access-Control-Allow-Methods"
+	HdrACAllowHeaders     = "Access-Control-Allow-Headers"
+	HdrACAllowCredentials = "Access-Control-Allow-Credentials"
+	HdrACExposeHeaders    = "Access-Control-Expose-Headers"
+	HdrACMaxAge           = "Access-Control-Max-Age"
 )
 
 //
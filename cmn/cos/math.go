@@ -4,7 +4,11 @@
  */
 package cos
 
-import "github.com/NVIDIA/aistore/cmn/debug"
+import (
+	"math"
+
+	"github.com/NVIDIA/aistore/cmn/debug"
+)
 
 func DivCeil(a, b int64) int64 {
 	d, r := a/b, a%b
@@ -47,3 +51,13 @@ func ClampInt(i, mini, maxi int) int {
 	}
 	return min(i, maxi)
 }
+
+// Weighted rendezvous hashing (aka weighted HRW): given a uniformly distributed hash
+// and a (positive) weight, returns the score "w / -ln(u)", where u in (0, 1) is the hash
+// normalized. Each node then wins (ie., gets selected) in proportion to its weight.
+// The score is returned as IEEE 754 bits - for positive floats, an order-preserving
+// mapping - to be compared the same way unweighted HRW compares hashes.
+func HrwWeighted(h, weight uint64) uint64 {
+	u := (float64(h>>11) + 0.5) / (1 << 53)
+	return math.Float64bits(float64(weight) / -math.Log(u))
+}
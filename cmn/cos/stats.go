@@ -14,6 +14,21 @@ const (
 	StreamsInObjSize   = "stream.in.size"
 )
 
+// intra-cluster compression, per codec (transmit side):
+// - ".size": uncompressed bytes passed to the codec
+// - ".cmpr.size": resulting bytes on the wire (the ratio is ".size" / ".cmpr.size")
+// - ".ns.total": cumulative compression time
+// compression "auto" mode may skip incompressible payloads - see StreamsOutSkipSize
+const (
+	StreamsOutLZ4Size      = "stream.out.lz4.size"
+	StreamsOutLZ4CmprSize  = "stream.out.lz4.cmpr.size"
+	StreamsOutLZ4Total     = "stream.out.lz4.ns.total"
+	StreamsOutZstdSize     = "stream.out.zstd.size"
+	StreamsOutZstdCmprSize = "stream.out.zstd.cmpr.size"
+	StreamsOutZstdTotal    = "stream.out.zstd.ns.total"
+	StreamsOutSkipSize     = "stream.out.skip.size"
+)
+
 type (
 	StatsUpdater interface {
 		Inc(name string)
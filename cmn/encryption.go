@@ -0,0 +1,49 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+)
+
+// At-rest encryption: when enabled, objects, chunks, and EC slices get encrypted
+// upon write and transparently decrypted on read.
+//
+// Upon enabling, AIS generates the bucket's data key and stores it wrapped
+// by the cluster's master key (see cmn/kms); the (wrapped) key is immutable
+// for the lifetime of the bucket. Disabling encryption affects only new writes -
+// existing objects remain encrypted and readable.
+
+type (
+	EncryptionConf struct {
+		Key     string `json:"key,omitempty"`    // wrapped data key (assigned by AIS)
+		KekID   string `json:"kek_id,omitempty"` // ID of the master key that wraps it
+		Enabled bool   `json:"enabled"`          // encrypt new writes
+	}
+
+	// EncryptionConfToSet is the partial-update counterpart of EncryptionConf.
+	EncryptionConfToSet struct {
+		// Encrypt new writes; data key is generated upon first enablement.
+		Enabled *bool `json:"enabled,omitempty"` // +gen:optional
+	}
+)
+
+func (c *EncryptionConf) ValidateAsProps(...any) error {
+	if c.Key != "" && c.KekID == "" {
+		return errors.New("invalid encryption config: data key without master key ID")
+	}
+	return nil
+}
+
+func (c *EncryptionConf) String() string {
+	if !c.Enabled {
+		return confDisabled
+	}
+	return "Enabled"
+}
+
+// whether objects of the two buckets can be copied as is (see also: lom.Copy2FQN)
+func (c *EncryptionConf) SameKey(other *EncryptionConf) bool { return c.Key == other.Key }
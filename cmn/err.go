@@ -116,6 +116,9 @@ type (
 	ErrRemoteMetadataMismatch struct {
 		cause error
 	}
+	ErrPrecondFailed struct {
+		hdr string // failed precondition (HTTP header)
+	}
 
 	ErrCapExceeded struct {
 		totalBytes     uint64
@@ -511,6 +514,20 @@ func (e *ErrRemoteMetadataMismatch) Error() string {
 	return fmt.Sprintf("metadata mismatch: %v", e.cause)
 }
 
+// ErrPrecondFailed
+
+func (e *ErrPrecondFailed) Error() string {
+	return "precondition failed: " + e.hdr
+}
+
+func IsErrPrecondFailed(err error) bool {
+	if _, ok := err.(*ErrPrecondFailed); ok {
+		return true
+	}
+	var e *ErrPrecondFailed
+	return errors.As(err, &e)
+}
+
 // ErrBusy
 
 func NewErrBusy(whereOrType, what string, detail ...string) *ErrBusy {
@@ -965,18 +982,27 @@ func NewErrLimitedCoexistence(node, xaction, action, detail string) *ErrLimitedC
 	return &ErrLimitedCoexistence{node, xaction, action, detail}
 }
 
+const limcoRunning = " is currently running, cannot run "
+
 func (e *ErrLimitedCoexistence) Error() string {
-	return fmt.Sprintf("%s: %s is currently running, cannot run %q(%s) concurrently",
+	return fmt.Sprintf("%s: %s"+limcoRunning+"%q(%s) concurrently",
 		e.node, e.xaction, e.action, e.detail)
 }
 
-func isErrLimitedCoexistence(err error) bool {
+// including the one received from another node (as ErrHTTP), possibly wrapped
+func IsErrLimitedCoexistence(err error) bool {
 	debug.Assert(err != nil)
 	if _, ok := err.(*ErrLimitedCoexistence); ok {
 		return true
 	}
 	var wrapped *ErrLimitedCoexistence
-	return errors.As(err, &wrapped)
+	if errors.As(err, &wrapped) {
+		return true
+	}
+	if herr := AsErrHTTP(err); herr != nil {
+		return herr.TypeCode == "ErrLimitedCoexistence"
+	}
+	return false
 }
 
 // ErrXactUsePrev
@@ -1230,6 +1256,11 @@ func (e *ErrHTTP) init(r *http.Request, err error, ecode int) {
 			e.TypeCode = tcode[i+1:]
 		}
 	}
+	// (wrapped) limited coexistence: the receiver retries upon it
+	var limco *ErrLimitedCoexistence
+	if errors.As(err, &limco) {
+		e.TypeCode = "ErrLimitedCoexistence"
+	}
 	_clean(err)
 	e.Message = err.Error()
 	if r != nil {
@@ -1414,10 +1445,12 @@ func WriteErr(w http.ResponseWriter, r *http.Request, err error, opts ...int /*[
 			status = http.StatusRequestedRangeNotSatisfiable
 		case isErrUnsupp(err), isErrNotImpl(err):
 			status = http.StatusNotImplemented
-		case IsErrBusy(err) || isErrLimitedCoexistence(err):
+		case IsErrBusy(err) || IsErrLimitedCoexistence(err):
 			status = http.StatusConflict
 		case IsErrTooManyRequests(err):
 			status = http.StatusTooManyRequests
+		case IsErrPrecondFailed(err):
+			status = http.StatusPreconditionFailed
 		}
 	}
 
@@ -0,0 +1,194 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Bucket event notifications: a list of rules, each selecting event types
+// and (optionally) object names by prefix and/or suffix, and specifying
+// the destination: HTTP(S) webhook or named on-disk queue.
+// Events are generated by targets upon object create, delete, and rename;
+// the format is the S3 event message structure (see ais/s3/events).
+// Each matching rule gets its own copy of the event.
+//
+// See also:
+// - https://docs.aws.amazon.com/AmazonS3/latest/userguide/notification-content-structure.html
+
+// event types (with "s3:" prefix, as in S3 notification configuration)
+const (
+	EventObjCreated        = "s3:ObjectCreated:*"
+	EventObjCreatedPut     = "s3:ObjectCreated:Put"
+	EventObjCreatedCopy    = "s3:ObjectCreated:Copy"
+	EventObjCreatedMpt     = "s3:ObjectCreated:CompleteMultipartUpload"
+	EventObjCreatedPromote = "s3:ObjectCreated:Promote" // (AIS)
+	EventObjCreatedRename  = "s3:ObjectCreated:Rename"  // (AIS) new name
+	EventObjRemoved        = "s3:ObjectRemoved:*"
+	EventObjRemovedDelete  = "s3:ObjectRemoved:Delete"
+	EventObjRemovedRename  = "s3:ObjectRemoved:Rename" // (AIS) old name
+)
+
+const (
+	EventsMaxRules       = 100
+	DfltEventsMaxBacklog = 10_000 // pending (undelivered or unconsumed) events, per destination
+
+	eventWildcard           = "*"
+	eventsMaxIDLen          = 255
+	eventsMaxWebhookURLLen  = 2048
+	eventsMaxQueueNameLen   = 64
+	eventsMaxBacklogCeiling = 10_000_000
+)
+
+var supportedEvents = []string{
+	EventObjCreated, EventObjCreatedPut, EventObjCreatedCopy, EventObjCreatedMpt, EventObjCreatedPromote, EventObjCreatedRename,
+	EventObjRemoved, EventObjRemovedDelete, EventObjRemovedRename,
+}
+
+type (
+	EventRule struct {
+		// Optional rule ID (S3: configuration ID).
+		ID string `json:"id,omitempty"`
+
+		// Event types, e.g. "s3:ObjectCreated:*", "s3:ObjectRemoved:Delete".
+		Events []string `json:"events"`
+
+		// Object name filters (both optional).
+		Prefix string `json:"prefix,omitempty"`
+		Suffix string `json:"suffix,omitempty"`
+
+		// Destination: exactly one of the two.
+		// Webhook - HTTP(S) URL to POST events to;
+		// Queue - name of the target-local on-disk queue (directory) that external
+		// consumers read (and remove) events from.
+		Webhook string `json:"webhook,omitempty"`
+		Queue   string `json:"queue,omitempty"`
+
+		// Maximum number of pending events per target, after which the oldest get dropped;
+		// 0 (zero) - use default (DfltEventsMaxBacklog).
+		MaxBacklog int64 `json:"max_backlog,omitempty"`
+	}
+
+	EventsConf struct {
+		// Event notification rules; can only be set in their entirety (see EventsConfToSet).
+		Rules []EventRule `json:"rules,omitempty" list:"readonly"`
+	}
+
+	// EventsConfToSet is the partial-update counterpart of EventsConf.
+	EventsConfToSet struct {
+		// Event notification rules. When specified, replaces all existing rules;
+		// an empty list removes them.
+		Rules *[]EventRule `json:"rules,omitempty" list:"readonly"` // +gen:optional
+	}
+)
+
+////////////////
+// EventsConf //
+////////////////
+
+func (c *EventsConf) String() string {
+	if len(c.Rules) == 0 {
+		return "none"
+	}
+	return fmt.Sprintf("%d rule(s)", len(c.Rules))
+}
+
+func (c *EventsConf) IsActive() bool { return len(c.Rules) > 0 }
+
+func (c *EventsConf) ValidateAsProps(...any) error {
+	if len(c.Rules) > EventsMaxRules {
+		return fmt.Errorf("invalid event notification rules: number of rules %d exceeds the maximum %d", len(c.Rules), EventsMaxRules)
+	}
+	for i := range c.Rules {
+		if err := c.Rules[i].validate(i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+///////////////
+// EventRule //
+///////////////
+
+func (rule *EventRule) id(idx int) string {
+	if rule.ID != "" {
+		return rule.ID
+	}
+	return fmt.Sprintf("#%d", idx)
+}
+
+func (rule *EventRule) validate(idx int) error {
+	etag := "invalid event notification rule " + rule.id(idx)
+	if len(rule.ID) > eventsMaxIDLen {
+		return fmt.Errorf("%s: ID is too long (max %d)", etag, eventsMaxIDLen)
+	}
+	if len(rule.Events) == 0 {
+		return fmt.Errorf("%s: no event types", etag)
+	}
+	for _, ev := range rule.Events {
+		if !slices.Contains(supportedEvents, ev) {
+			return fmt.Errorf("%s: unsupported event type %q (expecting one of: %s)", etag, ev, strings.Join(supportedEvents, ", "))
+		}
+	}
+	switch {
+	case rule.Webhook != "" && rule.Queue != "":
+		return fmt.Errorf("%s: webhook and queue are mutually exclusive", etag)
+	case rule.Webhook != "":
+		if len(rule.Webhook) > eventsMaxWebhookURLLen {
+			return fmt.Errorf("%s: webhook URL is too long (max %d)", etag, eventsMaxWebhookURLLen)
+		}
+		u, err := url.Parse(rule.Webhook)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%s: invalid webhook URL %q (expecting http(s)://host[:port]/...)", etag, rule.Webhook)
+		}
+	case rule.Queue != "":
+		if len(rule.Queue) > eventsMaxQueueNameLen {
+			return fmt.Errorf("%s: queue name is too long (max %d)", etag, eventsMaxQueueNameLen)
+		}
+		if err := cos.CheckAlphaPlus(rule.Queue, "queue name"); err != nil {
+			return fmt.Errorf("%s: %v", etag, err)
+		}
+	default:
+		return fmt.Errorf("%s: no destination (expecting webhook or queue)", etag)
+	}
+	if rule.MaxBacklog < 0 || rule.MaxBacklog > eventsMaxBacklogCeiling {
+		return fmt.Errorf("%s: max backlog %d is out of range [0, %d]", etag, rule.MaxBacklog, eventsMaxBacklogCeiling)
+	}
+	return nil
+}
+
+// Match returns true if the rule selects a given event (e.g. EventObjCreatedPut) and object name
+func (rule *EventRule) Match(event, objName string) bool {
+	if !strings.HasPrefix(objName, rule.Prefix) || !strings.HasSuffix(objName, rule.Suffix) {
+		return false
+	}
+	for _, ev := range rule.Events {
+		if ev == event {
+			return true
+		}
+		if base, ok := strings.CutSuffix(ev, eventWildcard); ok && strings.HasPrefix(event, base) {
+			return true
+		}
+	}
+	return false
+}
+
+func IsObjCreatedEvent(event string) bool {
+	return strings.HasPrefix(event, strings.TrimSuffix(EventObjCreated, eventWildcard))
+}
+
+func (rule *EventRule) Backlog() int64 {
+	if rule.MaxBacklog > 0 {
+		return rule.MaxBacklog
+	}
+	return DfltEventsMaxBacklog
+}
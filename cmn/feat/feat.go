@@ -54,6 +54,7 @@ const (
 	EnableGoRuntimeMetrics    // publish selected Go runtime metrics via Prometheus
 	DloadAllowPrivateEgress   // allow downloader egress to private RFC1918/ULA addresses; loopback and link-local remain blocked
 	S3RedirectRebuild         // allow S3 clients that rebuild redirected requests instead of following the Location URI (forbidden when AuthN or intra-cluster signing is configured)
+	EnableLocalETL            // outside Kubernetes: run ETL servers as local processes that execute user-provided `runtime.command` on target hosts
 )
 
 var Cluster = [...]string{
@@ -85,6 +86,7 @@ var Cluster = [...]string{
 	"Enable-Go-Runtime-Metrics",
 	"Dload-Allow-Private-Egress",
 	"S3-Redirect-Rebuild",
+	"Enable-Local-ETL",
 
 	// apc.ResetToken ("none") ===========
 }
@@ -33,6 +33,9 @@ const (
 	Vmd         = ".ais.vmd"    // vmd persistent file basename
 	Emd         = ".ais.emd"    // emd persistent file basename
 
+	// bucket event notifications: pending events (target; in the config directory)
+	EventsDir = ".ais.events"
+
 	// CLI config
 	CliConfig = "cli.json" // see jsp/app.go
 
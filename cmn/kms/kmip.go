@@ -0,0 +1,163 @@
+// Package kms provides master key management for at-rest encryption.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package kms
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/env"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// KMIP-compatible local keystore: a stand-in for an external KMIP server that
+// uses the same object model, for instance:
+//
+// {
+//   "objects": [
+//     {
+//       "unique_identifier": "6a9b3c1e-01",
+//       "object_type": "SymmetricKey",
+//       "cryptographic_algorithm": "AES",
+//       "cryptographic_length": 256,
+//       "state": "Active",
+//       "key_material": "<hex>"
+//     }
+//   ]
+// }
+//
+// - new data keys are wrapped by the current key: AIS_KMS_KEY_ID or, if not
+//   specified, the one and only key in the Active state;
+// - existing data keys can be unwrapped by keys in Active, Deactivated, or Compromised
+//   states (in KMIP terms, the latter two are still usable to "process" protected data);
+// - the keystore is re-read on every request, to pick up rotation without restart
+//   (compare with kms.Unwrap that caches unwrapped data keys).
+
+const (
+	kmipSymmetricKey = "SymmetricKey"
+	kmipAES          = "AES"
+
+	kmipActive      = "Active"
+	kmipDeactivated = "Deactivated"
+	kmipCompromised = "Compromised"
+)
+
+type (
+	KmipObject struct {
+		UID         string `json:"unique_identifier"`
+		ObjectType  string `json:"object_type"`
+		Algorithm   string `json:"cryptographic_algorithm"`
+		State       string `json:"state"`
+		KeyMaterial string `json:"key_material"` // hex
+		Length      int    `json:"cryptographic_length"`
+	}
+	KmipKeystore struct {
+		Objects []KmipObject `json:"objects"`
+	}
+
+	keystore struct {
+		fqn   string
+		keyID string
+	}
+)
+
+func newKeystore(fqn, keyID string) (*keystore, error) {
+	if fqn == "" {
+		return nil, fmt.Errorf("%s provider: %s is empty", ProviderKMIP, env.AisKMSKeystore)
+	}
+	ks := &keystore{fqn: fqn, keyID: keyID}
+	_, _, err := ks.Current() // validate
+	return ks, err
+}
+
+func (*keystore) Name() string { return ProviderKMIP }
+
+func (ks *keystore) Current() (string, []byte, error) {
+	store, err := ks.load()
+	if err != nil {
+		return "", nil, err
+	}
+	var obj *KmipObject
+	if ks.keyID != "" {
+		if obj = store.find(ks.keyID); obj == nil {
+			return "", nil, fmt.Errorf("%s: %w (id %q)", ks, ErrKeyNotFound, ks.keyID)
+		}
+		if obj.State != kmipActive {
+			return "", nil, fmt.Errorf("%s: key %q is not active (state %q)", ks, ks.keyID, obj.State)
+		}
+	} else {
+		for i := range store.Objects {
+			if o := &store.Objects[i]; o.State == kmipActive {
+				if obj != nil {
+					return "", nil, fmt.Errorf("%s: multiple active keys - %s must be specified", ks, env.AisKMSKeyID)
+				}
+				obj = o
+			}
+		}
+		if obj == nil {
+			return "", nil, fmt.Errorf("%s: no active keys", ks)
+		}
+	}
+	key, err := obj.key()
+	if err != nil {
+		return "", nil, fmt.Errorf("%s: %w", ks, err)
+	}
+	return obj.UID, key, nil
+}
+
+func (ks *keystore) Get(kekID string) ([]byte, error) {
+	store, err := ks.load()
+	if err != nil {
+		return nil, err
+	}
+	obj := store.find(kekID)
+	if obj == nil {
+		return nil, ErrKeyNotFound
+	}
+	switch obj.State {
+	case kmipActive, kmipDeactivated, kmipCompromised:
+		return obj.key()
+	default:
+		return nil, fmt.Errorf("key %q cannot be used (state %q)", kekID, obj.State)
+	}
+}
+
+func (ks *keystore) load() (*KmipKeystore, error) {
+	b, err := os.ReadFile(ks.fqn)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", ks, err)
+	}
+	store := &KmipKeystore{}
+	if err := jsoniter.Unmarshal(b, store); err != nil {
+		return nil, fmt.Errorf("%s: invalid keystore: %w", ks, err)
+	}
+	return store, nil
+}
+
+func (ks *keystore) String() string { return ProviderKMIP + "[" + ks.fqn + "]" }
+
+func (store *KmipKeystore) find(uid string) *KmipObject {
+	for i := range store.Objects {
+		if store.Objects[i].UID == uid {
+			return &store.Objects[i]
+		}
+	}
+	return nil
+}
+
+func (obj *KmipObject) key() ([]byte, error) {
+	if obj.ObjectType != kmipSymmetricKey || !strings.EqualFold(obj.Algorithm, kmipAES) || obj.Length != KeySize*8 {
+		return nil, fmt.Errorf("key %q: expecting %s %s-%d (have %s %s-%d)", obj.UID,
+			kmipSymmetricKey, kmipAES, KeySize*8, obj.ObjectType, obj.Algorithm, obj.Length)
+	}
+	key, err := hex.DecodeString(obj.KeyMaterial)
+	if err != nil || len(key) != KeySize {
+		return nil, errors.New("key " + obj.UID + ": invalid key material")
+	}
+	return key, nil
+}
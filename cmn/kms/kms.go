@@ -0,0 +1,259 @@
+// Package kms provides master key management for at-rest encryption.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package kms
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/env"
+)
+
+// Envelope encryption:
+// - each encrypted bucket has its own randomly generated data key (DEK);
+// - the DEK is wrapped (AES-256-GCM) by the master key a.k.a. key-encryption key (KEK);
+// - wrapped DEK and the KEK's ID are stored in the bucket's properties (and BMD);
+// - the KEK itself never leaves the provider; nodes unwrap DEKs on demand and cache them in memory.
+//
+// Providers (configured via environment - see api/env/kms.go):
+// - "env":  the key is the value of the environment variable;
+// - "file": the key is stored in a file (e.g., a mounted secret);
+// - "kmip": KMIP-compatible local keystore: symmetric key objects identified by
+//           unique identifiers and subject to KMIP lifecycle states (see kmip.go).
+
+const (
+	ProviderEnv  = "env"
+	ProviderFile = "file"
+	ProviderKMIP = "kmip"
+)
+
+const KeySize = 32 // AES-256
+
+type (
+	Provider interface {
+		Name() string
+		// master key to wrap new data keys, and its ID
+		Current() (kekID string, kek []byte, err error)
+		// master key by ID (to unwrap existing data keys)
+		Get(kekID string) ([]byte, error)
+	}
+
+	// single static key (env and file providers)
+	static struct {
+		name string
+		id   string
+		key  []byte
+	}
+)
+
+var (
+	ErrNotConfigured = errors.New("master key provider is not configured (see " + env.AisKMSProvider + " and related)")
+	ErrKeyNotFound   = errors.New("master key not found")
+)
+
+var (
+	mu   sync.Mutex
+	prov Provider
+	deks = make(map[string][]byte, 4) // wrapped => unwrapped
+)
+
+// Get returns the provider configured via environment, initializing it upon first call
+func Get() (Provider, error) {
+	mu.Lock()
+	defer mu.Unlock()
+	if prov != nil {
+		return prov, nil
+	}
+	p, err := FromEnv()
+	if err == nil {
+		prov = p
+	}
+	return p, err
+}
+
+// FromEnv constructs a new provider from environment variables (no caching)
+func FromEnv() (Provider, error) {
+	name := os.Getenv(env.AisKMSProvider)
+	if name == "" {
+		switch {
+		case os.Getenv(env.AisKMSMasterKey) != "":
+			name = ProviderEnv
+		case os.Getenv(env.AisKMSKeyFile) != "":
+			name = ProviderFile
+		case os.Getenv(env.AisKMSKeystore) != "":
+			name = ProviderKMIP
+		default:
+			return nil, ErrNotConfigured
+		}
+	}
+	switch name {
+	case ProviderEnv:
+		v := os.Getenv(env.AisKMSMasterKey)
+		if v == "" {
+			return nil, fmt.Errorf("%s provider: %s is empty", name, env.AisKMSMasterKey)
+		}
+		key, err := ParseKey([]byte(v))
+		if err != nil {
+			return nil, fmt.Errorf("%s provider: %s: %w", name, env.AisKMSMasterKey, err)
+		}
+		return newStatic(name, key), nil
+	case ProviderFile:
+		fqn := os.Getenv(env.AisKMSKeyFile)
+		if fqn == "" {
+			return nil, fmt.Errorf("%s provider: %s is empty", name, env.AisKMSKeyFile)
+		}
+		b, err := os.ReadFile(fqn)
+		if err != nil {
+			return nil, fmt.Errorf("%s provider: %w", name, err)
+		}
+		key, err := ParseKey(b)
+		if err != nil {
+			return nil, fmt.Errorf("%s provider: %q: %w", name, fqn, err)
+		}
+		return newStatic(name, key), nil
+	case ProviderKMIP:
+		return newKeystore(os.Getenv(env.AisKMSKeystore), os.Getenv(env.AisKMSKeyID))
+	default:
+		return nil, fmt.Errorf("invalid %s %q (expecting one of: %q, %q, %q)", env.AisKMSProvider, name,
+			ProviderEnv, ProviderFile, ProviderKMIP)
+	}
+}
+
+// ParseKey accepts 256-bit key as raw bytes, hex, or base64 (surrounding whitespace is ignored)
+func ParseKey(b []byte) ([]byte, error) {
+	if len(b) == KeySize {
+		return b, nil
+	}
+	s := strings.TrimSpace(string(b))
+	if len(s) == 2*KeySize {
+		if key, err := hex.DecodeString(s); err == nil {
+			return key, nil
+		}
+	}
+	if key, err := base64.StdEncoding.DecodeString(s); err == nil && len(key) == KeySize {
+		return key, nil
+	}
+	return nil, fmt.Errorf("expecting %d-byte key (raw, hex, or base64 encoded)", KeySize)
+}
+
+//
+// data keys
+//
+
+// NewDataKey generates a new random data key and returns it wrapped by the current master key
+func NewDataKey() (kekID, wrapped string, _ error) {
+	p, err := Get()
+	if err != nil {
+		return "", "", err
+	}
+	kekID, kek, err := p.Current()
+	if err != nil {
+		return "", "", err
+	}
+	dek := make([]byte, KeySize)
+	if _, err := rand.Read(dek); err != nil {
+		return "", "", err
+	}
+	wrapped, err = wrap(kek, kekID, dek)
+	return kekID, wrapped, err
+}
+
+// Unwrap returns the data key given its wrapped representation and the ID of the wrapping master key
+func Unwrap(kekID, wrapped string) ([]byte, error) {
+	mu.Lock()
+	dek, ok := deks[wrapped]
+	mu.Unlock()
+	if ok {
+		return dek, nil
+	}
+	p, err := Get()
+	if err != nil {
+		return nil, err
+	}
+	kek, err := p.Get(kekID)
+	if err != nil {
+		return nil, fmt.Errorf("%s provider: %w (id %q)", p.Name(), err, kekID)
+	}
+	if dek, err = unwrap(kek, kekID, wrapped); err != nil {
+		return nil, err
+	}
+	mu.Lock()
+	deks[wrapped] = dek
+	mu.Unlock()
+	return dek, nil
+}
+
+func wrap(kek []byte, kekID string, dek []byte) (string, error) {
+	gcm, err := newGCM(kek)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize(), gcm.NonceSize()+len(dek)+gcm.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	b := gcm.Seal(nonce, nonce, dek, aad(kekID))
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+func unwrap(kek []byte, kekID, wrapped string) ([]byte, error) {
+	b, err := base64.StdEncoding.DecodeString(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("invalid wrapped data key: %w", err)
+	}
+	gcm, err := newGCM(kek)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != gcm.NonceSize()+KeySize+gcm.Overhead() {
+		return nil, fmt.Errorf("invalid wrapped data key: unexpected length %d", len(b))
+	}
+	dek, err := gcm.Open(nil, b[:gcm.NonceSize()], b[gcm.NonceSize():], aad(kekID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key (wrong master key?): %w", err)
+	}
+	return dek, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func aad(kekID string) []byte { return []byte("ais-kms-v1:" + kekID) }
+
+////////////
+// static //
+////////////
+
+func newStatic(name string, key []byte) *static {
+	// (key ID: fingerprint that does not reveal the key)
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("ais-kms-key-id"))
+	return &static{name: name, id: hex.EncodeToString(mac.Sum(nil)[:8]), key: key}
+}
+
+func (s *static) Name() string                     { return s.name }
+func (s *static) Current() (string, []byte, error) { return s.id, s.key, nil }
+
+func (s *static) Get(kekID string) ([]byte, error) {
+	if kekID != s.id {
+		return nil, ErrKeyNotFound
+	}
+	return s.key, nil
+}
@@ -0,0 +1,139 @@
+// Package kms provides master key management for at-rest encryption.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package kms
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/env"
+	"github.com/NVIDIA/aistore/tools/tassert"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+func testKey(b byte) []byte { return bytes.Repeat([]byte{b}, KeySize) }
+
+func TestParseKey(t *testing.T) {
+	key := testKey(7)
+	for _, in := range [][]byte{key, []byte(hex.EncodeToString(key)), []byte(base64.StdEncoding.EncodeToString(key) + "\n")} {
+		out, err := ParseKey(in)
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, bytes.Equal(out, key), "%q: wrong key", in)
+	}
+	for _, in := range []string{"", "short", hex.EncodeToString(key[:20])} {
+		_, err := ParseKey([]byte(in))
+		tassert.Errorf(t, err != nil, "%q: expecting error", in)
+	}
+}
+
+func TestWrapUnwrap(t *testing.T) {
+	var (
+		p   = newStatic(ProviderEnv, testKey(1))
+		dek = testKey(9)
+	)
+	kekID, kek, err := p.Current()
+	tassert.CheckFatal(t, err)
+	wrapped, err := wrap(kek, kekID, dek)
+	tassert.CheckFatal(t, err)
+
+	out, err := unwrap(kek, kekID, wrapped)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, bytes.Equal(out, dek), "wrong data key")
+
+	// wrong master key, wrong key ID
+	_, err = unwrap(testKey(2), kekID, wrapped)
+	tassert.Errorf(t, err != nil, "expecting error (wrong master key)")
+	_, err = unwrap(kek, "other", wrapped)
+	tassert.Errorf(t, err != nil, "expecting error (wrong key ID)")
+
+	// key ID is a stable fingerprint
+	tassert.Errorf(t, newStatic(ProviderFile, testKey(1)).id == kekID, "expecting same ID")
+	tassert.Errorf(t, newStatic(ProviderEnv, testKey(2)).id != kekID, "expecting different IDs")
+}
+
+func TestFromEnv(t *testing.T) {
+	for _, name := range []string{env.AisKMSProvider, env.AisKMSMasterKey, env.AisKMSKeyFile, env.AisKMSKeystore, env.AisKMSKeyID} {
+		t.Setenv(name, "")
+	}
+	_, err := FromEnv()
+	tassert.Fatalf(t, errors.Is(err, ErrNotConfigured), "expecting %v, got %v", ErrNotConfigured, err)
+
+	// file
+	fqn := filepath.Join(t.TempDir(), "master.key")
+	tassert.CheckFatal(t, os.WriteFile(fqn, []byte(hex.EncodeToString(testKey(3))), 0o600))
+	t.Setenv(env.AisKMSKeyFile, fqn)
+	p, err := FromEnv()
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, p.Name() == ProviderFile, "expecting %q, got %q", ProviderFile, p.Name())
+
+	// env takes precedence when the provider is not specified
+	t.Setenv(env.AisKMSMasterKey, base64.StdEncoding.EncodeToString(testKey(4)))
+	p, err = FromEnv()
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, p.Name() == ProviderEnv, "expecting %q, got %q", ProviderEnv, p.Name())
+
+	t.Setenv(env.AisKMSProvider, "vault")
+	_, err = FromEnv()
+	tassert.Errorf(t, err != nil, "expecting error (invalid provider)")
+}
+
+func TestKmipKeystore(t *testing.T) {
+	obj := func(uid, state string, b byte) KmipObject {
+		return KmipObject{
+			UID:         uid,
+			ObjectType:  kmipSymmetricKey,
+			Algorithm:   kmipAES,
+			Length:      KeySize * 8,
+			State:       state,
+			KeyMaterial: hex.EncodeToString(testKey(b)),
+		}
+	}
+	fqn := filepath.Join(t.TempDir(), "keystore.json")
+	save := func(objs ...KmipObject) {
+		b, err := jsoniter.Marshal(&KmipKeystore{Objects: objs})
+		tassert.CheckFatal(t, err)
+		tassert.CheckFatal(t, os.WriteFile(fqn, b, 0o600))
+	}
+
+	save(obj("k1", kmipActive, 1))
+	ks, err := newKeystore(fqn, "")
+	tassert.CheckFatal(t, err)
+	kekID, kek, err := ks.Current()
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, kekID == "k1" && bytes.Equal(kek, testKey(1)), "wrong current key %q", kekID)
+	wrapped, err := wrap(kek, kekID, testKey(9))
+	tassert.CheckFatal(t, err)
+
+	// rotate: k1 => deactivated, k2 => active; existing data keys remain readable
+	save(obj("k1", kmipDeactivated, 1), obj("k2", kmipActive, 2))
+	kekID, _, err = ks.Current()
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, kekID == "k2", "expecting k2, got %q", kekID)
+	kek, err = ks.Get("k1")
+	tassert.CheckFatal(t, err)
+	dek, err := unwrap(kek, "k1", wrapped)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, bytes.Equal(dek, testKey(9)), "wrong data key")
+
+	// destroyed keys are unusable
+	save(obj("k1", "Destroyed", 1), obj("k2", kmipActive, 2))
+	_, err = ks.Get("k1")
+	tassert.Errorf(t, err != nil, "expecting error (destroyed key)")
+
+	// ambiguous current key
+	save(obj("k1", kmipActive, 1), obj("k2", kmipActive, 2))
+	_, _, err = ks.Current()
+	tassert.Errorf(t, err != nil, "expecting error (multiple active keys)")
+	ks.keyID = "k1"
+	kekID, _, err = ks.Current()
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, kekID == "k1", "expecting k1, got %q", kekID)
+}
@@ -0,0 +1,209 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Bucket lifecycle: a list of rules, each selecting objects by name prefix and/or
+// (custom metadata) tags and specifying one or more actions:
+// - expire (delete) objects N days after their last modification;
+// - abort multipart uploads that remain incomplete N days after initiation.
+//
+// Rules are stored in the BMD as part of bucket props and enforced by the
+// target-side `apc.ActLifecycle` xaction (see xact/xs/lifecycle.go) that runs
+// periodically and can also be started on demand (`ais start lifecycle BUCKET`).
+//
+// For remote buckets, expiration only removes in-cluster copies - the
+// remote backend is expected to enforce its own lifecycle policy.
+//
+// See also:
+// - https://docs.aws.amazon.com/AmazonS3/latest/userguide/object-lifecycle-mgmt.html
+
+const (
+	LifecycleMaxRules = 1000 // (as per S3)
+	lifecycleMaxIDLen = 255
+	lifecycleMaxTags  = 10
+	lifecycleMaxDays  = 100 * 365
+	lifecycleDay      = 24 * time.Hour
+)
+
+type (
+	LifecycleRule struct {
+		// Tags (when specified) must all be present in object's tags (see cmn.TagsObjMD)
+		// or, otherwise, in its custom metadata - with the same values.
+		Tags cos.StrKVs `json:"tags,omitempty"`
+
+		// Unique (within a bucket) rule ID.
+		ID string `json:"id"`
+
+		// Object name prefix; empty prefix selects all objects in the bucket.
+		Prefix string `json:"prefix,omitempty"`
+
+		// Expire (delete) objects that were last modified more than
+		// ExpirationDays ago; 0 (zero) - no expiration.
+		ExpirationDays int `json:"expiration_days,omitempty"`
+
+		// Abort multipart uploads that remain incomplete longer than
+		// AbortMptDays after initiation; 0 (zero) - never.
+		AbortMptDays int `json:"abort_mpt_days,omitempty"`
+
+		// Keep the rule without enforcing it.
+		Disabled bool `json:"disabled,omitempty"`
+	}
+
+	LifecycleConf struct {
+		// Lifecycle rules; can only be set in their entirety (see LifecycleConfToSet).
+		Rules []LifecycleRule `json:"rules,omitempty" list:"readonly"`
+
+		// Enabled: lifecycle rules get enforced only when set to true.
+		Enabled bool `json:"enabled"`
+	}
+
+	// LifecycleConfToSet is the partial-update counterpart of LifecycleConf.
+	LifecycleConfToSet struct {
+		// Lifecycle rules. When specified, replaces all existing rules;
+		// an empty list removes them.
+		Rules *[]LifecycleRule `json:"rules,omitempty" list:"readonly"` // +gen:optional
+		// Toggles lifecycle enforcement for the bucket.
+		Enabled *bool `json:"enabled,omitempty"` // +gen:optional
+	}
+)
+
+///////////////////
+// LifecycleConf //
+///////////////////
+
+func (c *LifecycleConf) String() string {
+	if !c.Enabled {
+		return confDisabled
+	}
+	return fmt.Sprintf("%d rule(s)", len(c.Rules))
+}
+
+// IsActive returns true when there's at least one enabled rule to enforce.
+func (c *LifecycleConf) IsActive() bool {
+	if !c.Enabled {
+		return false
+	}
+	for i := range c.Rules {
+		if !c.Rules[i].Disabled {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *LifecycleConf) ValidateAsProps(...any) error {
+	if len(c.Rules) > LifecycleMaxRules {
+		return fmt.Errorf("invalid lifecycle: number of rules %d exceeds the maximum %d", len(c.Rules), LifecycleMaxRules)
+	}
+	ids := make(map[string]struct{}, len(c.Rules))
+	for i := range c.Rules {
+		rule := &c.Rules[i]
+		if err := rule.validate(); err != nil {
+			return err
+		}
+		if _, ok := ids[rule.ID]; ok {
+			return fmt.Errorf("invalid lifecycle: duplicate rule ID %q", rule.ID)
+		}
+		ids[rule.ID] = struct{}{}
+	}
+	return nil
+}
+
+// Expired returns the ID of the first enabled rule that expires the given object
+// (or empty string if there's none).
+func (c *LifecycleConf) Expired(objName string, md cos.StrKVs, mtime, now time.Time) string {
+	for i := range c.Rules {
+		rule := &c.Rules[i]
+		if rule.Disabled || rule.ExpirationDays == 0 {
+			continue
+		}
+		if rule.Match(objName, md) && rule.Expired(mtime, now) {
+			return rule.ID
+		}
+	}
+	return ""
+}
+
+///////////////////
+// LifecycleRule //
+///////////////////
+
+func (rule *LifecycleRule) validate() error {
+	if rule.ID == "" {
+		return errors.New("invalid lifecycle: rule ID cannot be empty")
+	}
+	if len(rule.ID) > lifecycleMaxIDLen {
+		return fmt.Errorf("invalid lifecycle: rule ID %q is too long (%d > %d)", rule.ID, len(rule.ID), lifecycleMaxIDLen)
+	}
+	etag := "invalid lifecycle rule " + rule.ID
+	if err := cos.ValidatePrefix(etag, rule.Prefix); err != nil {
+		return err
+	}
+	if len(rule.Tags) > lifecycleMaxTags {
+		return fmt.Errorf("%s: number of tags %d exceeds the maximum %d", etag, len(rule.Tags), lifecycleMaxTags)
+	}
+	for k := range rule.Tags {
+		if k == "" {
+			return fmt.Errorf("%s: tag key cannot be empty", etag)
+		}
+	}
+	if rule.ExpirationDays < 0 || rule.ExpirationDays > lifecycleMaxDays {
+		return fmt.Errorf("%s: expiration_days %d out of range [0, %d]", etag, rule.ExpirationDays, lifecycleMaxDays)
+	}
+	if rule.AbortMptDays < 0 || rule.AbortMptDays > lifecycleMaxDays {
+		return fmt.Errorf("%s: abort_mpt_days %d out of range [0, %d]", etag, rule.AbortMptDays, lifecycleMaxDays)
+	}
+	if rule.AbortMptDays > 0 && len(rule.Tags) > 0 {
+		// (as per S3: uploads in progress have no tags to match)
+		return fmt.Errorf("%s: abort_mpt_days cannot be combined with tags", etag)
+	}
+	if rule.ExpirationDays == 0 && rule.AbortMptDays == 0 {
+		return fmt.Errorf("%s: must specify at least one action (expiration_days and/or abort_mpt_days)", etag)
+	}
+	return nil
+}
+
+// Match returns true if the rule selects the named object with the given custom metadata.
+func (rule *LifecycleRule) Match(objName string, md cos.StrKVs) bool {
+	if !strings.HasPrefix(objName, rule.Prefix) {
+		return false
+	}
+	if len(rule.Tags) == 0 {
+		return true
+	}
+	tags, _ := ObjTags(md)
+	for k, v := range rule.Tags {
+		vv, ok := tags[k]
+		if !ok {
+			vv, ok = md[k]
+		}
+		if !ok || vv != v {
+			return false
+		}
+	}
+	return true
+}
+
+func (rule *LifecycleRule) Expired(mtime, now time.Time) bool {
+	if rule.ExpirationDays == 0 || mtime.IsZero() {
+		return false
+	}
+	return now.Sub(mtime) >= time.Duration(rule.ExpirationDays)*lifecycleDay
+}
+
+// MptAge returns the age after which incomplete multipart uploads are to be aborted.
+func (rule *LifecycleRule) MptAge() time.Duration {
+	return time.Duration(rule.AbortMptDays) * lifecycleDay
+}
@@ -40,6 +40,13 @@ const (
 
 	// as the name implies
 	OrigFntl = "orig_fntl"
+
+	// custom metadata keys prefixed with ReservedObjMD are set by the system and can
+	// not be specified by users (see ValidateUserMD) - with the exception of object tags
+	ReservedObjMD = "ais."
+
+	// object tags, URL-query encoded (see cmn/objtags.go)
+	TagsObjMD = ReservedObjMD + "tags"
 )
 
 type (
@@ -723,3 +730,39 @@ func ValidateCustomMD(custom cos.StrKVs) error {
 	}
 	return nil
 }
+
+// user-specified custom metadata (e.g., PUT, set-custom) may not contain reserved keys
+// other than object tags; the latter must be valid (see ParseObjTags)
+func ValidateUserKV(k, v string) error {
+	if !strings.HasPrefix(k, ReservedObjMD) {
+		return nil
+	}
+	if k != TagsObjMD {
+		return fmt.Errorf("%s: key %q is reserved (prefix %q)", tagCustom, k, ReservedObjMD)
+	}
+	_, err := ParseObjTags(v)
+	return err
+}
+
+// (compare with FromHeader)
+func ValidateUserHdr(hdr http.Header) error {
+	for _, kvs := range hdr[apc.HdrObjCustomMD] {
+		k, v, _ := strings.Cut(kvs, "=")
+		if err := ValidateUserKV(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ValidateUserMD(custom cos.StrKVs) error {
+	if err := ValidateCustomMD(custom); err != nil {
+		return err
+	}
+	for k, v := range custom {
+		if err := ValidateUserKV(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -55,6 +55,17 @@ func (be *LsoEnt) less(oe *LsoEnt) bool {
 		return false
 	}
 	if be.Name == oe.Name {
+		// apc.LsVersions: prior versions (and delete markers) first, oldest to newest
+		bv, ov := be.IsAnyFlagSet(apc.EntryIsVersion), oe.IsAnyFlagSet(apc.EntryIsVersion)
+		switch {
+		case bv && ov:
+			if len(be.Version) != len(oe.Version) {
+				return len(be.Version) < len(oe.Version)
+			}
+			return be.Version < oe.Version
+		case bv != ov:
+			return bv
+		}
 		return be.Status() < oe.Status()
 	}
 	return be.Name < oe.Name
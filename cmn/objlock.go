@@ -0,0 +1,229 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Object lock (WORM): write-once-read-many retention of objects.
+// - bucket level: once enabled, object lock cannot be disabled; optionally, the bucket
+//   specifies default retention (mode and period) for newly written objects;
+// - object level: retain-until date and mode, and legal hold - all stored in the
+//   object's custom metadata (see RetainUntilObjMD et al. below);
+// - retained objects (retain-until in the future, or legal hold on) cannot be
+//   overwritten, deleted, renamed, or evicted - neither by users nor by LRU and
+//   space cleanup;
+// - "governance" mode retention can be bypassed (and shortened or removed) by users
+//   with the permission to set bucket ACL; "compliance" mode retention can only be extended.
+//
+// See also:
+// - https://docs.aws.amazon.com/AmazonS3/latest/userguide/object-lock.html
+
+const (
+	ObjLockGovernance = "governance"
+	ObjLockCompliance = "compliance"
+
+	objLockMaxDays = 100 * 365
+)
+
+// object lock: custom metadata keys
+const (
+	RetainUntilObjMD = "retain-until" // RFC3339 (UTC)
+	RetainModeObjMD  = "retain-mode"  // ObjLockGovernance | ObjLockCompliance
+	LegalHoldObjMD   = "legal-hold"   // "on" (absent when off)
+
+	LegalHoldOn = "on"
+)
+
+type (
+	ObjectLockConf struct {
+		// Default retention mode: "governance" or "compliance".
+		Mode string `json:"mode,omitempty"`
+
+		// Default retention period (in days) for newly written objects; 0 (zero) - none.
+		Days int `json:"days,omitempty"`
+
+		// Enables object lock (cannot be disabled once enabled).
+		Enabled bool `json:"enabled"`
+	}
+
+	// ObjectLockConfToSet is the partial-update counterpart of ObjectLockConf.
+	ObjectLockConfToSet struct {
+		// Default retention mode: "governance" or "compliance".
+		Mode *string `json:"mode,omitempty"` // +gen:optional
+		// Default retention period (in days); 0 (zero) - none.
+		Days *int `json:"days,omitempty"` // +gen:optional
+		// Enables object lock (irreversible).
+		Enabled *bool `json:"enabled,omitempty"` // +gen:optional
+	}
+
+	// per-object retention (parsed from custom metadata)
+	ObjRetention struct {
+		RetainUntil time.Time
+		Mode        string
+		LegalHold   bool
+	}
+
+	ErrObjLocked struct {
+		cname  string
+		reason string
+	}
+)
+
+////////////////////
+// ObjectLockConf //
+////////////////////
+
+func (c *ObjectLockConf) String() string {
+	if !c.Enabled {
+		return "disabled"
+	}
+	if c.Days == 0 {
+		return "enabled"
+	}
+	return fmt.Sprintf("enabled (default retention: %s, %dd)", c.Mode, c.Days)
+}
+
+func (c *ObjectLockConf) ValidateAsProps(...any) error {
+	if !c.Enabled && (c.Mode != "" || c.Days != 0) {
+		return errors.New("invalid object lock configuration: default retention requires object lock to be enabled")
+	}
+	if c.Mode != "" && !ValidObjLockMode(c.Mode) {
+		return fmt.Errorf("invalid object lock mode %q (expecting %q or %q)", c.Mode, ObjLockGovernance, ObjLockCompliance)
+	}
+	if c.Days < 0 || c.Days > objLockMaxDays {
+		return fmt.Errorf("invalid object lock default retention %d days (expecting [0, %d])", c.Days, objLockMaxDays)
+	}
+	if c.Days > 0 && c.Mode == "" {
+		return errors.New("invalid object lock configuration: default retention requires mode")
+	}
+	return nil
+}
+
+// default retention for newly written objects (zero time when none)
+func (c *ObjectLockConf) DefaultRetainUntil(now time.Time) time.Time {
+	if !c.Enabled || c.Days == 0 {
+		return time.Time{}
+	}
+	return now.Add(time.Duration(c.Days) * 24 * time.Hour)
+}
+
+func ValidObjLockMode(mode string) bool {
+	return mode == ObjLockGovernance || mode == ObjLockCompliance
+}
+
+//////////////////
+// ObjRetention //
+//////////////////
+
+func ObjRetentionFromMD(custom cos.StrKVs) (ret ObjRetention) {
+	if len(custom) == 0 {
+		return ret
+	}
+	if s, ok := custom[RetainUntilObjMD]; ok {
+		if t, err := time.Parse(time.RFC3339, s); err == nil {
+			ret.RetainUntil = t
+		}
+	}
+	ret.Mode = custom[RetainModeObjMD]
+	ret.LegalHold = custom[LegalHoldObjMD] == LegalHoldOn
+	return ret
+}
+
+// IsObjLockMD returns true if the custom metadata key is one of the object lock keys.
+func IsObjLockMD(key string) bool {
+	return key == RetainUntilObjMD || key == RetainModeObjMD || key == LegalHoldObjMD
+}
+
+func (ret *ObjRetention) Retained(now time.Time) bool {
+	return !ret.RetainUntil.IsZero() && ret.RetainUntil.After(now)
+}
+
+func (ret *ObjRetention) Locked(now time.Time) bool { return ret.LegalHold || ret.Retained(now) }
+
+// Check returns ErrObjLocked if the object cannot be modified or removed;
+// governance-mode retention can be bypassed (legal hold cannot).
+func (ret *ObjRetention) Check(cname string, now time.Time, bypassGovernance bool) error {
+	if ret.LegalHold {
+		return &ErrObjLocked{cname: cname, reason: "legal hold"}
+	}
+	if !ret.Retained(now) {
+		return nil
+	}
+	if bypassGovernance && ret.Mode != ObjLockCompliance {
+		return nil
+	}
+	return &ErrObjLocked{cname: cname, reason: fmt.Sprintf("%s retention until %s", ret.Mode, ret.RetainUntil.Format(time.RFC3339))}
+}
+
+// ValidateUpdate checks whether the retention can be changed to `to`:
+// compliance retention can only be extended; governance - shortened, removed,
+// or converted only with bypass.
+func (ret *ObjRetention) ValidateUpdate(to *ObjRetention, now time.Time, bypassGovernance bool) error {
+	if !to.RetainUntil.IsZero() {
+		if !ValidObjLockMode(to.Mode) {
+			return fmt.Errorf("invalid retention mode %q (expecting %q or %q)", to.Mode, ObjLockGovernance, ObjLockCompliance)
+		}
+		if !to.RetainUntil.After(now) {
+			return fmt.Errorf("invalid retain-until date %s: must be in the future", to.RetainUntil.Format(time.RFC3339))
+		}
+	}
+	if !ret.Retained(now) {
+		return nil
+	}
+	extends := !to.RetainUntil.IsZero() && !to.RetainUntil.Before(ret.RetainUntil)
+	switch {
+	case ret.Mode == ObjLockCompliance:
+		if !extends || to.Mode != ObjLockCompliance {
+			return errors.New("compliance mode retention can only be extended")
+		}
+	case extends && to.Mode == ret.Mode:
+	case to.Mode == ObjLockCompliance && extends:
+		// governance => compliance
+	case !bypassGovernance:
+		return errors.New("governance mode retention can only be shortened or removed with bypass")
+	}
+	return nil
+}
+
+// ToMD sets (or removes) retention keys in custom metadata (legal hold excluded).
+func (ret *ObjRetention) ToMD(custom cos.StrKVs) {
+	if ret.RetainUntil.IsZero() {
+		delete(custom, RetainUntilObjMD)
+		delete(custom, RetainModeObjMD)
+		return
+	}
+	custom[RetainUntilObjMD] = ret.RetainUntil.UTC().Format(time.RFC3339)
+	custom[RetainModeObjMD] = ret.Mode
+}
+
+//////////////////
+// ErrObjLocked //
+//////////////////
+
+func (e *ErrObjLocked) Error() string {
+	return fmt.Sprintf("%s is locked (%s)", e.cname, e.reason)
+}
+
+func IsErrObjLocked(err error) bool {
+	var e *ErrObjLocked
+	return errors.As(err, &e)
+}
+
+// parse RFC3339 retain-until date (S3 and native API)
+func ParseRetainUntil(s string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, strings.TrimSpace(s))
+	if err != nil {
+		return t, fmt.Errorf("invalid retain-until date %q: %v", s, err)
+	}
+	return t.UTC(), nil
+}
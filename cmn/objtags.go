@@ -0,0 +1,100 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Object tags (a.k.a. S3 object tagging)
+// - stored as a single custom-metadata entry (TagsObjMD) in the object's metadata
+// - encoded as URL query, e.g. "class=pii&retention=7y" - same format as S3 `x-amz-tagging` header
+// - limits as per https://docs.aws.amazon.com/AmazonS3/latest/userguide/object-tagging.html
+
+const (
+	MaxObjTags      = 10
+	MaxObjTagKeyLen = 128
+	MaxObjTagValLen = 256
+)
+
+const tagObjTags = "object tags"
+
+// parse URL-query encoded tags and validate the result
+func ParseObjTags(s string) (cos.StrKVs, error) {
+	if s == "" {
+		return cos.StrKVs{}, nil
+	}
+	q, err := url.ParseQuery(s)
+	if err != nil {
+		return nil, fmt.Errorf("%s: invalid encoding %q: %v", tagObjTags, s, err)
+	}
+	tags := make(cos.StrKVs, len(q))
+	for k, vs := range q {
+		if len(vs) != 1 {
+			return nil, fmt.Errorf("%s: duplicate key %q", tagObjTags, k)
+		}
+		tags[k] = vs[0]
+	}
+	return tags, ValidateObjTags(tags)
+}
+
+// encode tags in the sorted (and therefore, deterministic) order
+func EncodeObjTags(tags cos.StrKVs) string {
+	if len(tags) == 0 {
+		return ""
+	}
+	var (
+		sb   strings.Builder
+		keys = make([]string, 0, len(tags))
+	)
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for i, k := range keys {
+		if i > 0 {
+			sb.WriteByte('&')
+		}
+		sb.WriteString(url.QueryEscape(k))
+		sb.WriteByte('=')
+		sb.WriteString(url.QueryEscape(tags[k]))
+	}
+	return sb.String()
+}
+
+func ValidateObjTags(tags cos.StrKVs) error {
+	if len(tags) > MaxObjTags {
+		return fmt.Errorf("%s: number of tags (%d) exceeds the maximum (%d)", tagObjTags, len(tags), MaxObjTags)
+	}
+	for k, v := range tags {
+		if k == "" {
+			return errors.New(tagObjTags + ": empty key")
+		}
+		if l := utf8.RuneCountInString(k); l > MaxObjTagKeyLen {
+			return fmt.Errorf("%s: key %q is too long (%d > %d)", tagObjTags, k, l, MaxObjTagKeyLen)
+		}
+		if l := utf8.RuneCountInString(v); l > MaxObjTagValLen {
+			return fmt.Errorf("%s: value of the key %q is too long (%d > %d)", tagObjTags, k, l, MaxObjTagValLen)
+		}
+	}
+	return nil
+}
+
+// given object's custom metadata, return its tags, if any
+func ObjTags(custom cos.StrKVs) (cos.StrKVs, error) {
+	s, ok := custom[TagsObjMD]
+	if !ok {
+		return cos.StrKVs{}, nil
+	}
+	return ParseObjTags(s)
+}
@@ -0,0 +1,316 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+)
+
+// Bucket policy: a list of allow/deny statements, each specifying
+// principals (users), actions (permissions), and resources (objects).
+// - evaluated by AIS gateways prior to (and in addition to) the regular access checks
+// - explicit deny wins; otherwise, matching allow statements must cover all requested permissions
+// - no matching statements: regular access checks (see `apc.AccessAttrs`)
+
+const (
+	PolicyAllow = "allow"
+	PolicyDeny  = "deny"
+
+	// any principal, including anonymous (no token)
+	PolicyAnyPrincipal = "*"
+	// any authenticated principal (valid token)
+	PolicyAuthPrincipal = "*authenticated"
+
+	// resource wildcard; when trailing, selects all objects with the given prefix
+	PolicyWildcard = "*"
+
+	PolicyMaxStatements = 64
+)
+
+// Eval results
+const (
+	PolicyNoDecision = iota
+	PolicyAllowed
+	PolicyDenied
+)
+
+// S3 actions => AIS permissions
+// (all other S3 actions are not supported)
+var policyS3Actions = map[string]apc.AccessAttrs{
+	"s3:*":                   apc.AccessAll,
+	"s3:GetObject":           apc.AceGET | apc.AceObjHEAD,
+	"s3:PutObject":           apc.AcePUT | apc.AceAPPEND,
+	"s3:DeleteObject":        apc.AceObjDELETE,
+	"s3:ListBucket":          apc.AceObjLIST | apc.AceBckHEAD,
+	"s3:GetObjectTagging":    apc.AceObjHEAD,
+	"s3:PutObjectTagging":    apc.AcePUT,
+	"s3:DeleteObjectTagging": apc.AcePUT,
+	"s3:GetBucketPolicy":     apc.AceBckHEAD,
+	"s3:PutBucketPolicy":     apc.AceBckSetACL,
+	"s3:DeleteBucketPolicy":  apc.AceBckSetACL,
+	"s3:GetBucketAcl":        apc.AceBckHEAD,
+	"s3:PutBucketAcl":        apc.AceBckSetACL,
+}
+
+type (
+	PolicyStatement struct {
+		// Optional statement ID.
+		Sid string `json:"sid,omitempty"`
+
+		// Either "allow" or "deny".
+		Effect string `json:"effect"`
+
+		// User IDs (authN token subjects); "*" selects all users including anonymous,
+		// while "*authenticated" selects all users with a valid token.
+		Principals []string `json:"principals"`
+
+		// AIS permissions (e.g. "GET", "LIST-OBJECTS", "ro", "rw") and/or S3 actions (e.g. "s3:GetObject").
+		Actions []string `json:"actions"`
+
+		// Object names and name prefixes: "logs/*" selects all objects under "logs/",
+		// while "*" selects the entire bucket including bucket-level operations.
+		Resources []string `json:"resources"`
+	}
+
+	PolicyConf struct {
+		// Policy statements; can only be set in their entirety (see PolicyConfToSet).
+		Statements []PolicyStatement `json:"statements,omitempty" list:"readonly"`
+	}
+
+	// PolicyScope: objects selected by a multi-object request - either explicit
+	// names or all objects with a given prefix (empty prefix: entire bucket).
+	// Used with list-objects, multi-object delete/evict/prefetch/copy/transform/archive,
+	// and bucket-to-bucket copy/transform.
+	PolicyScope struct {
+		Names     []string
+		Prefix    string
+		Recursive bool // false: non-recursive listing (directory-like Prefix)
+	}
+
+	// PolicyConfToSet is the partial-update counterpart of PolicyConf.
+	PolicyConfToSet struct {
+		// Policy statements. When specified, replaces all existing statements;
+		// an empty list removes them.
+		Statements *[]PolicyStatement `json:"statements,omitempty" list:"readonly"` // +gen:optional
+	}
+)
+
+////////////////
+// PolicyConf //
+////////////////
+
+func (c *PolicyConf) String() string {
+	if len(c.Statements) == 0 {
+		return "none"
+	}
+	return fmt.Sprintf("%d statement(s)", len(c.Statements))
+}
+
+func (c *PolicyConf) IsActive() bool { return len(c.Statements) > 0 }
+
+func (c *PolicyConf) ValidateAsProps(...any) error {
+	if len(c.Statements) > PolicyMaxStatements {
+		return fmt.Errorf("invalid policy: number of statements %d exceeds the maximum %d", len(c.Statements), PolicyMaxStatements)
+	}
+	for i := range c.Statements {
+		if err := c.Statements[i].validate(i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Eval evaluates the policy for a given principal (empty for anonymous),
+// requested permissions, and resource - object name or list-objects prefix
+// (empty for bucket-level operations).
+// Returns PolicyDenied and the denying statement's ID (or index), or
+// PolicyAllowed, or PolicyNoDecision.
+func (c *PolicyConf) Eval(principal string, ace apc.AccessAttrs, resource string) (int, string) {
+	var allowed apc.AccessAttrs
+	for i := range c.Statements {
+		stmt := &c.Statements[i]
+		if !stmt.matchPrincipal(principal) || !stmt.matchResource(resource) {
+			continue
+		}
+		perms := stmt.perms()
+		if perms&ace == 0 {
+			continue
+		}
+		if strings.EqualFold(stmt.Effect, PolicyDeny) {
+			return PolicyDenied, stmt.id(i)
+		}
+		allowed |= perms
+	}
+	if allowed.Has(ace) {
+		return PolicyAllowed, ""
+	}
+	return PolicyNoDecision, ""
+}
+
+// EvalScope is Eval for multi-object requests. It fails closed: a deny statement
+// denies the entire request if any of its resources selects (or may select) any
+// object in the scope. Allow, on the other hand, must cover all named objects
+// or, respectively, the prefix.
+func (c *PolicyConf) EvalScope(principal string, ace apc.AccessAttrs, scope *PolicyScope) (int, string) {
+	if len(scope.Names) > 0 {
+		verdict := PolicyAllowed
+		for _, name := range scope.Names {
+			switch v, sid := c.Eval(principal, ace, name); v {
+			case PolicyDenied:
+				return v, sid
+			case PolicyNoDecision:
+				verdict = PolicyNoDecision
+			}
+		}
+		return verdict, ""
+	}
+	if scope.Recursive {
+		for i := range c.Statements {
+			stmt := &c.Statements[i]
+			if !strings.EqualFold(stmt.Effect, PolicyDeny) || stmt.perms()&ace == 0 {
+				continue
+			}
+			if stmt.matchPrincipal(principal) && stmt.overlapPrefix(scope.Prefix) {
+				return PolicyDenied, stmt.id(i)
+			}
+		}
+	}
+	return c.Eval(principal, ace, scope.Prefix)
+}
+
+func (s *PolicyScope) String() string {
+	switch {
+	case len(s.Names) == 1:
+		return s.Names[0]
+	case len(s.Names) > 1:
+		return fmt.Sprintf("%s (and %d more)", s.Names[0], len(s.Names)-1)
+	default:
+		return s.Prefix
+	}
+}
+
+/////////////////////
+// PolicyStatement //
+/////////////////////
+
+func (stmt *PolicyStatement) id(idx int) string {
+	if stmt.Sid != "" {
+		return stmt.Sid
+	}
+	return fmt.Sprintf("#%d", idx)
+}
+
+func (stmt *PolicyStatement) validate(idx int) error {
+	etag := "invalid policy statement " + stmt.id(idx)
+	if !strings.EqualFold(stmt.Effect, PolicyAllow) && !strings.EqualFold(stmt.Effect, PolicyDeny) {
+		return fmt.Errorf("%s: effect must be %q or %q (got %q)", etag, PolicyAllow, PolicyDeny, stmt.Effect)
+	}
+	if len(stmt.Principals) == 0 {
+		return fmt.Errorf("%s: no principals", etag)
+	}
+	for _, p := range stmt.Principals {
+		if p == "" {
+			return fmt.Errorf("%s: empty principal", etag)
+		}
+		if p != PolicyAnyPrincipal && p != PolicyAuthPrincipal && strings.Contains(p, PolicyWildcard) {
+			return fmt.Errorf("%s: invalid principal %q (wildcards are not supported)", etag, p)
+		}
+	}
+	if len(stmt.Actions) == 0 {
+		return fmt.Errorf("%s: no actions", etag)
+	}
+	for _, action := range stmt.Actions {
+		if _, err := PolicyActionToAccess(action); err != nil {
+			return fmt.Errorf("%s: %v", etag, err)
+		}
+	}
+	if len(stmt.Resources) == 0 {
+		return fmt.Errorf("%s: no resources", etag)
+	}
+	for _, res := range stmt.Resources {
+		if res == "" {
+			return fmt.Errorf("%s: empty resource", etag)
+		}
+		if i := strings.Index(res, PolicyWildcard); i >= 0 && i != len(res)-1 {
+			return fmt.Errorf("%s: resource %q: wildcard is only supported at the end", etag, res)
+		}
+	}
+	return nil
+}
+
+func (stmt *PolicyStatement) matchPrincipal(principal string) bool {
+	for _, p := range stmt.Principals {
+		switch {
+		case p == PolicyAnyPrincipal:
+			return true
+		case principal == "":
+			// anonymous
+		case p == PolicyAuthPrincipal || p == principal:
+			return true
+		}
+	}
+	return false
+}
+
+func (stmt *PolicyStatement) matchResource(resource string) bool {
+	for _, res := range stmt.Resources {
+		if res == PolicyWildcard {
+			return true
+		}
+		if resource == "" {
+			continue // bucket-level operation
+		}
+		if prefix, ok := strings.CutSuffix(res, PolicyWildcard); ok {
+			if strings.HasPrefix(resource, prefix) {
+				return true
+			}
+		} else if res == resource {
+			return true
+		}
+	}
+	return false
+}
+
+// whether any of the statement's resources selects objects that have the given prefix
+func (stmt *PolicyStatement) overlapPrefix(prefix string) bool {
+	for _, res := range stmt.Resources {
+		if resPrefix, ok := strings.CutSuffix(res, PolicyWildcard); ok {
+			if strings.HasPrefix(resPrefix, prefix) || strings.HasPrefix(prefix, resPrefix) {
+				return true
+			}
+		} else if strings.HasPrefix(res, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// (validated actions)
+func (stmt *PolicyStatement) perms() (perms apc.AccessAttrs) {
+	for _, action := range stmt.Actions {
+		a, _ := PolicyActionToAccess(action)
+		perms |= a
+	}
+	return perms
+}
+
+// PolicyActionToAccess converts AIS permission name or S3 action to access bits.
+func PolicyActionToAccess(action string) (apc.AccessAttrs, error) {
+	if strings.HasPrefix(action, "s3:") {
+		if a, ok := policyS3Actions[action]; ok {
+			return a, nil
+		}
+		return 0, fmt.Errorf("unsupported action %q", action)
+	}
+	if action == "" {
+		return 0, errors.New("empty action")
+	}
+	return apc.StrToAccess(action)
+}
@@ -0,0 +1,124 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// HTTP conditional requests: If-Match, If-None-Match, If-Modified-Since, If-Unmodified-Since
+// evaluated against the object's current ETag and last-modified time, in the order
+// prescribed by RFC 9110 (section 13.2.2).
+// See also:
+// - https://www.rfc-editor.org/rfc/rfc9110#section-13
+// - https://docs.aws.amazon.com/AmazonS3/latest/userguide/conditional-requests.html
+
+type Precond struct {
+	IfModifiedSince   time.Time
+	IfUnmodifiedSince time.Time
+	IfMatch           string
+	IfNoneMatch       string
+}
+
+// NewPrecond returns nil when the request carries no (valid) preconditions;
+// as per RFC 9110, invalid HTTP dates are ignored.
+func NewPrecond(hdr http.Header) *Precond {
+	var (
+		pc  Precond
+		has bool
+	)
+	if v := hdr.Get(cos.HdrIfMatch); v != "" {
+		pc.IfMatch, has = v, true
+	}
+	if v := hdr.Get(cos.HdrIfNoneMatch); v != "" {
+		pc.IfNoneMatch, has = v, true
+	}
+	if v := hdr.Get(cos.HdrIfModifiedSince); v != "" {
+		if tm, err := http.ParseTime(v); err == nil {
+			pc.IfModifiedSince, has = tm, true
+		}
+	}
+	if v := hdr.Get(cos.HdrIfUnmodifiedSince); v != "" {
+		if tm, err := http.ParseTime(v); err == nil {
+			pc.IfUnmodifiedSince, has = tm, true
+		}
+	}
+	if !has {
+		return nil
+	}
+	return &pc
+}
+
+// Eval evaluates preconditions given:
+// - exists: whether the object currently exists
+// - etag:   its (unquoted) ETag, if any
+// - mtime:  its last-modified time, if known
+// - read:   GET or HEAD (as opposed to PUT and other writes)
+// Returns:
+// - (0, nil) to proceed with the request;
+// - (304, nil) when a read is not to be performed (GET/HEAD only);
+// - (412, *ErrPrecondFailed) otherwise.
+func (pc *Precond) Eval(exists bool, etag string, mtime time.Time, read bool) (int, error) {
+	if !mtime.IsZero() {
+		mtime = mtime.Truncate(time.Second) // (HTTP dates have one-second resolution)
+	}
+
+	// 1. If-Match, or else If-Unmodified-Since
+	switch {
+	case pc.IfMatch != "":
+		if !exists || !etagMatch(pc.IfMatch, etag, true /*strong*/) {
+			return http.StatusPreconditionFailed, &ErrPrecondFailed{cos.HdrIfMatch}
+		}
+	case !pc.IfUnmodifiedSince.IsZero():
+		if exists && !mtime.IsZero() && mtime.After(pc.IfUnmodifiedSince) {
+			return http.StatusPreconditionFailed, &ErrPrecondFailed{cos.HdrIfUnmodifiedSince}
+		}
+	}
+
+	// 2. If-None-Match, or else If-Modified-Since (the latter - reads only)
+	switch {
+	case pc.IfNoneMatch != "":
+		if exists && etagMatch(pc.IfNoneMatch, etag, false /*strong*/) {
+			if read {
+				return http.StatusNotModified, nil
+			}
+			return http.StatusPreconditionFailed, &ErrPrecondFailed{cos.HdrIfNoneMatch}
+		}
+	case read && !pc.IfModifiedSince.IsZero():
+		if exists && !mtime.IsZero() && !mtime.After(pc.IfModifiedSince) {
+			return http.StatusNotModified, nil
+		}
+	}
+	return 0, nil
+}
+
+// matches comma-separated list of entity tags (or "*") against a given unquoted ETag;
+// strong comparison never matches weak ("W/") tags
+func etagMatch(list, etag string, strong bool) bool {
+	for tag := range strings.SplitSeq(list, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" {
+			return true
+		}
+		if etag == "" {
+			continue
+		}
+		if weak := strings.HasPrefix(tag, "W/"); weak {
+			if strong {
+				continue
+			}
+			tag = tag[2:]
+		}
+		if UnquoteCEV(tag) == etag {
+			return true
+		}
+	}
+	return false
+}
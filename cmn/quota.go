@@ -0,0 +1,168 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Capacity and object-count quotas:
+// - bucket level: bucket property (see Bprops.Quota);
+// - namespace level: BMD (see meta.BMD.NsQuotas), applies to all buckets in a given namespace;
+// - soft quotas are advisory (exceeding them gets logged and shown in bucket summary);
+// - hard quotas fail PUTs (507 Insufficient Storage; 403 via S3 API);
+// - targets track usage incrementally, the primary aggregates it cluster-wide;
+//   enforcement is therefore approximate - within a few seconds (and a few PUTs) of the limit.
+
+type (
+	QuotaConf struct {
+		// Soft and hard capacity limits; 0 (zero) - unlimited.
+		SoftSize cos.SizeIEC `json:"soft_size,omitempty"`
+		HardSize cos.SizeIEC `json:"hard_size,omitempty"`
+
+		// Soft and hard object-count limits; 0 (zero) - unlimited.
+		SoftObjs int64 `json:"soft_objs,omitempty"`
+		HardObjs int64 `json:"hard_objs,omitempty"`
+	}
+
+	// QuotaConfToSet is the partial-update counterpart of QuotaConf.
+	QuotaConfToSet struct {
+		// Soft capacity limit; 0 (zero) - unlimited.
+		SoftSize *cos.SizeIEC `json:"soft_size,omitempty"` // +gen:optional
+		// Hard capacity limit; 0 (zero) - unlimited.
+		HardSize *cos.SizeIEC `json:"hard_size,omitempty"` // +gen:optional
+		// Soft object-count limit; 0 (zero) - unlimited.
+		SoftObjs *int64 `json:"soft_objs,omitempty"` // +gen:optional
+		// Hard object-count limit; 0 (zero) - unlimited.
+		HardObjs *int64 `json:"hard_objs,omitempty"` // +gen:optional
+	}
+
+	QuotaUsage struct {
+		Size int64 `json:"size,string"`
+		Objs int64 `json:"objs,string"`
+	}
+
+	// target => primary: per-bucket local usage;
+	// primary => target: cluster-wide per-bucket and per-namespace usage
+	QuotaStats struct {
+		Bcks map[string]QuotaUsage `json:"bcks,omitempty"` // bucket uname => usage
+		Nss  map[string]QuotaUsage `json:"nss,omitempty"`  // namespace uname => usage
+	}
+
+	// bucket summary: usage vs. quota
+	BsummQuota struct {
+		Bck     QuotaConf  `json:"bck"`
+		BckUsed QuotaUsage `json:"bck_used"`
+		Ns      QuotaConf  `json:"ns"`
+		NsUsed  QuotaUsage `json:"ns_used"`
+	}
+
+	ErrQuotaExceeded struct {
+		what  string // "bucket" | "namespace"
+		name  string
+		limit string
+	}
+)
+
+///////////////
+// QuotaConf //
+///////////////
+
+func (c *QuotaConf) IsSet() bool {
+	return c.SoftSize != 0 || c.HardSize != 0 || c.SoftObjs != 0 || c.HardObjs != 0
+}
+
+func (c *QuotaConf) HasHard() bool { return c.HardSize != 0 || c.HardObjs != 0 }
+
+func (c *QuotaConf) String() string {
+	if !c.IsSet() {
+		return "none"
+	}
+	var parts []string
+	if c.SoftSize != 0 {
+		parts = append(parts, "soft size "+c.SoftSize.String())
+	}
+	if c.HardSize != 0 {
+		parts = append(parts, "hard size "+c.HardSize.String())
+	}
+	if c.SoftObjs != 0 {
+		parts = append(parts, "soft objects "+strconv.FormatInt(c.SoftObjs, 10))
+	}
+	if c.HardObjs != 0 {
+		parts = append(parts, "hard objects "+strconv.FormatInt(c.HardObjs, 10))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func (c *QuotaConf) ValidateAsProps(...any) error {
+	if c.SoftSize < 0 || c.HardSize < 0 {
+		return fmt.Errorf("invalid quota: negative size (soft %d, hard %d)", c.SoftSize, c.HardSize)
+	}
+	if c.SoftObjs < 0 || c.HardObjs < 0 {
+		return fmt.Errorf("invalid quota: negative object count (soft %d, hard %d)", c.SoftObjs, c.HardObjs)
+	}
+	if c.HardSize != 0 && c.SoftSize > c.HardSize {
+		return fmt.Errorf("invalid quota: soft size %s exceeds hard size %s", c.SoftSize, c.HardSize)
+	}
+	if c.HardObjs != 0 && c.SoftObjs > c.HardObjs {
+		return fmt.Errorf("invalid quota: soft object count %d exceeds hard %d", c.SoftObjs, c.HardObjs)
+	}
+	return nil
+}
+
+// CheckHard returns ErrQuotaExceeded if writing `size` bytes (and `objs` new objects)
+// on top of the current usage would exceed the hard quota.
+func (c *QuotaConf) CheckHard(used *QuotaUsage, size, objs int64, what, name string) error {
+	if c.HardSize != 0 && used.Size+max(size, 0) > int64(c.HardSize) {
+		return &ErrQuotaExceeded{what: what, name: name, limit: "hard size " + c.HardSize.String()}
+	}
+	if c.HardObjs != 0 && objs > 0 && used.Objs+objs > c.HardObjs {
+		return &ErrQuotaExceeded{what: what, name: name, limit: "hard object count " + strconv.FormatInt(c.HardObjs, 10)}
+	}
+	return nil
+}
+
+// SoftExceeded returns a non-empty description when usage exceeds the soft quota.
+func (c *QuotaConf) SoftExceeded(used *QuotaUsage) string {
+	switch {
+	case c.SoftSize != 0 && used.Size > int64(c.SoftSize):
+		return "soft size " + c.SoftSize.String()
+	case c.SoftObjs != 0 && used.Objs > c.SoftObjs:
+		return "soft object count " + strconv.FormatInt(c.SoftObjs, 10)
+	}
+	return ""
+}
+
+////////////////
+// QuotaUsage //
+////////////////
+
+func (u *QuotaUsage) Add(size, objs int64) {
+	u.Size += size
+	u.Objs += objs
+}
+
+func (u *QuotaUsage) String() string {
+	return cos.ToSizeIEC(u.Size, 2) + ", " + strconv.FormatInt(u.Objs, 10) + " objects"
+}
+
+//////////////////////
+// ErrQuotaExceeded //
+//////////////////////
+
+func (e *ErrQuotaExceeded) Error() string {
+	return fmt.Sprintf("%s %q: quota exceeded (%s)", e.what, e.name, e.limit)
+}
+
+func IsErrQuotaExceeded(err error) bool {
+	var e *ErrQuotaExceeded
+	return errors.As(err, &e)
+}
@@ -0,0 +1,158 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Cluster-side scheduling of recurring jobs:
+// - named schedules are stored in the BMD (see meta.BMD.Schedules) and executed by the primary;
+// - a job is either a cluster-wide "start xaction" request (apc.ActXactStart with xact.ArgsMsg),
+//   or one of the bucket actions listed below (e.g., copy-bucket or prefetch);
+// - overlap policy determines what happens when the job is due while its previous run is still running;
+// - run history is kept in the primary's memory (and is therefore lost upon primary change).
+
+const (
+	SchedOverlapSkip  = "skip"  // skip this run (default)
+	SchedOverlapQueue = "queue" // run as soon as the previous one finishes (pending runs get coalesced)
+)
+
+// job run states (see JobRun)
+const (
+	JobRunStarted = "started"
+	JobRunSkipped = "skipped"
+	JobRunFailed  = "failed"
+)
+
+// max number of most recent runs to keep (per schedule)
+const JobRunHistory = 16
+
+// bucket (POST /v1/buckets) actions that can be scheduled
+var SchedBckActs = []string{
+	apc.ActCopyBck,
+	apc.ActETLBck,
+	apc.ActCopyObjects,
+	apc.ActETLObjects,
+	apc.ActPrefetchObjects,
+	apc.ActEvictObjects,
+	apc.ActDeleteObjects,
+	apc.ActArchive,
+	apc.ActCreateNBI,
+	apc.ActMakeNCopies,
+	apc.ActECEncode,
+}
+
+type (
+	JobSchedule struct {
+		// Unique schedule name.
+		Name string `json:"name"`
+
+		// Standard 5-field cron expression (see cos.ParseCron), e.g. "0 2 * * *"; evaluated in UTC.
+		Cron string `json:"cron"`
+
+		// What to do when the job is due while its previous run is still running:
+		// "skip" (default) or "queue".
+		Overlap string `json:"overlap,omitempty"`
+
+		// Bucket to run the action on (bucket actions only).
+		Bck *Bck `json:"bck,omitempty"`
+
+		// Destination bucket (copy and transform bucket actions).
+		BckTo *Bck `json:"bck_to,omitempty"`
+
+		// Action message: apc.ActXactStart with xact.ArgsMsg value (cluster-wide),
+		// or one of the supported bucket actions with its respective value.
+		Msg apc.ActMsg `json:"msg"`
+	}
+
+	JobRun struct {
+		State string `json:"state"`         // one of the JobRun* enumerated above
+		Xid   string `json:"xid,omitempty"` // started xaction (job) ID, if any
+		Err   string `json:"err,omitempty"`
+		Time  int64  `json:"time,string"` // unix nano
+	}
+
+	// GET /v1/cluster?what=job-schedules
+	JobScheduleInfo struct {
+		JobSchedule
+		Next    int64    `json:"next,string,omitempty"` // unix nano
+		Runs    []JobRun `json:"runs,omitempty"`        // most recent last
+		Pending bool     `json:"pending,omitempty"`     // queued behind the previous (still running) run
+	}
+	JobSchedules []*JobScheduleInfo
+)
+
+/////////////////
+// JobSchedule //
+/////////////////
+
+func (s *JobSchedule) Validate() error {
+	if s.Name == "" {
+		return errors.New("job schedule: missing name")
+	}
+	if err := cos.CheckAlphaPlus(s.Name, "job schedule name"); err != nil {
+		return err
+	}
+	if _, err := cos.ParseCron(s.Cron); err != nil {
+		return fmt.Errorf("job schedule %q: %w", s.Name, err)
+	}
+	switch s.Overlap {
+	case "":
+		s.Overlap = SchedOverlapSkip
+	case SchedOverlapSkip, SchedOverlapQueue:
+	default:
+		return fmt.Errorf("job schedule %q: invalid overlap policy %q (expecting %q or %q)",
+			s.Name, s.Overlap, SchedOverlapSkip, SchedOverlapQueue)
+	}
+	if s.IsBckAction() {
+		if err := s.Bck.Validate(); err != nil {
+			return fmt.Errorf("job schedule %q: %w", s.Name, err)
+		}
+		if !slices.Contains(SchedBckActs, s.Msg.Action) {
+			return fmt.Errorf("job schedule %q: bucket action %q cannot be scheduled (expecting one of: %v)",
+				s.Name, s.Msg.Action, SchedBckActs)
+		}
+		if s.BckTo != nil {
+			if err := s.BckTo.Validate(); err != nil {
+				return fmt.Errorf("job schedule %q: destination: %w", s.Name, err)
+			}
+		}
+		return nil
+	}
+	if s.Msg.Action != apc.ActXactStart {
+		return fmt.Errorf("job schedule %q: expecting %q action or bucket, got %q with no bucket",
+			s.Name, apc.ActXactStart, s.Msg.Action)
+	}
+	if s.BckTo != nil {
+		return fmt.Errorf("job schedule %q: destination bucket requires source bucket", s.Name)
+	}
+	return nil
+}
+
+func (s *JobSchedule) IsBckAction() bool { return s.Bck != nil && !s.Bck.IsEmpty() }
+
+// next run time strictly after `after` (UTC); zero time if none
+func (s *JobSchedule) Next(after time.Time) time.Time {
+	c, err := cos.ParseCron(s.Cron)
+	if err != nil {
+		return time.Time{}
+	}
+	return c.Next(after.UTC())
+}
+
+func (s *JobSchedule) String() string {
+	if s.IsBckAction() {
+		return fmt.Sprintf("job-schedule[%s, %q, %s %s]", s.Name, s.Cron, s.Msg.Action, s.Bck.Cname(""))
+	}
+	return fmt.Sprintf("job-schedule[%s, %q, %s]", s.Name, s.Cron, s.Msg.Action)
+}
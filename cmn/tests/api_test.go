@@ -5,8 +5,11 @@
 package tests_test
 
 import (
+	"time"
+
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -79,6 +82,154 @@ var _ = Describe("API", func() {
 					},
 				},
 			),
+			Entry("lifecycle rules (replaced as a whole)",
+				cmn.Bprops{
+					Lifecycle: cmn.LifecycleConf{
+						Rules: []cmn.LifecycleRule{{ID: "old", ExpirationDays: 1}},
+					},
+				},
+				cmn.BpropsToSet{
+					Lifecycle: &cmn.LifecycleConfToSet{
+						Rules:   &[]cmn.LifecycleRule{{ID: "new", Prefix: "tmp/", ExpirationDays: 7}},
+						Enabled: apc.Ptr(true),
+					},
+				},
+				cmn.Bprops{
+					Lifecycle: cmn.LifecycleConf{
+						Rules:   []cmn.LifecycleRule{{ID: "new", Prefix: "tmp/", ExpirationDays: 7}},
+						Enabled: true,
+					},
+				},
+			),
+			Entry("policy statements (replaced as a whole)",
+				cmn.Bprops{
+					Policy: cmn.PolicyConf{
+						Statements: []cmn.PolicyStatement{{Effect: cmn.PolicyDeny, Principals: []string{"*"}, Actions: []string{"rw"}, Resources: []string{"*"}}},
+					},
+				},
+				cmn.BpropsToSet{
+					Policy: &cmn.PolicyConfToSet{
+						Statements: &[]cmn.PolicyStatement{{Effect: cmn.PolicyAllow, Principals: []string{"alice"}, Actions: []string{"ro"}, Resources: []string{"logs/*"}}},
+					},
+				},
+				cmn.Bprops{
+					Policy: cmn.PolicyConf{
+						Statements: []cmn.PolicyStatement{{Effect: cmn.PolicyAllow, Principals: []string{"alice"}, Actions: []string{"ro"}, Resources: []string{"logs/*"}}},
+					},
+				},
+			),
+			Entry("CORS rules (replaced as a whole)",
+				cmn.Bprops{
+					CORS: cmn.CORSConf{
+						Rules: []cmn.CORSRule{{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"GET"}}},
+					},
+				},
+				cmn.BpropsToSet{
+					CORS: &cmn.CORSConfToSet{
+						Rules: &[]cmn.CORSRule{{ID: "app", AllowedOrigins: []string{"https://app.example.com"}, AllowedMethods: []string{"GET", "PUT"}}},
+					},
+				},
+				cmn.Bprops{
+					CORS: cmn.CORSConf{
+						Rules: []cmn.CORSRule{{ID: "app", AllowedOrigins: []string{"https://app.example.com"}, AllowedMethods: []string{"GET", "PUT"}}},
+					},
+				},
+			),
+			Entry("object lock default retention",
+				cmn.Bprops{
+					ObjectLock: cmn.ObjectLockConf{Enabled: true},
+				},
+				cmn.BpropsToSet{
+					ObjectLock: &cmn.ObjectLockConfToSet{
+						Mode: apc.Ptr(cmn.ObjLockCompliance),
+						Days: apc.Ptr(30),
+					},
+				},
+				cmn.Bprops{
+					ObjectLock: cmn.ObjectLockConf{Enabled: true, Mode: cmn.ObjLockCompliance, Days: 30},
+				},
+			),
+			Entry("bucket quota",
+				cmn.Bprops{
+					Quota: cmn.QuotaConf{HardObjs: 1000},
+				},
+				cmn.BpropsToSet{
+					Quota: &cmn.QuotaConfToSet{
+						SoftSize: apc.Ptr(cos.SizeIEC(cos.GiB)),
+						HardSize: apc.Ptr(cos.SizeIEC(2 * cos.GiB)),
+					},
+				},
+				cmn.Bprops{
+					Quota: cmn.QuotaConf{SoftSize: cos.GiB, HardSize: 2 * cos.GiB, HardObjs: 1000},
+				},
+			),
+			Entry("storage-class tiering",
+				cmn.Bprops{
+					Tiering: cmn.TieringConf{Hot: "nvme", Cold: "hdd", ColdAfter: cos.Duration(24 * time.Hour)},
+				},
+				cmn.BpropsToSet{
+					Tiering: &cmn.TieringConfToSet{
+						ColdAfter: apc.Ptr(cos.Duration(7 * 24 * time.Hour)),
+						Enabled:   apc.Ptr(true),
+					},
+				},
+				cmn.Bprops{
+					Tiering: cmn.TieringConf{Hot: "nvme", Cold: "hdd", ColdAfter: cos.Duration(7 * 24 * time.Hour), Enabled: true},
+				},
+			),
+			Entry("version history",
+				cmn.Bprops{
+					Versioning: cmn.VersionConf{Enabled: true},
+				},
+				cmn.BpropsToSet{
+					Versioning: &cmn.VersionConfToSet{
+						KeepVersions: apc.Ptr(5),
+						KeepDays:     apc.Ptr(30),
+					},
+				},
+				cmn.Bprops{
+					Versioning: cmn.VersionConf{Enabled: true, KeepVersions: 5, KeepDays: 30},
+				},
+			),
+			Entry("event notification rules (replaced as a whole)",
+				cmn.Bprops{
+					Events: cmn.EventsConf{
+						Rules: []cmn.EventRule{{Events: []string{cmn.EventObjCreated}, Queue: "new"}},
+					},
+				},
+				cmn.BpropsToSet{
+					Events: &cmn.EventsConfToSet{
+						Rules: &[]cmn.EventRule{{ID: "hook", Events: []string{cmn.EventObjRemoved}, Webhook: "http://example.com/events"}},
+					},
+				},
+				cmn.Bprops{
+					Events: cmn.EventsConf{
+						Rules: []cmn.EventRule{{ID: "hook", Events: []string{cmn.EventObjRemoved}, Webhook: "http://example.com/events"}},
+					},
+				},
+			),
+			Entry("compression codec",
+				cmn.Bprops{
+					Compression: cmn.CompressionConf{Codec: apc.CodecLZ4},
+				},
+				cmn.BpropsToSet{
+					Compression: &cmn.CompressionConfToSet{Codec: apc.Ptr(apc.CodecZstd)},
+				},
+				cmn.Bprops{
+					Compression: cmn.CompressionConf{Codec: apc.CodecZstd},
+				},
+			),
+			Entry("encryption (data key retained)",
+				cmn.Bprops{
+					Encryption: cmn.EncryptionConf{Key: "wrapped", KekID: "kek", Enabled: true},
+				},
+				cmn.BpropsToSet{
+					Encryption: &cmn.EncryptionConfToSet{Enabled: apc.Ptr(false)},
+				},
+				cmn.Bprops{
+					Encryption: cmn.EncryptionConf{Key: "wrapped", KekID: "kek"},
+				},
+			),
 			Entry("multiple nested fields and non-empty initial struct",
 				cmn.Bprops{
 					Provider: apc.AWS,
@@ -691,6 +691,17 @@ func TestWritePolicyConfValidateDefaults(t *testing.T) {
 		"write_policy.md: got %q, expected %q", c.MD, apc.WriteImmediate)
 }
 
+func TestWritePolicyConfValidateData(t *testing.T) {
+	c := cmn.WritePolicyConf{Data: apc.WriteDelayed}
+	tassert.CheckFatal(t, c.Validate())
+
+	c = cmn.WritePolicyConf{Data: apc.WriteNever}
+	tassert.Fatalf(t, c.Validate() != nil, "write_policy.data %q must be rejected", apc.WriteNever)
+
+	c = cmn.WritePolicyConf{Data: apc.WriteImmediate, MD: apc.WriteNever}
+	tassert.CheckFatal(t, c.Validate())
+}
+
 func TestUpdateClusterConfigSparseOverride(t *testing.T) {
 	oldConfig := cmn.GCO.Get()
 	defer func() {
@@ -739,3 +750,25 @@ func TestNodeJoinNonceWindow(t *testing.T) {
 	auth.IntraCluster.NonceWindow = cos.Duration(2 * time.Minute)
 	tassert.Fatalf(t, auth.NodeJoinNonceWindow() == 2*time.Minute, "unexpected configured value %v", auth.NodeJoinNonceWindow())
 }
+
+func TestParseHrwWeight(t *testing.T) {
+	tests := []struct {
+		val    string
+		auto   bool
+		weight uint64
+		ok     bool
+	}{
+		{"", false, 0, true},
+		{cmn.HrwWeightAuto, true, 0, true},
+		{"60", false, 60, true},
+		{"0", false, 0, false},
+		{"-1", false, 0, false},
+		{"60TB", false, 0, false},
+	}
+	for _, test := range tests {
+		lc := cmn.LocalConfig{HrwWeight: test.val}
+		auto, weight, err := lc.ParseHrwWeight()
+		tassert.Errorf(t, (err == nil) == test.ok, "%q: unexpected error %v", test.val, err)
+		tassert.Errorf(t, auto == test.auto && weight == test.weight, "%q: got (%t, %d)", test.val, auto, weight)
+	}
+}
@@ -0,0 +1,57 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests_test
+
+import (
+	"github.com/NVIDIA/aistore/cmn"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CORS", func() {
+	DescribeTable("validate",
+		func(rule cmn.CORSRule, ok bool) {
+			conf := &cmn.CORSConf{Rules: []cmn.CORSRule{rule}}
+			err := conf.ValidateAsProps()
+			if ok {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+		Entry("any origin", cmn.CORSRule{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"GET", "HEAD"}}, true),
+		Entry("wildcard subdomain", cmn.CORSRule{AllowedOrigins: []string{"https://*.example.com"}, AllowedMethods: []string{"PUT"},
+			AllowedHeaders: []string{"x-amz-*"}, ExposeHeaders: []string{"ETag"}, MaxAgeSeconds: 3600}, true),
+		Entry("no origins", cmn.CORSRule{AllowedMethods: []string{"GET"}}, false),
+		Entry("two wildcards", cmn.CORSRule{AllowedOrigins: []string{"https://*.*.com"}, AllowedMethods: []string{"GET"}}, false),
+		Entry("no methods", cmn.CORSRule{AllowedOrigins: []string{"*"}}, false),
+		Entry("unsupported method", cmn.CORSRule{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"PATCH"}}, false),
+		Entry("wildcard expose header", cmn.CORSRule{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"GET"}, ExposeHeaders: []string{"*"}}, false),
+		Entry("negative max-age", cmn.CORSRule{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"GET"}, MaxAgeSeconds: -1}, false),
+	)
+
+	It("should match the first rule that allows origin, method, and headers", func() {
+		conf := &cmn.CORSConf{Rules: []cmn.CORSRule{
+			{ID: "app", AllowedOrigins: []string{"https://*.example.com"}, AllowedMethods: []string{"GET", "PUT"}, AllowedHeaders: []string{"Content-*", "x-amz-date"}},
+			{ID: "public", AllowedOrigins: []string{"*"}, AllowedMethods: []string{"GET"}},
+		}}
+		Expect(conf.ValidateAsProps()).NotTo(HaveOccurred())
+
+		rule := conf.Match("https://labels.example.com", "PUT", []string{"content-type", "X-Amz-Date"})
+		Expect(rule).NotTo(BeNil())
+		Expect(rule.ID).To(Equal("app"))
+		Expect(rule.AnyOrigin()).To(BeFalse())
+
+		Expect(conf.Match("https://labels.example.com", "PUT", []string{"authorization"})).To(BeNil())
+		Expect(conf.Match("https://example.com", "PUT", nil)).To(BeNil())
+		Expect(conf.Match("https://evil.com", "DELETE", nil)).To(BeNil())
+
+		rule = conf.Match("https://evil.com", "GET", nil)
+		Expect(rule).NotTo(BeNil())
+		Expect(rule.ID).To(Equal("public"))
+		Expect(rule.AnyOrigin()).To(BeTrue())
+	})
+})
@@ -22,3 +22,22 @@ func TestAbortedErrorAs(t *testing.T) {
 	mockError := fmt.Errorf("wrapping aborted error %w", abortedError)
 	tassert.Fatalf(t, cmn.IsErrAborted(mockError), "expected errors.As to return true on a wrapped error")
 }
+
+func TestLimitedCoexistenceErrorAs(t *testing.T) {
+	limco := cmn.NewErrLimitedCoexistence("t[abc]", "rebalance[xyz]", "copy-bck", "ais://src")
+	tassert.Fatalf(t, cmn.IsErrLimitedCoexistence(limco), "expected true on the same error type")
+	tassert.Fatalf(t, cmn.IsErrLimitedCoexistence(fmt.Errorf("begin: %w", limco)), "expected true on a wrapped error")
+
+	// received from another node
+	herr := cmn.NewErrHTTP(nil, limco, 0)
+	tassert.Fatalf(t, cmn.IsErrLimitedCoexistence(herr), "expected true on ErrHTTP (type code %q)", herr.TypeCode)
+	herr = cmn.NewErrHTTP(nil, fmt.Errorf("failed to begin: %w", limco), 0)
+	tassert.Fatalf(t, cmn.IsErrLimitedCoexistence(herr), "expected true on ErrHTTP (wrapped, type code %q)", herr.TypeCode)
+
+	// by type code only (not by message text)
+	herr = cmn.NewErrHTTP(nil, fmt.Errorf("failed to begin: %v", limco), 0)
+	tassert.Fatalf(t, !cmn.IsErrLimitedCoexistence(herr), "expected false on ErrHTTP with no type code")
+
+	herr = cmn.NewErrHTTP(nil, cmn.NewErrBusy("bucket", "ais://src"), 0)
+	tassert.Fatalf(t, !cmn.IsErrLimitedCoexistence(herr), "expected false on ErrHTTP(ErrBusy)")
+}
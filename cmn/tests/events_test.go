@@ -0,0 +1,53 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests_test
+
+import (
+	"github.com/NVIDIA/aistore/cmn"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Events", func() {
+	created := []string{cmn.EventObjCreated}
+
+	DescribeTable("validate",
+		func(rule cmn.EventRule, ok bool) {
+			conf := &cmn.EventsConf{Rules: []cmn.EventRule{rule}}
+			err := conf.ValidateAsProps()
+			if ok {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+		Entry("webhook", cmn.EventRule{Events: created, Webhook: "https://example.com:8443/events"}, true),
+		Entry("queue", cmn.EventRule{ID: "shards", Events: []string{cmn.EventObjCreatedPut, cmn.EventObjRemovedDelete},
+			Prefix: "shards/", Suffix: ".tar", Queue: "new-shards", MaxBacklog: 100}, true),
+		Entry("no events", cmn.EventRule{Queue: "q"}, false),
+		Entry("unsupported event", cmn.EventRule{Events: []string{"s3:ObjectRestore:*"}, Queue: "q"}, false),
+		Entry("both destinations", cmn.EventRule{Events: created, Queue: "q", Webhook: "http://example.com"}, false),
+		Entry("no destination", cmn.EventRule{Events: created}, false),
+		Entry("unsupported URL scheme", cmn.EventRule{Events: created, Webhook: "ftp://example.com"}, false),
+		Entry("no URL host", cmn.EventRule{Events: created, Webhook: "http:///events"}, false),
+		Entry("invalid queue name", cmn.EventRule{Events: created, Queue: "../q"}, false),
+		Entry("negative backlog", cmn.EventRule{Events: created, Queue: "q", MaxBacklog: -1}, false),
+	)
+
+	It("should match event types and object names", func() {
+		rule := &cmn.EventRule{Events: []string{cmn.EventObjCreated, cmn.EventObjRemovedDelete}, Prefix: "logs/", Suffix: ".gz", Queue: "q"}
+		Expect(rule.Match(cmn.EventObjCreatedPut, "logs/a.gz")).To(BeTrue())
+		Expect(rule.Match(cmn.EventObjCreatedMpt, "logs/b.gz")).To(BeTrue())
+		Expect(rule.Match(cmn.EventObjRemovedDelete, "logs/a.gz")).To(BeTrue())
+		Expect(rule.Match(cmn.EventObjRemovedRename, "logs/a.gz")).To(BeFalse())
+		Expect(rule.Match(cmn.EventObjCreatedPut, "logs/a.txt")).To(BeFalse())
+		Expect(rule.Match(cmn.EventObjCreatedPut, "a.gz")).To(BeFalse())
+
+		Expect(cmn.IsObjCreatedEvent(cmn.EventObjCreatedRename)).To(BeTrue())
+		Expect(cmn.IsObjCreatedEvent(cmn.EventObjRemovedRename)).To(BeFalse())
+		Expect(rule.Backlog()).To(BeEquivalentTo(cmn.DfltEventsMaxBacklog))
+	})
+})
@@ -0,0 +1,81 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests_test
+
+import (
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Lifecycle", func() {
+	const day = 24 * time.Hour
+
+	DescribeTable("validate",
+		func(rules []cmn.LifecycleRule, ok bool) {
+			lcy := &cmn.LifecycleConf{Rules: rules, Enabled: true}
+			err := lcy.ValidateAsProps()
+			if ok {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+		Entry("no rules", nil, true),
+		Entry("expiration", []cmn.LifecycleRule{{ID: "a", ExpirationDays: 30}}, true),
+		Entry("abort-mpt", []cmn.LifecycleRule{{ID: "a", Prefix: "tmp/", AbortMptDays: 1}}, true),
+		Entry("empty ID", []cmn.LifecycleRule{{ExpirationDays: 1}}, false),
+		Entry("too long ID", []cmn.LifecycleRule{{ID: strings.Repeat("x", 256), ExpirationDays: 1}}, false),
+		Entry("no action", []cmn.LifecycleRule{{ID: "a", Prefix: "tmp/"}}, false),
+		Entry("negative days", []cmn.LifecycleRule{{ID: "a", ExpirationDays: -1}}, false),
+		Entry("duplicate IDs", []cmn.LifecycleRule{{ID: "a", ExpirationDays: 1}, {ID: "a", AbortMptDays: 1}}, false),
+		Entry("abort-mpt with tags", []cmn.LifecycleRule{{ID: "a", AbortMptDays: 1, Tags: cos.StrKVs{"k": "v"}}}, false),
+		Entry("empty tag key", []cmn.LifecycleRule{{ID: "a", ExpirationDays: 1, Tags: cos.StrKVs{"": "v"}}}, false),
+	)
+
+	It("should select expired objects by prefix and tags", func() {
+		var (
+			now = time.Now()
+			lcy = &cmn.LifecycleConf{
+				Enabled: true,
+				Rules: []cmn.LifecycleRule{
+					{ID: "disabled", ExpirationDays: 1, Disabled: true},
+					{ID: "tmp", Prefix: "tmp/", ExpirationDays: 7},
+					{ID: "logs", Prefix: "logs/", ExpirationDays: 1, Tags: cos.StrKVs{"class": "debug"}},
+					{ID: "mpt", AbortMptDays: 1},
+				},
+			}
+			md = cos.StrKVs{"class": "debug"}
+		)
+		Expect(lcy.IsActive()).To(BeTrue())
+
+		Expect(lcy.Expired("tmp/a", nil, now.Add(-8*day), now)).To(Equal("tmp"))
+		Expect(lcy.Expired("tmp/a", nil, now.Add(-6*day), now)).To(BeEmpty())
+		Expect(lcy.Expired("data/a", nil, now.Add(-100*day), now)).To(BeEmpty())
+
+		Expect(lcy.Expired("logs/a", md, now.Add(-2*day), now)).To(Equal("logs"))
+		Expect(lcy.Expired("logs/a", nil, now.Add(-2*day), now)).To(BeEmpty())
+		Expect(lcy.Expired("logs/a", cos.StrKVs{"class": "info"}, now.Add(-2*day), now)).To(BeEmpty())
+
+		// object tags
+		tagged := cos.StrKVs{cmn.TagsObjMD: cmn.EncodeObjTags(cos.StrKVs{"class": "debug", "owner": "x"})}
+		Expect(lcy.Expired("logs/a", tagged, now.Add(-2*day), now)).To(Equal("logs"))
+		tagged[cmn.TagsObjMD] = cmn.EncodeObjTags(cos.StrKVs{"class": "info"})
+		Expect(lcy.Expired("logs/a", tagged, now.Add(-2*day), now)).To(BeEmpty())
+	})
+
+	It("should not be active when disabled", func() {
+		lcy := &cmn.LifecycleConf{Rules: []cmn.LifecycleRule{{ID: "a", ExpirationDays: 1}}}
+		Expect(lcy.IsActive()).To(BeFalse())
+		lcy.Enabled = true
+		lcy.Rules[0].Disabled = true
+		Expect(lcy.IsActive()).To(BeFalse())
+	})
+})
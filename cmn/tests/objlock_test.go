@@ -0,0 +1,97 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests_test
+
+import (
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ObjectLock", func() {
+	var (
+		now   = time.Now()
+		later = now.Add(24 * time.Hour)
+	)
+
+	DescribeTable("validate",
+		func(conf cmn.ObjectLockConf, ok bool) {
+			err := conf.ValidateAsProps()
+			if ok {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+		Entry("disabled", cmn.ObjectLockConf{}, true),
+		Entry("enabled", cmn.ObjectLockConf{Enabled: true}, true),
+		Entry("default retention", cmn.ObjectLockConf{Enabled: true, Mode: cmn.ObjLockCompliance, Days: 365}, true),
+		Entry("retention when disabled", cmn.ObjectLockConf{Mode: cmn.ObjLockGovernance, Days: 1}, false),
+		Entry("days without mode", cmn.ObjectLockConf{Enabled: true, Days: 1}, false),
+		Entry("invalid mode", cmn.ObjectLockConf{Enabled: true, Mode: "strict", Days: 1}, false),
+		Entry("negative days", cmn.ObjectLockConf{Enabled: true, Mode: cmn.ObjLockGovernance, Days: -1}, false),
+	)
+
+	It("should parse retention and legal hold from custom metadata", func() {
+		until := later.UTC().Truncate(time.Second)
+		ret := cmn.ObjRetention{RetainUntil: until, Mode: cmn.ObjLockGovernance}
+		custom := cos.StrKVs{"color": "blue", cmn.LegalHoldObjMD: cmn.LegalHoldOn}
+		ret.ToMD(custom)
+
+		parsed := cmn.ObjRetentionFromMD(custom)
+		Expect(parsed.RetainUntil.Equal(until)).To(BeTrue())
+		Expect(parsed.Mode).To(Equal(cmn.ObjLockGovernance))
+		Expect(parsed.LegalHold).To(BeTrue())
+		Expect(parsed.Locked(now)).To(BeTrue())
+
+		(&cmn.ObjRetention{}).ToMD(custom)
+		Expect(custom).To(Equal(cos.StrKVs{"color": "blue", cmn.LegalHoldObjMD: cmn.LegalHoldOn}))
+	})
+
+	It("should block modifications of retained objects", func() {
+		gov := cmn.ObjRetention{RetainUntil: later, Mode: cmn.ObjLockGovernance}
+		err := gov.Check("ais://b/o", now, false)
+		Expect(cmn.IsErrObjLocked(err)).To(BeTrue())
+		Expect(gov.Check("ais://b/o", now, true)).NotTo(HaveOccurred())
+		Expect(gov.Check("ais://b/o", later.Add(time.Second), false)).NotTo(HaveOccurred())
+
+		comp := cmn.ObjRetention{RetainUntil: later, Mode: cmn.ObjLockCompliance}
+		Expect(cmn.IsErrObjLocked(comp.Check("ais://b/o", now, true))).To(BeTrue())
+
+		hold := cmn.ObjRetention{LegalHold: true}
+		Expect(cmn.IsErrObjLocked(hold.Check("ais://b/o", now, true))).To(BeTrue())
+	})
+
+	It("should only extend compliance retention", func() {
+		comp := cmn.ObjRetention{RetainUntil: later, Mode: cmn.ObjLockCompliance}
+		extend := &cmn.ObjRetention{RetainUntil: later.Add(time.Hour), Mode: cmn.ObjLockCompliance}
+		shorten := &cmn.ObjRetention{RetainUntil: later.Add(-time.Hour), Mode: cmn.ObjLockCompliance}
+		toGov := &cmn.ObjRetention{RetainUntil: later.Add(time.Hour), Mode: cmn.ObjLockGovernance}
+
+		Expect(comp.ValidateUpdate(extend, now, false)).NotTo(HaveOccurred())
+		Expect(comp.ValidateUpdate(shorten, now, true)).To(HaveOccurred())
+		Expect(comp.ValidateUpdate(toGov, now, true)).To(HaveOccurred())
+		Expect(comp.ValidateUpdate(&cmn.ObjRetention{}, now, true)).To(HaveOccurred())
+	})
+
+	It("should shorten or remove governance retention only with bypass", func() {
+		gov := cmn.ObjRetention{RetainUntil: later, Mode: cmn.ObjLockGovernance}
+		shorten := &cmn.ObjRetention{RetainUntil: later.Add(-time.Hour), Mode: cmn.ObjLockGovernance}
+		toComp := &cmn.ObjRetention{RetainUntil: later, Mode: cmn.ObjLockCompliance}
+
+		Expect(gov.ValidateUpdate(shorten, now, false)).To(HaveOccurred())
+		Expect(gov.ValidateUpdate(shorten, now, true)).NotTo(HaveOccurred())
+		Expect(gov.ValidateUpdate(&cmn.ObjRetention{}, now, false)).To(HaveOccurred())
+		Expect(gov.ValidateUpdate(&cmn.ObjRetention{}, now, true)).NotTo(HaveOccurred())
+		Expect(gov.ValidateUpdate(toComp, now, false)).NotTo(HaveOccurred())
+
+		past := &cmn.ObjRetention{RetainUntil: now.Add(-time.Hour), Mode: cmn.ObjLockGovernance}
+		Expect((&cmn.ObjRetention{}).ValidateUpdate(past, now, true)).To(HaveOccurred())
+	})
+})
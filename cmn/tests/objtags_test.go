@@ -0,0 +1,95 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests_test
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Object tags", func() {
+	It("should encode deterministically and parse back", func() {
+		tags := cos.StrKVs{"retention": "7y", "class": "pii", "path": "a/b c&d=e:f"}
+		s := cmn.EncodeObjTags(tags)
+		Expect(s).To(HavePrefix("class=pii&path="))
+		Expect(s).NotTo(ContainSubstring(" "))
+		Expect(s).NotTo(ContainSubstring(":"))
+
+		parsed, err := cmn.ParseObjTags(s)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parsed).To(Equal(tags))
+
+		Expect(cmn.EncodeObjTags(nil)).To(BeEmpty())
+		parsed, err = cmn.ParseObjTags("")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parsed).To(BeEmpty())
+	})
+
+	It("should reserve system metadata keys", func() {
+		Expect(cmn.ValidateUserMD(cos.StrKVs{"tags": "anything", "class": "pii"})).NotTo(HaveOccurred())
+		Expect(cmn.ValidateUserMD(cos.StrKVs{cmn.TagsObjMD: "class=pii"})).NotTo(HaveOccurred())
+		Expect(cmn.ValidateUserMD(cos.StrKVs{cmn.TagsObjMD: "k=%zz"})).To(HaveOccurred())
+		Expect(cmn.ValidateUserMD(cos.StrKVs{cmn.SnapWrittenObjMD: "1"})).To(HaveOccurred())
+		Expect(cmn.ValidateUserMD(cos.StrKVs{cmn.VerSupersededObjMD: "1"})).To(HaveOccurred())
+		Expect(cmn.ValidateUserMD(cos.StrKVs{"ais.other": "v"})).To(HaveOccurred())
+
+		hdr := http.Header{}
+		hdr.Add(apc.HdrObjCustomMD, "tags=v")
+		Expect(cmn.ValidateUserHdr(hdr)).NotTo(HaveOccurred())
+		hdr.Add(apc.HdrObjCustomMD, cmn.SnapWrittenObjMD+"=1")
+		Expect(cmn.ValidateUserHdr(hdr)).To(HaveOccurred())
+	})
+
+	It("should get tags from custom metadata", func() {
+		custom := cos.StrKVs{cmn.SourceObjMD: "aws", cmn.TagsObjMD: "class=pii"}
+		tags, err := cmn.ObjTags(custom)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tags).To(Equal(cos.StrKVs{"class": "pii"}))
+
+		tags, err = cmn.ObjTags(cos.StrKVs{"class": "pii"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tags).To(BeEmpty())
+	})
+
+	DescribeTable("validate",
+		func(s string, ok bool) {
+			_, err := cmn.ParseObjTags(s)
+			if ok {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+		Entry("single", "k=v", true),
+		Entry("empty value", "k=", true),
+		Entry("empty key", "=v", false),
+		Entry("duplicate key", "k=v1&k=v2", false),
+		Entry("bad escape", "k=%zz", false),
+		Entry("long key", strings.Repeat("k", cmn.MaxObjTagKeyLen+1)+"=v", false),
+		Entry("long value", "k="+strings.Repeat("v", cmn.MaxObjTagValLen+1), false),
+		Entry("max tags", func() string {
+			kvs := make([]string, 0, cmn.MaxObjTags)
+			for i := range cmn.MaxObjTags {
+				kvs = append(kvs, "k"+strconv.Itoa(i)+"=v")
+			}
+			return strings.Join(kvs, "&")
+		}(), true),
+		Entry("too many tags", func() string {
+			kvs := make([]string, 0, cmn.MaxObjTags+1)
+			for i := range cmn.MaxObjTags + 1 {
+				kvs = append(kvs, "k"+strconv.Itoa(i)+"=v")
+			}
+			return strings.Join(kvs, "&")
+		}(), false),
+	)
+})
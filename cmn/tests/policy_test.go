@@ -0,0 +1,149 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests_test
+
+import (
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Bucket policy", func() {
+	stmt := func(effect string, principals, actions, resources []string) cmn.PolicyStatement {
+		return cmn.PolicyStatement{Effect: effect, Principals: principals, Actions: actions, Resources: resources}
+	}
+
+	DescribeTable("validate",
+		func(s cmn.PolicyStatement, ok bool) {
+			conf := &cmn.PolicyConf{Statements: []cmn.PolicyStatement{s}}
+			err := conf.ValidateAsProps()
+			if ok {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+		Entry("allow", stmt("allow", []string{"*"}, []string{"GET"}, []string{"*"}), true),
+		Entry("deny (any case)", stmt("Deny", []string{"alice"}, []string{"s3:PutObject"}, []string{"logs/*"}), true),
+		Entry("authenticated", stmt("allow", []string{cmn.PolicyAuthPrincipal}, []string{"ro"}, []string{"*"}), true),
+		Entry("invalid effect", stmt("permit", []string{"*"}, []string{"GET"}, []string{"*"}), false),
+		Entry("no principals", stmt("allow", nil, []string{"GET"}, []string{"*"}), false),
+		Entry("wildcard principal", stmt("allow", []string{"al*"}, []string{"GET"}, []string{"*"}), false),
+		Entry("unknown action", stmt("allow", []string{"*"}, []string{"FLY"}, []string{"*"}), false),
+		Entry("unsupported s3 action", stmt("allow", []string{"*"}, []string{"s3:GetObjectRetention"}, []string{"*"}), false),
+		Entry("no resources", stmt("allow", []string{"*"}, []string{"GET"}, nil), false),
+		Entry("inner wildcard", stmt("allow", []string{"*"}, []string{"GET"}, []string{"a*b"}), false),
+	)
+
+	It("should limit the number of statements", func() {
+		conf := &cmn.PolicyConf{}
+		for range cmn.PolicyMaxStatements + 1 {
+			conf.Statements = append(conf.Statements, stmt("allow", []string{"*"}, []string{"GET"}, []string{"*"}))
+		}
+		Expect(conf.ValidateAsProps()).To(HaveOccurred())
+	})
+
+	It("should evaluate allow, deny, and prefixes", func() {
+		conf := &cmn.PolicyConf{Statements: []cmn.PolicyStatement{
+			stmt("allow", []string{"*"}, []string{"s3:GetObject"}, []string{"public/*"}),
+			stmt("allow", []string{"alice"}, []string{"rw"}, []string{"*"}),
+			{Sid: "no-tmp", Effect: "deny", Principals: []string{"alice"}, Actions: []string{"DELETE-OBJECT"}, Resources: []string{"tmp/*"}},
+		}}
+		Expect(conf.ValidateAsProps()).NotTo(HaveOccurred())
+
+		// anonymous
+		verdict, _ := conf.Eval("", apc.AceGET, "public/a.txt")
+		Expect(verdict).To(Equal(cmn.PolicyAllowed))
+		verdict, _ = conf.Eval("", apc.AceGET, "private/a.txt")
+		Expect(verdict).To(Equal(cmn.PolicyNoDecision))
+		verdict, _ = conf.Eval("", apc.AcePUT, "public/a.txt")
+		Expect(verdict).To(Equal(cmn.PolicyNoDecision))
+
+		// bucket-level operations match only "*"
+		verdict, _ = conf.Eval("bob", apc.AceObjLIST, "")
+		Expect(verdict).To(Equal(cmn.PolicyNoDecision))
+		verdict, _ = conf.Eval("alice", apc.AceObjLIST, "")
+		Expect(verdict).To(Equal(cmn.PolicyAllowed))
+
+		// explicit deny wins
+		verdict, sid := conf.Eval("alice", apc.AceObjDELETE, "tmp/x")
+		Expect(verdict).To(Equal(cmn.PolicyDenied))
+		Expect(sid).To(Equal("no-tmp"))
+		verdict, _ = conf.Eval("alice", apc.AceObjDELETE, "data/x")
+		Expect(verdict).To(Equal(cmn.PolicyAllowed))
+	})
+
+	It("should require all requested permissions", func() {
+		conf := &cmn.PolicyConf{Statements: []cmn.PolicyStatement{
+			stmt("allow", []string{"bob"}, []string{"GET"}, []string{"*"}),
+			stmt("allow", []string{"bob"}, []string{"HEAD-OBJECT"}, []string{"data/*"}),
+		}}
+		verdict, _ := conf.Eval("bob", apc.AceGET|apc.AceObjHEAD, "data/x")
+		Expect(verdict).To(Equal(cmn.PolicyAllowed))
+		verdict, _ = conf.Eval("bob", apc.AceGET|apc.AceObjHEAD, "other/x")
+		Expect(verdict).To(Equal(cmn.PolicyNoDecision))
+	})
+
+	It("should distinguish anonymous and authenticated principals", func() {
+		conf := &cmn.PolicyConf{Statements: []cmn.PolicyStatement{
+			stmt("allow", []string{cmn.PolicyAuthPrincipal}, []string{"ro"}, []string{"*"}),
+		}}
+		verdict, _ := conf.Eval("", apc.AceGET, "a")
+		Expect(verdict).To(Equal(cmn.PolicyNoDecision))
+		verdict, _ = conf.Eval("carol", apc.AceGET, "a")
+		Expect(verdict).To(Equal(cmn.PolicyAllowed))
+	})
+
+	It("should fail closed evaluating multi-object scopes", func() {
+		conf := &cmn.PolicyConf{Statements: []cmn.PolicyStatement{
+			stmt("allow", []string{"alice"}, []string{"rw"}, []string{"*"}),
+			{Sid: "no-tmp", Effect: "deny", Principals: []string{"alice"}, Actions: []string{"DELETE-OBJECT", "LIST-OBJECTS"}, Resources: []string{"data/tmp/*"}},
+			{Sid: "no-key", Effect: "deny", Principals: []string{"alice"}, Actions: []string{"DELETE-OBJECT"}, Resources: []string{"keys/master"}},
+		}}
+		Expect(conf.ValidateAsProps()).NotTo(HaveOccurred())
+
+		// entire bucket, enclosing and enclosed prefixes
+		for _, prefix := range []string{"", "data/", "data/tmp/old/", "keys/"} {
+			verdict, _ := conf.EvalScope("alice", apc.AceObjDELETE, &cmn.PolicyScope{Prefix: prefix, Recursive: true})
+			Expect(verdict).To(Equal(cmn.PolicyDenied), "prefix %q", prefix)
+		}
+		for _, prefix := range []string{"data/x", "keys/other", "keys/master/"} {
+			verdict, _ := conf.EvalScope("alice", apc.AceObjDELETE, &cmn.PolicyScope{Prefix: prefix, Recursive: true})
+			Expect(verdict).To(Equal(cmn.PolicyAllowed), "prefix %q", prefix)
+		}
+		verdict, _ := conf.EvalScope("alice", apc.AceGET, &cmn.PolicyScope{Recursive: true})
+		Expect(verdict).To(Equal(cmn.PolicyAllowed))
+
+		// non-recursive listing does not descend into "data/tmp/"
+		verdict, _ = conf.EvalScope("alice", apc.AceObjLIST, &cmn.PolicyScope{Prefix: "data/"})
+		Expect(verdict).To(Equal(cmn.PolicyAllowed))
+		verdict, sid := conf.EvalScope("alice", apc.AceObjLIST, &cmn.PolicyScope{Prefix: "data/", Recursive: true})
+		Expect(verdict).To(Equal(cmn.PolicyDenied))
+		Expect(sid).To(Equal("no-tmp"))
+
+		// names: any denied name denies all
+		verdict, sid = conf.EvalScope("alice", apc.AceObjDELETE, &cmn.PolicyScope{Names: []string{"a", "keys/master"}})
+		Expect(verdict).To(Equal(cmn.PolicyDenied))
+		Expect(sid).To(Equal("no-key"))
+		verdict, _ = conf.EvalScope("alice", apc.AceObjDELETE, &cmn.PolicyScope{Names: []string{"a", "keys/other"}})
+		Expect(verdict).To(Equal(cmn.PolicyAllowed))
+	})
+
+	It("should require allow to cover all named objects", func() {
+		conf := &cmn.PolicyConf{Statements: []cmn.PolicyStatement{
+			stmt("allow", []string{"bob"}, []string{"DELETE-OBJECT"}, []string{"scratch/*"}),
+		}}
+		verdict, _ := conf.EvalScope("bob", apc.AceObjDELETE, &cmn.PolicyScope{Names: []string{"scratch/a", "scratch/b"}})
+		Expect(verdict).To(Equal(cmn.PolicyAllowed))
+		verdict, _ = conf.EvalScope("bob", apc.AceObjDELETE, &cmn.PolicyScope{Names: []string{"scratch/a", "data/b"}})
+		Expect(verdict).To(Equal(cmn.PolicyNoDecision))
+		verdict, _ = conf.EvalScope("bob", apc.AceObjDELETE, &cmn.PolicyScope{Prefix: "scratch/", Recursive: true})
+		Expect(verdict).To(Equal(cmn.PolicyAllowed))
+		verdict, _ = conf.EvalScope("bob", apc.AceObjDELETE, &cmn.PolicyScope{Recursive: true})
+		Expect(verdict).To(Equal(cmn.PolicyNoDecision))
+	})
+})
@@ -0,0 +1,80 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests_test
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Precond", func() {
+	var (
+		mtime  = time.Date(2026, 1, 2, 3, 4, 5, 600, time.UTC)
+		before = mtime.Add(-time.Hour).Format(http.TimeFormat)
+		after  = mtime.Add(time.Hour).Format(http.TimeFormat)
+		same   = mtime.Format(http.TimeFormat)
+	)
+	const etag = "abc"
+
+	It("should return nil when there are no (valid) preconditions", func() {
+		Expect(cmn.NewPrecond(http.Header{})).To(BeNil())
+		hdr := http.Header{}
+		hdr.Set(cos.HdrIfModifiedSince, "yesterday")
+		Expect(cmn.NewPrecond(hdr)).To(BeNil())
+	})
+
+	DescribeTable("eval",
+		func(hdrs []string, exists, read bool, expected int) {
+			hdr := http.Header{}
+			for i := 0; i < len(hdrs); i += 2 {
+				hdr.Set(hdrs[i], hdrs[i+1])
+			}
+			pc := cmn.NewPrecond(hdr)
+			Expect(pc).NotTo(BeNil())
+			ecode, err := pc.Eval(exists, etag, mtime, read)
+			Expect(ecode).To(Equal(expected))
+			if expected == http.StatusPreconditionFailed {
+				Expect(cmn.IsErrPrecondFailed(err)).To(BeTrue())
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+			}
+		},
+		// If-Match
+		Entry("if-match ok", []string{cos.HdrIfMatch, `"abc"`}, true, true, 0),
+		Entry("if-match list", []string{cos.HdrIfMatch, `"xyz", "abc"`}, true, true, 0),
+		Entry("if-match star", []string{cos.HdrIfMatch, "*"}, true, false, 0),
+		Entry("if-match mismatch", []string{cos.HdrIfMatch, `"xyz"`}, true, true, 412),
+		Entry("if-match weak", []string{cos.HdrIfMatch, `W/"abc"`}, true, true, 412),
+		Entry("if-match missing", []string{cos.HdrIfMatch, "*"}, false, false, 412),
+
+		// If-None-Match
+		Entry("if-none-match get", []string{cos.HdrIfNoneMatch, `"abc"`}, true, true, 304),
+		Entry("if-none-match weak get", []string{cos.HdrIfNoneMatch, `W/"abc"`}, true, true, 304),
+		Entry("if-none-match changed", []string{cos.HdrIfNoneMatch, `"xyz"`}, true, true, 0),
+		Entry("create-only exists", []string{cos.HdrIfNoneMatch, "*"}, true, false, 412),
+		Entry("create-only new", []string{cos.HdrIfNoneMatch, "*"}, false, false, 0),
+
+		// dates
+		Entry("if-modified-since not modified", []string{cos.HdrIfModifiedSince, same}, true, true, 304),
+		Entry("if-modified-since modified", []string{cos.HdrIfModifiedSince, before}, true, true, 0),
+		Entry("if-modified-since ignored on write", []string{cos.HdrIfModifiedSince, after}, true, false, 0),
+		Entry("if-unmodified-since ok", []string{cos.HdrIfUnmodifiedSince, same}, true, true, 0),
+		Entry("if-unmodified-since modified", []string{cos.HdrIfUnmodifiedSince, before}, true, false, 412),
+
+		// precedence
+		Entry("if-match overrides if-unmodified-since",
+			[]string{cos.HdrIfMatch, `"abc"`, cos.HdrIfUnmodifiedSince, before}, true, true, 0),
+		Entry("if-none-match overrides if-modified-since",
+			[]string{cos.HdrIfNoneMatch, `"xyz"`, cos.HdrIfModifiedSince, after}, true, true, 0),
+		Entry("if-match evaluated first",
+			[]string{cos.HdrIfMatch, `"xyz"`, cos.HdrIfNoneMatch, `"abc"`}, true, true, 412),
+	)
+})
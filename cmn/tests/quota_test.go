@@ -0,0 +1,52 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests_test
+
+import (
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("QuotaConf", func() {
+	DescribeTable("validate",
+		func(c cmn.QuotaConf, ok bool) {
+			err := c.ValidateAsProps()
+			if ok {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+		Entry("none", cmn.QuotaConf{}, true),
+		Entry("soft only", cmn.QuotaConf{SoftSize: cos.GiB, SoftObjs: 10}, true),
+		Entry("soft below hard", cmn.QuotaConf{SoftSize: cos.GiB, HardSize: 2 * cos.GiB, SoftObjs: 10, HardObjs: 20}, true),
+		Entry("negative size", cmn.QuotaConf{HardSize: -1}, false),
+		Entry("negative count", cmn.QuotaConf{SoftObjs: -1}, false),
+		Entry("soft size above hard", cmn.QuotaConf{SoftSize: 2 * cos.GiB, HardSize: cos.GiB}, false),
+		Entry("soft count above hard", cmn.QuotaConf{SoftObjs: 20, HardObjs: 10}, false),
+	)
+
+	It("should enforce hard limits", func() {
+		c := cmn.QuotaConf{HardSize: cos.MiB, HardObjs: 2}
+		used := cmn.QuotaUsage{Size: cos.MiB - cos.KiB, Objs: 1}
+		Expect(c.CheckHard(&used, cos.KiB, 1, "bucket", "ais://abc")).NotTo(HaveOccurred())
+		Expect(cmn.IsErrQuotaExceeded(c.CheckHard(&used, cos.KiB+1, 0, "bucket", "ais://abc"))).To(BeTrue())
+
+		used.Objs = 2
+		Expect(cmn.IsErrQuotaExceeded(c.CheckHard(&used, 0, 1, "bucket", "ais://abc"))).To(BeTrue())
+		// overwrite (no new objects)
+		Expect(c.CheckHard(&used, 0, 0, "bucket", "ais://abc")).NotTo(HaveOccurred())
+	})
+
+	It("should report exceeded soft limits", func() {
+		c := cmn.QuotaConf{SoftSize: cos.MiB, SoftObjs: 10}
+		Expect(c.SoftExceeded(&cmn.QuotaUsage{Size: cos.MiB, Objs: 10})).To(BeEmpty())
+		Expect(c.SoftExceeded(&cmn.QuotaUsage{Size: cos.MiB + 1})).NotTo(BeEmpty())
+		Expect(c.SoftExceeded(&cmn.QuotaUsage{Objs: 11})).NotTo(BeEmpty())
+	})
+})
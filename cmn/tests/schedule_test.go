@@ -0,0 +1,61 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests_test
+
+import (
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("JobSchedule", func() {
+	var (
+		src   = &cmn.Bck{Name: "src", Provider: apc.AIS}
+		dst   = &cmn.Bck{Name: "dst", Provider: apc.AIS}
+		start = apc.ActMsg{Action: apc.ActXactStart, Value: map[string]any{"kind": apc.ActLRU}}
+	)
+
+	DescribeTable("validate",
+		func(s cmn.JobSchedule, ok bool) {
+			err := s.Validate()
+			if ok {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+		Entry("xaction", cmn.JobSchedule{Name: "lru", Cron: "0 2 * * *", Msg: start}, true),
+		Entry("bucket action", cmn.JobSchedule{Name: "dr", Cron: "@weekly", Overlap: cmn.SchedOverlapQueue,
+			Bck: src, BckTo: dst, Msg: apc.ActMsg{Action: apc.ActCopyBck}}, true),
+		Entry("no name", cmn.JobSchedule{Cron: "0 2 * * *", Msg: start}, false),
+		Entry("invalid name", cmn.JobSchedule{Name: "a/b", Cron: "0 2 * * *", Msg: start}, false),
+		Entry("invalid cron", cmn.JobSchedule{Name: "lru", Cron: "0 2 * *", Msg: start}, false),
+		Entry("invalid overlap", cmn.JobSchedule{Name: "lru", Cron: "0 2 * * *", Overlap: "wait", Msg: start}, false),
+		Entry("not a bucket action", cmn.JobSchedule{Name: "x", Cron: "0 2 * * *", Bck: src,
+			Msg: apc.ActMsg{Action: apc.ActDestroyBck}}, false),
+		Entry("bucket action without bucket", cmn.JobSchedule{Name: "x", Cron: "0 2 * * *",
+			Msg: apc.ActMsg{Action: apc.ActCopyBck}}, false),
+		Entry("destination without source", cmn.JobSchedule{Name: "x", Cron: "0 2 * * *", BckTo: dst, Msg: start}, false),
+	)
+
+	It("should default to skipping overlapping runs", func() {
+		s := cmn.JobSchedule{Name: "lru", Cron: "0 2 * * *", Msg: start}
+		Expect(s.Validate()).NotTo(HaveOccurred())
+		Expect(s.Overlap).To(Equal(cmn.SchedOverlapSkip))
+	})
+
+	It("should compute next run in UTC", func() {
+		var (
+			s   = cmn.JobSchedule{Name: "lru", Cron: "0 2 * * *", Msg: start}
+			loc = time.FixedZone("UTC-8", -8*3600)
+			now = time.Date(2026, time.March, 14, 20, 0, 0, 0, loc) // 04:00 UTC on the 15th
+		)
+		Expect(s.Next(now)).To(Equal(time.Date(2026, time.March, 16, 2, 0, 0, 0, time.UTC)))
+	})
+})
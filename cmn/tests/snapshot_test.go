@@ -0,0 +1,65 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests_test
+
+import (
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BckSnapshot", func() {
+	var (
+		created = time.Now()
+		snap    = cmn.BckSnapshot{Name: "daily", Created: created.UnixNano()}
+	)
+
+	It("should only include objects written before the snapshot", func() {
+		Expect(snap.Has(created.Add(-time.Second).UnixNano())).To(BeTrue())
+		Expect(snap.Has(created.UnixNano())).To(BeFalse())
+		Expect(snap.Has(created.Add(time.Second).UnixNano())).To(BeFalse())
+	})
+
+	It("should include objects written when there were no snapshots", func() {
+		Expect(snap.Has(0)).To(BeTrue())
+	})
+
+	It("should derive ID from creation time", func() {
+		other := cmn.BckSnapshot{Name: snap.Name, Created: snap.Created + 1}
+		Expect(snap.ID()).NotTo(BeEmpty())
+		Expect(snap.ID()).NotTo(Equal(other.ID()))
+	})
+
+	It("should find snapshot by name", func() {
+		bp := &cmn.Bprops{Snapshots: []cmn.BckSnapshot{{Name: "a", Created: 1}, snap}}
+		Expect(bp.Snapshot("daily")).NotTo(BeNil())
+		Expect(bp.Snapshot("daily").Created).To(Equal(snap.Created))
+		Expect(bp.Snapshot("b")).To(BeNil())
+	})
+
+	DescribeTable("validate",
+		func(bck cmn.Bck, bp cmn.Bprops, name string, ok bool) {
+			err := cmn.ValidateSnapshot(&bck, &bp, name)
+			if ok {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+		Entry("ais bucket", cmn.Bck{Name: "abc", Provider: apc.AIS}, cmn.Bprops{}, "daily-1", true),
+		Entry("empty name", cmn.Bck{Name: "abc", Provider: apc.AIS}, cmn.Bprops{}, "", false),
+		Entry("invalid name", cmn.Bck{Name: "abc", Provider: apc.AIS}, cmn.Bprops{}, "a/b", false),
+		Entry("remote bucket", cmn.Bck{Name: "abc", Provider: apc.AWS}, cmn.Bprops{}, "daily-1", false),
+		Entry("remote backend",
+			cmn.Bck{Name: "abc", Provider: apc.AIS},
+			cmn.Bprops{BackendBck: cmn.Bck{Name: "xyz", Provider: apc.AWS}},
+			"daily-1", false,
+		),
+	)
+})
@@ -0,0 +1,57 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests_test
+
+import (
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TieringConf", func() {
+	const week = cos.Duration(7 * 24 * time.Hour)
+
+	DescribeTable("validate",
+		func(c cmn.TieringConf, ok bool) {
+			err := c.ValidateAsProps()
+			if ok {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+		Entry("disabled", cmn.TieringConf{}, true),
+		Entry("disabled (ignoring the rest)", cmn.TieringConf{Hot: "nvme"}, true),
+		Entry("valid", cmn.TieringConf{Hot: "nvme", Cold: "hdd", ColdAfter: week, Enabled: true}, true),
+		Entry("no hot", cmn.TieringConf{Cold: "hdd", ColdAfter: week, Enabled: true}, false),
+		Entry("no cold", cmn.TieringConf{Hot: "nvme", ColdAfter: week, Enabled: true}, false),
+		Entry("same class", cmn.TieringConf{Hot: "nvme", Cold: "nvme", ColdAfter: week, Enabled: true}, false),
+		Entry("cold_after too short", cmn.TieringConf{Hot: "nvme", Cold: "hdd", ColdAfter: cos.Duration(time.Minute), Enabled: true}, false),
+	)
+
+	It("should classify objects by atime", func() {
+		var (
+			c   = cmn.TieringConf{Hot: "nvme", Cold: "hdd", ColdAfter: week, Enabled: true}
+			now = time.Now()
+		)
+		Expect(c.IsCold(now.Add(-time.Hour), now)).To(BeFalse())
+		Expect(c.IsCold(now.Add(-week.D()), now)).To(BeTrue())
+		Expect(c.IsCold(now.Add(-30*24*time.Hour), now)).To(BeTrue())
+	})
+
+	It("should not allow tiering with mirroring or EC", func() {
+		bp := cmn.Bprops{
+			Provider: "ais",
+			Tiering:  cmn.TieringConf{Hot: "nvme", Cold: "hdd", ColdAfter: week, Enabled: true},
+		}
+		bp.Cksum.Type = cos.ChecksumCesXxh
+		bp.Mirror.Enabled, bp.Mirror.Copies = true, 2
+		Expect(bp.Validate(3)).To(MatchError(ContainSubstring("tiering")))
+	})
+})
@@ -0,0 +1,56 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests_test
+
+import (
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("VersionHistory", func() {
+	DescribeTable("validate",
+		func(conf cmn.VersionConf, ok bool) {
+			err := conf.ValidateAsProps()
+			if ok {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+		Entry("disabled", cmn.VersionConf{}, true),
+		Entry("enabled", cmn.VersionConf{Enabled: true}, true),
+		Entry("keep versions", cmn.VersionConf{Enabled: true, KeepVersions: 10}, true),
+		Entry("keep versions and days", cmn.VersionConf{Enabled: true, KeepVersions: 10, KeepDays: 7}, true),
+		Entry("history when disabled", cmn.VersionConf{KeepDays: 7}, false),
+		Entry("negative versions", cmn.VersionConf{Enabled: true, KeepVersions: -1}, false),
+		Entry("negative days", cmn.VersionConf{Enabled: true, KeepDays: -1}, false),
+	)
+
+	It("should only keep history when enabled with limits", func() {
+		Expect((&cmn.VersionConf{Enabled: true}).KeepHistory()).To(BeFalse())
+		Expect((&cmn.VersionConf{KeepVersions: 1}).KeepHistory()).To(BeFalse())
+		Expect((&cmn.VersionConf{Enabled: true, KeepDays: 1}).KeepHistory()).To(BeTrue())
+	})
+
+	It("should sort prior versions ahead of the current object", func() {
+		const flags = apc.LocOK | apc.EntryIsVersion
+		entries := cmn.LsoEntries{
+			{Name: "b", Version: "1"},
+			{Name: "a", Version: "12"},
+			{Name: "a", Version: "10", Flags: flags},
+			{Name: "a", Version: "11", Flags: flags | apc.EntryIsDelMarker},
+			{Name: "a", Version: "9", Flags: flags},
+		}
+		cmn.SortLso(entries)
+		versions := make([]string, 0, len(entries))
+		for _, en := range entries {
+			versions = append(versions, en.Name+":"+en.Version)
+		}
+		Expect(versions).To(Equal([]string{"a:9", "a:10", "a:11", "a:12", "b:1"}))
+	})
+})
@@ -0,0 +1,87 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Storage-class tiering across mountpath labels:
+// - each tier is a storage class, i.e., the set of target mountpaths with a given label
+//   (e.g., "nvme" and "hdd"; see fs.Mountpath.Label);
+// - new objects are written to the hot tier;
+// - objects that haven't been accessed for `cold_after` get moved to the cold tier,
+//   and back to the hot one when accessed again (x-tiering, atime-based);
+// - GET, HEAD, and DELETE find objects in either tier.
+
+const TieringColdAfterMin = time.Hour
+
+type (
+	TieringConf struct {
+		// Storage class (mountpath label) of the hot tier: new objects are written there.
+		Hot string `json:"hot,omitempty"`
+
+		// Storage class (mountpath label) of the cold tier.
+		Cold string `json:"cold,omitempty"`
+
+		// Objects that haven't been accessed for this long (as per their atime)
+		// get moved to the cold tier.
+		ColdAfter cos.Duration `json:"cold_after,omitempty"`
+
+		Enabled bool `json:"enabled"`
+	}
+
+	// TieringConfToSet is the partial-update counterpart of TieringConf.
+	TieringConfToSet struct {
+		// Storage class (mountpath label) of the hot tier.
+		Hot *string `json:"hot,omitempty"` // +gen:optional
+		// Storage class (mountpath label) of the cold tier.
+		Cold *string `json:"cold,omitempty"` // +gen:optional
+		// Move objects that haven't been accessed for this long to the cold tier.
+		ColdAfter *cos.Duration `json:"cold_after,omitempty"` // +gen:optional
+		// Enable or disable tiering.
+		Enabled *bool `json:"enabled,omitempty"` // +gen:optional
+	}
+)
+
+/////////////////
+// TieringConf //
+/////////////////
+
+func (c *TieringConf) String() string {
+	if !c.Enabled {
+		return confDisabled
+	}
+	return fmt.Sprintf("hot=%s, cold=%s, cold_after=%v", c.Hot, c.Cold, c.ColdAfter)
+}
+
+func (c *TieringConf) HotLabel() cos.MountpathLabel  { return cos.MountpathLabel(c.Hot) }
+func (c *TieringConf) ColdLabel() cos.MountpathLabel { return cos.MountpathLabel(c.Cold) }
+
+// given the object's atime, returns true if the object belongs to the cold tier
+func (c *TieringConf) IsCold(atime, now time.Time) bool {
+	return now.Sub(atime) >= c.ColdAfter.D()
+}
+
+func (c *TieringConf) ValidateAsProps(...any) error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Hot == "" || c.Cold == "" {
+		return errors.New("invalid tiering: hot and cold storage classes (mountpath labels) must be specified")
+	}
+	if c.Hot == c.Cold {
+		return fmt.Errorf("invalid tiering: hot and cold storage classes must differ (got %q)", c.Hot)
+	}
+	if c.ColdAfter.D() < TieringColdAfterMin {
+		return fmt.Errorf("invalid tiering: cold_after %v (expecting >= %v)", c.ColdAfter, TieringColdAfterMin)
+	}
+	return nil
+}
@@ -61,7 +61,7 @@ func ParseBckObjectURI(uri string, opts ParseURIOpts) (bck Bck, objName string,
 	parts = strings.SplitN(uri, "/", 2)
 	if parts[0] != "" && (parts[0][0] == apc.NsUUIDPrefix || parts[0][0] == apc.NsNamePrefix) {
 		bck.Ns = ParseNsUname(parts[0])
-		if err := bck.Ns.validate(); err != nil {
+		if err := bck.Ns.Validate(); err != nil {
 			return bck, "", err
 		}
 		if !opts.IsQuery && bck.Provider == "" {
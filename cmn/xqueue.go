@@ -0,0 +1,83 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+)
+
+// Xaction admission queue:
+// - opt-in, via apc.QparamAdmitPrio (and, optionally, apc.QparamAdmitDeadline) query parameter(s)
+//   of the request to start xaction (apc.ActXactStart) or run xaction-starting bucket action;
+// - when the request conflicts with a running xaction (see ErrLimitedCoexistence) the primary
+//   queues it instead of failing right away - and responds with http.StatusAccepted and queue ID;
+// - the primary then periodically retries pending requests in the order of their priorities
+//   (higher first; FIFO within the same priority) until the request either succeeds,
+//   fails for any other reason, expires (deadline), or gets canceled (apc.ActXactDequeue);
+//   strictly so: a request that is still conflicting holds back all the ones behind it;
+// - the queue is kept in the primary's memory (and is therefore lost upon primary change).
+
+// queued request states (see XactQueueEntry)
+const (
+	XqPending  = "pending"
+	XqStarted  = "started"
+	XqFailed   = "failed"
+	XqExpired  = "expired"
+	XqCanceled = "canceled"
+)
+
+const (
+	XactQueueMax     = 256 // max number of pending requests
+	XactQueueHistory = 32  // max number of most recently resolved requests to keep
+)
+
+type (
+	XactQueueEntry struct {
+		ID       string `json:"id"`
+		Action   string `json:"action"`         // apc.ActXactStart or bucket action (e.g. apc.ActCopyBck)
+		Kind     string `json:"kind,omitempty"` // xaction kind (apc.ActXactStart only)
+		Bck      string `json:"bck,omitempty"`  // bucket (cname), if any
+		State    string `json:"state"`          // one of the Xq* enumerated above
+		Xid      string `json:"xid,omitempty"`  // started xaction ID(s), if any
+		Err      string `json:"err,omitempty"`  // most recent conflict (pending) or failure
+		Prio     int    `json:"prio"`
+		Attempts int    `json:"attempts"`
+		Added    int64  `json:"added,string"`              // unix nano
+		Deadline int64  `json:"deadline,string,omitempty"` // unix nano
+		Updated  int64  `json:"updated,string,omitempty"`  // unix nano (state change or most recent attempt)
+	}
+
+	// GET /v1/cluster?what=xact-queue
+	// pending requests in their admission order followed by the most recently resolved ones (most recent first)
+	XactQueue []*XactQueueEntry
+)
+
+// parse apc.QparamAdmitPrio and apc.QparamAdmitDeadline values
+func ParseAdmitQuery(sprio, sdeadline string) (prio int, deadline time.Duration, err error) {
+	if prio, err = strconv.Atoi(sprio); err != nil {
+		return 0, 0, fmt.Errorf("invalid %s=%q (expecting integer priority)", apc.QparamAdmitPrio, sprio)
+	}
+	if sdeadline == "" {
+		return prio, 0, nil
+	}
+	if deadline, err = time.ParseDuration(sdeadline); err != nil || deadline <= 0 {
+		return 0, 0, fmt.Errorf("invalid %s=%q (expecting positive duration)", apc.QparamAdmitDeadline, sdeadline)
+	}
+	return prio, deadline, nil
+}
+
+func (e *XactQueueEntry) IsPending() bool { return e.State == XqPending }
+
+func (e *XactQueueEntry) String() string {
+	if e.Bck != "" {
+		return fmt.Sprintf("xq[%s, %s %s, prio %d]", e.ID, e.Action, e.Bck, e.Prio)
+	}
+	return fmt.Sprintf("xq[%s, %s %s, prio %d]", e.ID, e.Action, e.Kind, e.Prio)
+}
@@ -58,4 +58,11 @@ type (
 		CompleteMpt(lom *LOM, r *http.Request, uploadID string, body []byte, parts apc.MptCompletedParts) (version, etag string, ecode int, err error)
 		AbortMpt(lom *LOM, r *http.Request, uploadID string) (ecode int, err error)
 	}
+
+	// optional; implemented by backends that natively support object tagging
+	// - tags are URL-query decoded (see cmn.TagsObjMD)
+	// - empty tags remove all tags from the remote object
+	TagsBackend interface {
+		PutObjTags(ctx context.Context, lom *LOM, tags cos.StrKVs) (ecode int, err error)
+	}
 )
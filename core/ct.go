@@ -209,8 +209,14 @@ func (ct *CT) Write(reader io.Reader, size int64, workFQN string) (err error) {
 	if err = cos.Stat(bdir); err != nil {
 		return &errBdir{cname: ct.Cname(), err: err}
 	}
+	ekey, err := ct.ekey()
+	if err != nil {
+		return err
+	}
 	buf, slab := g.pmm.Alloc()
-	if workFQN == "" {
+	if ekey != nil {
+		err = ct.writeEncrypted(reader, size, workFQN, ekey, buf)
+	} else if workFQN == "" {
 		_, err = cos.SaveReader(ct.fqn, reader, buf, cos.ChecksumNone, size)
 	} else {
 		_, err = ct.saveAndRename(workFQN, reader, buf, cos.ChecksumNone, size)
@@ -0,0 +1,741 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+)
+
+// At-rest compression (bucket property `compression`, see cmn.CompressionConf)
+//
+// - the codec is selected at write time and recorded with the content itself:
+//   monolithic objects - in lmeta flags (along with physical size), chunks -
+//   in the respective Uchunk flags; changing bucket's codec does not affect
+//   existing objects
+// - object size and checksum always refer to the logical (uncompressed) content
+// - lom.Open() and friends return readers that decompress transparently;
+//   random access (ReadAt, Seek) restarts decoding at the nearest preceding frame
+// - compressed content is a sequence of independently decodable frames: one per
+//   cframeMin logical bytes, with frame size doubling to keep the number of frames
+//   within cframesMax; monolithic objects record the frame index in lmeta (see cindex),
+//   chunks do not - reading a chunk at an offset decompresses and skips the chunk's
+//   content that precedes it
+// - mirroring and local copies are raw, file-level (and metadata-preserving)
+// - when both configured, compression is followed by encryption (see lencrypt.go)
+
+// seekable frames
+const (
+	cframeMin  = cos.MiB // initial logical frame size
+	cframesMax = 128     // when exceeded, frame size doubles (and every other frame boundary goes)
+)
+
+// Uchunk flags
+const (
+	chflZstd      = uint16(1) << 0
+	chflLZ4       = uint16(1) << 1
+	chflEncrypt   = uint16(1) << 2
+	chflCodecMask = chflZstd | chflLZ4
+	chflStoreMask = chflCodecMask | chflEncrypt
+)
+
+type (
+	// content writer: compresses and/or encrypts; counts physical (stored) bytes
+	// (compressor => encryptor => dst)
+	Cwriter struct {
+		enc   io.WriteCloser // compressor or nil
+		ew    *ewriter       // encryptor or nil
+		dst   io.Writer
+		codec string
+		cidx  cindex
+		lsize int64 // logical bytes written
+		zsize int64 // compressed bytes (prior to encryption)
+		psize int64
+	}
+	cwcount Cwriter // compressor's output
+	cwphys  Cwriter // physical output
+
+	// decompressing reader over a compressed file (object or chunk)
+	// - is a LomReader and a ReadOpenCloser
+	// - also implements io.Seeker (logical offsets)
+	// - not thread-safe (same as os.File reads via shared offset)
+	Creader struct {
+		src   cfile     // *os.File or (when encrypted) *Ereader
+		dec   io.Reader // Read decoder
+		at    io.Reader // ReadAt decoder (independent of Read/Seek offset)
+		spec  *cspec
+		off   int64 // logical Read offset
+		atoff int64 // logical ReadAt decoder offset
+	}
+
+	// how to read stored content: as is, decrypting, decompressing, or both
+	cspec struct {
+		ekey  *Ekey
+		cidx  *cindex // nil when not indexed
+		fqn   string
+		codec string
+		size  int64 // logical
+	}
+
+	// index of independently decodable frames: frame i (i > 0) starts at logical offset
+	// i*frame and compressed offset offs[i-1] (which, when encrypted, is the offset
+	// in decrypted content)
+	cindex struct {
+		offs  []int64
+		frame int64
+	}
+
+	// (common for *os.File, *Creader, and *Ereader)
+	cfile interface {
+		cos.LomReader
+		io.Seeker
+	}
+)
+
+// interface guard
+var (
+	_ cos.LomReader      = (*Creader)(nil)
+	_ cos.ReadOpenCloser = (*Creader)(nil)
+	_ io.Seeker          = (*Creader)(nil)
+)
+
+var (
+	zencPool, zdecPool, lz4wPool, lz4rPool sync.Pool
+
+	errCodec = errors.New("unknown compression codec")
+)
+
+//
+// LOM
+//
+
+// WriteCodec returns the bucket-configured codec for new writes, or empty string if none.
+func (lom *LOM) WriteCodec() string {
+	if bprops := lom.Bprops(); bprops != nil && bprops.Compression.IsEnabled() {
+		return bprops.Compression.Codec
+	}
+	return ""
+}
+
+// WriteEncoded returns true if new content is to be stored compressed and/or encrypted.
+func (lom *LOM) WriteEncoded() bool {
+	bprops := lom.Bprops()
+	return bprops != nil && (bprops.Compression.IsEnabled() || bprops.Encryption.Enabled)
+}
+
+// NewCwriter returns a writer that compresses and/or encrypts new content
+// as per bucket configuration; nil when neither is configured.
+func (lom *LOM) NewCwriter(dst io.Writer) (*Cwriter, error) {
+	var (
+		ekey  *Ekey
+		codec = lom.WriteCodec()
+	)
+	if bprops := lom.Bprops(); bprops != nil && bprops.Encryption.Enabled {
+		var err error
+		if ekey, err = bckEkey(&bprops.Encryption, lom.Cname()); err != nil {
+			return nil, err
+		}
+	}
+	if codec == "" && ekey == nil {
+		return nil, nil
+	}
+	return NewCwriter(dst, codec, ekey)
+}
+
+func (lom *LOM) IsCompressed() bool { return lom.md.flags&lmflCodecMask != 0 }
+
+// IsEncoded returns true if the object is stored compressed and/or encrypted
+// (in other words, when its on-disk content differs from the object's)
+func (lom *LOM) IsEncoded() bool { return lom.md.flags&lmflStoreMask != 0 }
+
+// Codec returns the codec the (monolithic) object is stored with, or empty string if none.
+func (lom *LOM) Codec() string {
+	switch lom.md.flags & lmflCodecMask {
+	case lmflZstd:
+		return apc.CodecZstd
+	case lmflLZ4:
+		return apc.CodecLZ4
+	default:
+		return ""
+	}
+}
+
+// Psize returns physical (on-disk) size of the object's content:
+// - compressed and/or encrypted monolithic object: size of the stored file
+// - chunked object with compressed and/or encrypted chunks: total size of the chunk files
+// - otherwise, same as Lsize
+func (lom *LOM) Psize() int64 {
+	if lom.md.psize > 0 {
+		return lom.md.psize
+	}
+	return lom.md.Size
+}
+
+// SetStored records how the newly written content is stored: codec, encryption,
+// and physical size; nil means as is.
+func (lom *LOM) SetStored(cw *Cwriter) {
+	lom.md.flags &^= lmflStoreMask
+	lom.md.psize = 0
+	lom.md.cidx = nil
+	if cw == nil {
+		return
+	}
+	switch cw.codec {
+	case "":
+	case apc.CodecZstd:
+		lom.md.flags |= lmflZstd
+	case apc.CodecLZ4:
+		lom.md.flags |= lmflLZ4
+	default:
+		debug.Assert(false, cw.codec)
+	}
+	if cw.ew != nil {
+		lom.md.flags |= lmflEncrypt
+	}
+	lom.md.psize = cw.psize
+	if len(cw.cidx.offs) > 0 {
+		lom.md.cidx = &cindex{offs: cw.cidx.offs, frame: cw.cidx.frame}
+	}
+}
+
+// OpenFile opens the given (main or replica) file of this monolithic object;
+// decrypts and/or decompresses if need be
+func (lom *LOM) OpenFile(fqn string) (cos.LomReader, error) {
+	if !lom.IsEncoded() {
+		return os.Open(fqn)
+	}
+	spec, err := lom.cspec(fqn)
+	if err != nil {
+		return nil, err
+	}
+	return spec.open()
+}
+
+// NewFileHandle is the LOM's counterpart of cos.NewFileHandle (compare with lom.OpenFile)
+func (lom *LOM) NewFileHandle(fqn string) (cos.ReadOpenCloser, error) {
+	if !lom.IsEncoded() {
+		return cos.NewFileHandle(fqn)
+	}
+	spec, err := lom.cspec(fqn)
+	if err != nil {
+		return nil, err
+	}
+	return spec.reopen()
+}
+
+func (lom *LOM) cspec(fqn string) (*cspec, error) {
+	spec := &cspec{fqn: fqn, codec: lom.Codec(), size: lom.md.Size, cidx: lom.md.cidx}
+	if lom.IsEncrypted() {
+		var err error
+		if spec.ekey, err = bckEkey(&lom.Bprops().Encryption, lom.Cname()); err != nil {
+			return nil, err
+		}
+	}
+	return spec, nil
+}
+
+//
+// Uchunk
+//
+
+// SetStored records how the chunk is stored (compare with lom.SetStored)
+func (c *Uchunk) SetStored(cw *Cwriter) {
+	c.flags &^= chflStoreMask
+	if cw == nil {
+		return
+	}
+	switch cw.codec {
+	case apc.CodecZstd:
+		c.flags |= chflZstd
+	case apc.CodecLZ4:
+		c.flags |= chflLZ4
+	}
+	if cw.ew != nil {
+		c.flags |= chflEncrypt
+	}
+}
+
+func (c *Uchunk) Codec() string {
+	switch c.flags & chflCodecMask {
+	case chflZstd:
+		return apc.CodecZstd
+	case chflLZ4:
+		return apc.CodecLZ4
+	default:
+		return ""
+	}
+}
+
+func (c *Uchunk) IsEncrypted() bool { return c.flags&chflEncrypt != 0 }
+
+// total physical size of the chunks iff any of them is compressed or encrypted, zero otherwise
+func (u *Ufest) psize() (psize int64) {
+	var encoded bool
+	for i := range u.chunks {
+		c := &u.chunks[i]
+		if c.flags&chflStoreMask == 0 {
+			psize += c.size
+			continue
+		}
+		finfo, err := os.Stat(c.path)
+		if err != nil {
+			return 0 // (not critical)
+		}
+		psize += finfo.Size()
+		encoded = true
+	}
+	if !encoded {
+		return 0
+	}
+	return psize
+}
+
+// OpenChunk returns a reader of the chunk's (logical) content.
+func (u *Ufest) OpenChunk(c *Uchunk) (cos.LomReader, error) { return u.openChunk(c) }
+
+func (u *Ufest) openChunk(c *Uchunk) (cfile, error) {
+	if c.flags&chflStoreMask == 0 {
+		return os.Open(c.path)
+	}
+	spec := &cspec{fqn: c.path, codec: c.Codec(), size: c.size}
+	if c.IsEncrypted() {
+		var err error
+		if spec.ekey, err = bckEkey(&u.lom.Bprops().Encryption, u.lom.Cname()); err != nil {
+			return nil, err
+		}
+	}
+	return spec.open()
+}
+
+/////////////
+// Cwriter //
+/////////////
+
+// NewCwriter returns a writer that compresses (given non-empty codec) and/or
+// encrypts (given data key) into `dst`; Close must be called to flush - it does not close `dst`.
+func NewCwriter(dst io.Writer, codec string, ekey *Ekey) (*Cwriter, error) {
+	cw := &Cwriter{dst: dst, codec: codec}
+	if ekey != nil {
+		var err error
+		if cw.ew, err = newEwriter((*cwphys)(cw), ekey); err != nil {
+			return nil, err
+		}
+	}
+	if codec != "" {
+		cw.cidx.frame = cframeMin
+	}
+	switch codec {
+	case "":
+		debug.Assert(ekey != nil)
+	case apc.CodecZstd:
+		enc, ok := zencPool.Get().(*zstd.Encoder)
+		if !ok {
+			var err error
+			enc, err = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1), zstd.WithEncoderLevel(zstd.SpeedDefault))
+			if err != nil {
+				cw.ew.free()
+				return nil, err
+			}
+		}
+		enc.Reset((*cwcount)(cw))
+		cw.enc = enc
+	case apc.CodecLZ4:
+		enc, ok := lz4wPool.Get().(*lz4.Writer)
+		if !ok {
+			enc = lz4.NewWriter(nil)
+		}
+		enc.Reset((*cwcount)(cw))
+		cw.enc = enc
+	default:
+		cw.ew.free()
+		return nil, fmt.Errorf("%w %q", errCodec, codec)
+	}
+	return cw, nil
+}
+
+func (cw *Cwriter) Write(p []byte) (n int, err error) {
+	if cw.enc == nil {
+		return cw.ew.Write(p)
+	}
+	for len(p) > 0 {
+		if err := cw.boundary(); err != nil {
+			return n, err
+		}
+		m := int(min(int64(len(p)), cw.cidx.next()-cw.lsize))
+		k, err := cw.enc.Write(p[:m])
+		n += k
+		cw.lsize += int64(k)
+		p = p[k:]
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// at a frame boundary (and with more to write): end the current frame and begin the next
+func (cw *Cwriter) boundary() error {
+	if cw.lsize == 0 || cw.lsize != cw.cidx.next() {
+		return nil
+	}
+	if len(cw.cidx.offs) == cframesMax-1 {
+		cw.cidx.coarsen()
+		debug.Assert(cw.lsize == cw.cidx.next())
+	}
+	if err := cw.enc.Close(); err != nil {
+		return err
+	}
+	switch enc := cw.enc.(type) {
+	case *zstd.Encoder:
+		enc.Reset((*cwcount)(cw))
+	case *lz4.Writer:
+		enc.Reset((*cwcount)(cw))
+	}
+	cw.cidx.offs = append(cw.cidx.offs, cw.zsize)
+	return nil
+}
+
+// flushes compressor and encryptor (in that order); idempotent
+func (cw *Cwriter) Close() (err error) {
+	if cw.enc != nil {
+		err = cw.enc.Close()
+		switch enc := cw.enc.(type) {
+		case *zstd.Encoder:
+			enc.Reset(nil)
+			zencPool.Put(enc)
+		case *lz4.Writer:
+			enc.Reset(nil)
+			lz4wPool.Put(enc)
+		}
+		cw.enc = nil
+	}
+	if cw.ew != nil {
+		if erw := cw.ew.Close(); err == nil {
+			err = erw
+		}
+	}
+	return err
+}
+
+// physical (stored) size - valid after Close
+func (cw *Cwriter) Psize() int64 { return cw.psize }
+
+func (cw *Cwriter) Codec() string { return cw.codec }
+
+func (cw *Cwriter) Encrypted() bool { return cw.ew != nil }
+
+func (cc *cwcount) Write(p []byte) (n int, err error) {
+	if cc.ew != nil {
+		n, err = cc.ew.Write(p)
+	} else {
+		n, err = (*cwphys)(cc).Write(p)
+	}
+	cc.zsize += int64(n)
+	return n, err
+}
+
+////////////
+// cindex //
+////////////
+
+// logical offset of the next frame boundary
+func (ci *cindex) next() int64 { return int64(len(ci.offs)+1) * ci.frame }
+
+// double the frame size, keeping even-numbered boundaries
+func (ci *cindex) coarsen() {
+	offs := ci.offs[:0]
+	for i := 1; i < len(ci.offs); i += 2 {
+		offs = append(offs, ci.offs[i])
+	}
+	ci.offs = offs
+	ci.frame <<= 1
+}
+
+// frame that contains the given logical offset (zero when not indexed)
+func (ci *cindex) frameOf(off int64) int {
+	if ci == nil {
+		return 0
+	}
+	return int(min(off/ci.frame, int64(len(ci.offs))))
+}
+
+// logical and compressed offsets of the frame
+func (ci *cindex) start(i int) (loff, zoff int64) {
+	if i == 0 {
+		return 0, 0
+	}
+	return int64(i) * ci.frame, ci.offs[i-1]
+}
+
+// text: base-36 frame size followed by compressed frame sizes
+// (that is, deltas between consecutive compressed offsets)
+func (ci *cindex) pack() string {
+	var (
+		sb   strings.Builder
+		prev int64
+	)
+	sb.Grow(8 + len(ci.offs)*5)
+	sb.WriteString(strconv.FormatInt(ci.frame, 36))
+	for _, off := range ci.offs {
+		sb.WriteByte(',')
+		sb.WriteString(strconv.FormatInt(off-prev, 36))
+		prev = off
+	}
+	return sb.String()
+}
+
+func (ci *cindex) unpack(s string) error {
+	var (
+		prev  int64
+		parts = strings.Split(s, ",")
+	)
+	if len(parts) < 2 || len(parts) > cframesMax {
+		return fmt.Errorf("invalid compressed frame index (%d)", len(parts))
+	}
+	ci.offs = make([]int64, 0, len(parts)-1)
+	for i, part := range parts {
+		v, err := strconv.ParseInt(part, 36, 64)
+		if err != nil || v <= 0 {
+			return fmt.Errorf("invalid compressed frame index (%q)", part)
+		}
+		if i == 0 {
+			ci.frame = v
+			continue
+		}
+		prev += v
+		ci.offs = append(ci.offs, prev)
+	}
+	return nil
+}
+
+func (cp *cwphys) Write(p []byte) (n int, err error) {
+	n, err = cp.dst.Write(p)
+	cp.psize += int64(n)
+	return n, err
+}
+
+/////////////
+// Creader //
+/////////////
+
+// NewCreader opens compressed (and not encrypted) file for reading.
+func NewCreader(fqn, codec string, size int64) (*Creader, error) {
+	spec := &cspec{fqn: fqn, codec: codec, size: size}
+	r, err := spec.open()
+	if err != nil {
+		return nil, err
+	}
+	return r.(*Creader), nil
+}
+
+func newCreader(src cfile, spec *cspec) (*Creader, error) {
+	r := &Creader{src: src, spec: spec}
+	var err error
+	if r.dec, err = newDecoder(spec.codec, src); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *Creader) Read(p []byte) (n int, err error) {
+	n, err = r.dec.Read(p)
+	r.off += int64(n)
+	return n, err
+}
+
+func (r *Creader) Open() (cos.ReadOpenCloser, error) { return r.spec.reopen() }
+
+// consistent with io.ReaderAt semantics: does not use or modify the Read offset
+func (r *Creader) ReadAt(p []byte, off int64) (n int, err error) {
+	if off < 0 {
+		return 0, errors.New("creader: negative offset")
+	}
+	if off >= r.spec.size {
+		return 0, io.EOF
+	}
+	cidx := r.spec.cidx
+	if r.at == nil || off < r.atoff || cidx.frameOf(off) > cidx.frameOf(r.atoff) {
+		// (re)start at the frame that contains the offset
+		loff, zoff := cidx.start(cidx.frameOf(off))
+		freeDecoder(r.at)
+		if r.at, err = newDecoder(r.spec.codec, io.NewSectionReader(r.src, zoff, 1<<62)); err != nil {
+			r.at = nil
+			return 0, err
+		}
+		r.atoff = loff
+	}
+	if skip := off - r.atoff; skip > 0 {
+		m, err := io.CopyN(io.Discard, r.at, skip)
+		r.atoff += m
+		if err != nil {
+			return 0, r._eof(err)
+		}
+	}
+	n, err = io.ReadFull(r.at, p)
+	r.atoff += int64(n)
+	return n, r._eof(err)
+}
+
+func (r *Creader) Seek(offset int64, whence int) (int64, error) {
+	var abs int64
+	switch whence {
+	case io.SeekStart:
+		abs = offset
+	case io.SeekCurrent:
+		abs = r.off + offset
+	case io.SeekEnd:
+		abs = r.spec.size + offset
+	default:
+		return 0, errors.New("creader: invalid whence")
+	}
+	if abs < 0 || abs > r.spec.size {
+		return 0, fmt.Errorf("creader: invalid offset %d (size %d)", abs, r.spec.size)
+	}
+	cidx := r.spec.cidx
+	if abs < r.off || cidx.frameOf(abs) > cidx.frameOf(r.off) {
+		loff, zoff := cidx.start(cidx.frameOf(abs))
+		if _, err := r.src.Seek(zoff, io.SeekStart); err != nil {
+			return 0, err
+		}
+		freeDecoder(r.dec)
+		dec, err := newDecoder(r.spec.codec, r.src)
+		if err != nil {
+			r.dec = nil
+			return 0, err
+		}
+		r.dec, r.off = dec, loff
+	}
+	if skip := abs - r.off; skip > 0 {
+		m, err := io.CopyN(io.Discard, r.dec, skip)
+		r.off += m
+		if err != nil {
+			return r.off, r._eof(err)
+		}
+	}
+	return abs, nil
+}
+
+func (r *Creader) Close() error {
+	freeDecoder(r.dec)
+	freeDecoder(r.at)
+	r.dec, r.at = nil, nil
+	return r.src.Close()
+}
+
+func (*Creader) _eof(err error) error {
+	if err == io.ErrUnexpectedEOF {
+		return io.EOF
+	}
+	return err
+}
+
+///////////
+// cspec //
+///////////
+
+func (spec *cspec) open() (cfile, error) {
+	fh, err := os.Open(spec.fqn)
+	if err != nil {
+		return nil, err
+	}
+	var src cfile = fh
+	if spec.ekey != nil {
+		if src, err = newEreader(fh, spec); err != nil {
+			cos.Close(fh)
+			return nil, err
+		}
+	}
+	if spec.codec == "" {
+		return src, nil
+	}
+	r, err := newCreader(src, spec)
+	if err != nil {
+		cos.Close(src)
+		return nil, err
+	}
+	return r, nil
+}
+
+func (spec *cspec) reopen() (cos.ReadOpenCloser, error) {
+	debug.Assert(spec.codec != "" || spec.ekey != nil)
+	r, err := spec.open()
+	if err != nil {
+		return nil, err
+	}
+	return r.(cos.ReadOpenCloser), nil
+}
+
+//
+// decoders
+//
+
+func newDecoder(codec string, r io.Reader) (io.Reader, error) {
+	switch codec {
+	case apc.CodecZstd:
+		dec, ok := zdecPool.Get().(*zstd.Decoder)
+		if !ok {
+			var err error
+			// (single-threaded decoding is synchronous - no goroutines to leak)
+			dec, err = zstd.NewReader(nil, zstd.WithDecoderConcurrency(1), zstd.WithDecoderLowmem(true))
+			if err != nil {
+				return nil, err
+			}
+		}
+		if err := dec.Reset(r); err != nil {
+			zdecPool.Put(dec)
+			return nil, err
+		}
+		return dec, nil
+	case apc.CodecLZ4:
+		dec, ok := lz4rPool.Get().(*lz4.Reader)
+		if !ok {
+			dec = lz4.NewReader(nil)
+		}
+		dec.Reset(r)
+		return dec, nil
+	default:
+		return nil, fmt.Errorf("%w %q", errCodec, codec)
+	}
+}
+
+func freeDecoder(dec io.Reader) {
+	switch dec := dec.(type) {
+	case *zstd.Decoder:
+		_ = dec.Reset(nil)
+		zdecPool.Put(dec)
+	case *lz4.Reader:
+		dec.Reset(nil)
+		lz4rPool.Put(dec)
+	}
+}
+
+// checksum of the logical content of a compressed and/or encrypted object (lom is locked)
+func (lom *LOM) _czCksum(cksumType string) (*cos.Cksum, error) {
+	if cksum := lom.md.Cksum; !cos.NoneC(cksum) && cksum.Ty() == cksumType {
+		return cksum.Clone(), nil
+	}
+	lh, err := lom.OpenFile(lom.FQN)
+	if err != nil {
+		return nil, err
+	}
+	_, cksum, err := cos.ChecksumReader(lh, cksumType)
+	cos.Close(lh)
+	if err != nil {
+		return nil, err
+	}
+	return cksum.Clone(), nil
+}
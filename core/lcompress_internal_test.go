@@ -0,0 +1,109 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"bytes"
+	"io"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// counts bytes read from compressed file
+type rcount struct {
+	*os.File
+	n int64
+}
+
+func (r *rcount) Read(p []byte) (int, error) {
+	n, err := r.File.Read(p)
+	r.n += int64(n)
+	return n, err
+}
+
+func (r *rcount) ReadAt(p []byte, off int64) (int, error) {
+	n, err := r.File.ReadAt(p, off)
+	r.n += int64(n)
+	return n, err
+}
+
+func TestCompressedFrames(t *testing.T) {
+	const (
+		size  = cos.MiB - 333
+		frame = 4 * cos.KiB
+	)
+	// compressible (but not too much)
+	rnd := rand.New(rand.NewPCG(1, 2))
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = 'a' + byte(rnd.IntN(16))
+	}
+	dir := t.TempDir()
+
+	for _, codec := range []string{apc.CodecZstd, apc.CodecLZ4} {
+		fqn := filepath.Join(dir, codec)
+		fh, err := os.Create(fqn)
+		tassert.CheckFatal(t, err)
+		cw, err := NewCwriter(fh, codec, nil)
+		tassert.CheckFatal(t, err)
+		cw.cidx.frame = frame // (to exercise many frames and coarsening)
+		for off := 0; off < size; off += 10007 {
+			_, err = cw.Write(data[off:min(off+10007, size)])
+			tassert.CheckFatal(t, err)
+		}
+		tassert.CheckFatal(t, cw.Close())
+		tassert.CheckFatal(t, fh.Close())
+
+		// size/frame > cframesMax: coarsened (once)
+		cidx := &cw.cidx
+		tassert.Fatalf(t, cidx.frame == 2*frame, "%s: expected frame size %d, got %d", codec, 2*frame, cidx.frame)
+		tassert.Fatalf(t, int64(len(cidx.offs)) == size/cidx.frame, "%s: expected %d frames, got %d", codec, size/cidx.frame+1, len(cidx.offs)+1)
+
+		var ci cindex
+		tassert.CheckFatal(t, ci.unpack(cidx.pack()))
+		tassert.Fatalf(t, ci.frame == cidx.frame && len(ci.offs) == len(cidx.offs), "%s: pack/unpack mismatch", codec)
+		for i := range ci.offs {
+			tassert.Fatalf(t, ci.offs[i] == cidx.offs[i], "%s: pack/unpack mismatch at %d", codec, i)
+		}
+
+		f, err := os.Open(fqn)
+		tassert.CheckFatal(t, err)
+		src := &rcount{File: f}
+		r, err := newCreader(src, &cspec{fqn: fqn, codec: codec, size: size, cidx: &ci})
+		tassert.CheckFatal(t, err)
+
+		// whole
+		b, err := io.ReadAll(r)
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, bytes.Equal(b, data), "%s: content mismatch", codec)
+
+		// random access: reads the containing frame (and not what precedes it)
+		p := make([]byte, 100)
+		for _, off := range []int64{size - 200, 3, size / 2, size/2 + frame, 5} {
+			src.n = 0
+			n, err := r.ReadAt(p, off)
+			tassert.CheckFatal(t, err)
+			tassert.Fatalf(t, bytes.Equal(p[:n], data[off:off+100]), "%s: mismatch at %d", codec, off)
+			tassert.Errorf(t, src.n < cw.zsize/8, "%s: read %d of %d compressed bytes at %d", codec, src.n, cw.zsize, off)
+		}
+
+		// seek
+		for _, off := range []int64{size - 50, 17, size / 3} {
+			abs, err := r.Seek(off, io.SeekStart)
+			tassert.CheckFatal(t, err)
+			tassert.Fatalf(t, abs == off, "%s: seek %d, got %d", codec, off, abs)
+			_, err = io.ReadFull(r, p[:50])
+			tassert.CheckFatal(t, err)
+			tassert.Fatalf(t, bytes.Equal(p[:50], data[off:off+50]), "%s: mismatch at %d (seek)", codec, off)
+		}
+		tassert.CheckFatal(t, r.Close())
+	}
+}
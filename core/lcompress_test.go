@@ -0,0 +1,240 @@
+// Package core_test provides tests for cluster package
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core_test
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("At-rest compression", func() {
+	const (
+		tmpDir   = "/tmp/lcompress_test"
+		mpath    = tmpDir + "/mpath"
+		bckName  = "LCOMPRESS_TEST"
+		dataSize = 300*cos.KiB + 17
+	)
+
+	var (
+		bck  = cmn.Bck{Name: bckName, Provider: apc.AIS, Ns: cmn.NsGlobal}
+		mix  = fs.Mountpath{Path: mpath}
+		snap = cmn.BckSnapshot{Name: "snap", Created: 1234}
+		data []byte
+	)
+
+	// compressible, yet not trivially so
+	data = make([]byte, dataSize)
+	for i := range data {
+		data[i] = byte(i/7) ^ byte(i%13)
+	}
+
+	BeforeEach(func() {
+		_ = cos.CreateDir(mpath)
+		_, _ = fs.AddTestMpath(mpath, "daeID")
+		_ = mock.NewTarget(mock.NewBaseBownerMock(
+			meta.NewBck(bckName, apc.AIS, cmn.NsGlobal, &cmn.Bprops{
+				Cksum:       cmn.CksumConf{Type: cos.ChecksumOneXxh},
+				Compression: cmn.CompressionConf{Codec: apc.CodecZstd},
+				Versioning:  cmn.VersionConf{Enabled: true, KeepVersions: 4},
+				Snapshots:   []cmn.BckSnapshot{snap},
+				BID:         301,
+			}),
+		))
+	})
+
+	AfterEach(func() {
+		_, _ = fs.Remove(mpath)
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	compress := func(fqn, codec string) int64 {
+		fh, err := cos.CreateFile(fqn)
+		Expect(err).NotTo(HaveOccurred())
+		cw, err := core.NewCwriter(fh, codec, nil /*ekey*/)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = cw.Write(data)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cw.Close()).NotTo(HaveOccurred())
+		Expect(fh.Close()).NotTo(HaveOccurred())
+		return cw.Psize()
+	}
+
+	for _, codec := range []string{apc.CodecZstd, apc.CodecLZ4} {
+		It("should round-trip with "+codec, func() {
+			fqn := filepath.Join(tmpDir, "obj."+codec)
+			psize := compress(fqn, codec)
+			finfo, err := os.Stat(fqn)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(finfo.Size()).To(Equal(psize))
+			Expect(psize).To(BeNumerically("<", dataSize))
+
+			r, err := core.NewCreader(fqn, codec, dataSize)
+			Expect(err).NotTo(HaveOccurred())
+			defer r.Close()
+
+			b, err := io.ReadAll(r)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bytes.Equal(b, data)).To(BeTrue())
+
+			// random access: forward and backward
+			for _, off := range []int64{cos.KiB * 200, 3, dataSize - 10} {
+				p := make([]byte, 10)
+				n, err := r.ReadAt(p, off)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(p[:n]).To(Equal(data[off : off+10]))
+			}
+			_, err = r.ReadAt(make([]byte, 1), dataSize)
+			Expect(err).To(Equal(io.EOF))
+
+			abs, err := r.Seek(100, io.SeekStart)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(abs).To(BeEquivalentTo(100))
+			p := make([]byte, 16)
+			_, err = io.ReadFull(r, p)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(p).To(Equal(data[100:116]))
+		})
+	}
+
+	It("should persist codec and physical size", func() {
+		fqn := mix.MakePathFQN(&bck, fs.ObjCT, "obj")
+		lom := &core.LOM{}
+		Expect(lom.InitFQN(fqn, &bck)).NotTo(HaveOccurred())
+		Expect(lom.WriteCodec()).To(Equal(apc.CodecZstd))
+
+		Expect(cos.CreateDir(filepath.Dir(fqn))).NotTo(HaveOccurred())
+		fh, err := cos.CreateFile(fqn)
+		Expect(err).NotTo(HaveOccurred())
+		cw, err := lom.NewCwriter(fh)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cw.Encrypted()).To(BeFalse())
+		_, err = cw.Write(data)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cw.Close()).NotTo(HaveOccurred())
+		Expect(fh.Close()).NotTo(HaveOccurred())
+		psize := cw.Psize()
+		lom.SetSize(dataSize)
+		lom.SetStored(cw)
+		lom.SetCksum(cos.NewCksum(cos.ChecksumNone, ""))
+		lom.IncVersion()
+		Expect(lom.Persist()).NotTo(HaveOccurred())
+		lom.UncacheUnless()
+
+		lom2 := &core.LOM{}
+		Expect(lom2.InitFQN(fqn, &bck)).NotTo(HaveOccurred())
+		Expect(lom2.Load(false, false)).NotTo(HaveOccurred())
+		Expect(lom2.IsCompressed()).To(BeTrue())
+		Expect(lom2.Codec()).To(Equal(apc.CodecZstd))
+		Expect(lom2.Lsize()).To(BeEquivalentTo(dataSize))
+		Expect(lom2.Psize()).To(Equal(psize))
+
+		lh, err := lom2.Open()
+		Expect(err).NotTo(HaveOccurred())
+		b, err := io.ReadAll(lh)
+		cos.Close(lh)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bytes.Equal(b, data)).To(BeTrue())
+	})
+
+	It("should persist frame index and read at offsets", func() {
+		fqn := mix.MakePathFQN(&bck, fs.ObjCT, "large")
+		lom := &core.LOM{}
+		Expect(lom.InitFQN(fqn, &bck)).NotTo(HaveOccurred())
+
+		const copies = 12 // multiple frames
+		Expect(cos.CreateDir(filepath.Dir(fqn))).NotTo(HaveOccurred())
+		fh, err := cos.CreateFile(fqn)
+		Expect(err).NotTo(HaveOccurred())
+		cw, err := lom.NewCwriter(fh)
+		Expect(err).NotTo(HaveOccurred())
+		for range copies {
+			_, err = cw.Write(data)
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(cw.Close()).NotTo(HaveOccurred())
+		Expect(fh.Close()).NotTo(HaveOccurred())
+		lom.SetSize(copies * dataSize)
+		lom.SetStored(cw)
+		lom.SetCksum(cos.NewCksum(cos.ChecksumNone, ""))
+		lom.IncVersion()
+		Expect(lom.Persist()).NotTo(HaveOccurred())
+		lom.UncacheUnless()
+
+		lom2 := &core.LOM{}
+		Expect(lom2.InitFQN(fqn, &bck)).NotTo(HaveOccurred())
+		Expect(lom2.Load(false, false)).NotTo(HaveOccurred())
+		lh, err := lom2.Open()
+		Expect(err).NotTo(HaveOccurred())
+		defer cos.Close(lh)
+		p := make([]byte, 64)
+		for _, off := range []int64{copies*dataSize - 64, 5*dataSize + 7, 11, 3 * cos.MiB} {
+			_, err := lh.ReadAt(p, off)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(p).To(Equal(data[off%dataSize : off%dataSize+64]))
+		}
+	})
+
+	It("should encode received (rebalanced) snapshot content", func() {
+		lom := &core.LOM{ObjName: "preserved"}
+		Expect(lom.InitCmnBck(&bck)).NotTo(HaveOccurred())
+
+		// decoded content, as sent by rebalance
+		oa := &cmn.ObjAttrs{Size: dataSize}
+		buf := make([]byte, 32*cos.KiB)
+		Expect(lom.RecvPreserved(snap.ID(), oa, bytes.NewReader(data), buf)).NotTo(HaveOccurred())
+
+		slom, err := lom.LoadSnap(&snap)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(slom).NotTo(BeNil())
+		defer core.FreeLOM(slom)
+		Expect(slom.IsCompressed()).To(BeTrue())
+		Expect(slom.Lsize()).To(BeEquivalentTo(dataSize))
+
+		fh, err := slom.NewFileHandle(slom.FQN)
+		Expect(err).NotTo(HaveOccurred())
+		b, err := io.ReadAll(fh)
+		cos.Close(fh)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bytes.Equal(b, data)).To(BeTrue())
+	})
+
+	It("should encode received (rebalanced) version", func() {
+		lom := &core.LOM{ObjName: "received"}
+		Expect(lom.InitCmnBck(&bck)).NotTo(HaveOccurred())
+
+		// decoded content, as sent by rebalance
+		oa := &cmn.ObjAttrs{Size: dataSize}
+		oa.SetVersion("3")
+		buf := make([]byte, 32*cos.KiB)
+		Expect(lom.RecvVersion("3", false, oa, bytes.NewReader(data), buf)).NotTo(HaveOccurred())
+
+		vlom, err := lom.LoadVersion("3")
+		Expect(err).NotTo(HaveOccurred())
+		defer core.FreeLOM(vlom)
+		Expect(vlom.IsCompressed()).To(BeTrue())
+		Expect(vlom.Lsize()).To(BeEquivalentTo(dataSize))
+		Expect(vlom.Psize()).To(BeNumerically("<", dataSize))
+
+		fh, err := vlom.NewFileHandle(vlom.FQN)
+		Expect(err).NotTo(HaveOccurred())
+		b, err := io.ReadAll(fh)
+		cos.Close(fh)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bytes.Equal(b, data)).To(BeTrue())
+	})
+})
@@ -8,7 +8,6 @@ import (
 	"errors"
 	"fmt"
 	"maps"
-	"os"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -271,7 +270,11 @@ func (lom *LOM) Copy2FQN(dstFQN string, buf []byte) (dst *LOM, err error) {
 	debug.Assert(lom.IsLocked() >= apc.LockRead, lom.Cname(), " source not locked")
 
 	dst = lom.CloneTo(dstFQN)
-	if err = dst.InitFQN(dstFQN, nil); err == nil {
+	if err = dst.InitFQN(dstFQN, nil); err == nil && !lom.sameEkey(dst) {
+		// raw copy would render the content unreadable (see also: coi)
+		err = fmt.Errorf("cannot copy encrypted %s to %s: different data keys", lom.Cname(), dst.Cname())
+	}
+	if err == nil {
 		var (
 			nested     error
 			sameBucket bool
@@ -348,6 +351,7 @@ func (lom *LOM) _copyChunks(dst *LOM, buf []byte) error {
 			if srcChunk.cksum != nil {
 				dstChunk.SetCksum(srcChunk.cksum.Clone())
 			}
+			dstChunk.flags = srcChunk.flags // (compression)
 
 			err = dstUfest.Add(dstChunk, srcChunk.Size(), int64(srcChunk.Num()))
 			if err != nil {
@@ -402,6 +406,7 @@ func (u *Ufest) _undoCopy(err error) error {
 func (lom *LOM) _copy2fqn(dst *LOM, buf []byte, sameBucket bool) (err, nested error, locked bool) {
 	var (
 		dstCksum   *cos.CksumHash
+		czCksum    *cos.Cksum // (compressed and/or encrypted)
 		dstFQN     = dst.FQN
 		dstCksumTy = dst.CksumType()
 	)
@@ -414,10 +419,21 @@ func (lom *LOM) _copy2fqn(dst *LOM, buf []byte, sameBucket bool) (err, nested er
 	}
 
 	workFQN := dst.GenFQN(fs.WorkCT, fs.WorkfileCopy)
-	_, dstCksum, err = cos.CopyFile(lom.FQN, workFQN, buf, dstCksumTy)
+	if lom.IsEncoded() {
+		// raw copy that retains compression and/or encryption; the checksum is computed over logical content (below)
+		_, _, err = cos.CopyFile(lom.FQN, workFQN, buf, cos.ChecksumNone)
+	} else {
+		_, dstCksum, err = cos.CopyFile(lom.FQN, workFQN, buf, dstCksumTy)
+	}
 	if err != nil {
 		return err, nil, false
 	}
+	if lom.IsEncoded() && dstCksumTy != cos.ChecksumNone {
+		if czCksum, err = lom._czCksum(dstCksumTy); err != nil {
+			nested = cos.RemoveFile(workFQN)
+			return err, nested, false
+		}
+	}
 
 	if !sameBucket {
 		locked = dst.TryLock(true)
@@ -432,6 +448,8 @@ func (lom *LOM) _copy2fqn(dst *LOM, buf []byte, sameBucket bool) (err, nested er
 		if err := lom._copyChunks(dst, buf); err != nil {
 			return err, nil, locked
 		}
+	case czCksum != nil:
+		dst.SetCksum(czCksum)
 	case dstCksumTy != cos.ChecksumNone:
 		dst.SetCksum(dstCksum.Clone())
 	default:
@@ -491,7 +509,7 @@ func (lom *LOM) OpenCopy() (cos.LomReader, string) {
 	if fqn == lom.FQN {
 		return nil, ""
 	}
-	if lh, err := os.Open(fqn); err == nil { // (compare w/ lom.Open())
+	if lh, err := lom.OpenFile(fqn); err == nil { // (compare w/ lom.Open())
 		return lh, fqn
 	}
 	return nil, ""
@@ -170,6 +170,10 @@ func (lom *LOM) CheckRemoteMD(locked, sync bool, origReq *http.Request) (res CRM
 		// that doesn't provide any versioning metadata
 		return CRMD{Eq: true}
 	}
+	if lom.WbPending() {
+		// local write is the latest (not yet uploaded - see lwback)
+		return CRMD{Eq: true}
+	}
 
 	oa, ecode, err := T.HeadCold(lom, origReq)
 	if err == nil {
@@ -0,0 +1,420 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/kms"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// At-rest encryption (bucket property `encryption`, see cmn.EncryptionConf and cmn/kms)
+//
+// - each encrypted bucket has its own data key (wrapped by the cluster's master key);
+//   each file (object, replica, chunk, EC slice) is encrypted with its own
+//   key derived from the data key and a random per-file salt
+// - same as compression, encryption is recorded at write time: lmeta flags for
+//   monolithic objects, Uchunk flags for chunks; EC slices are self-describing
+//   (magic header)
+// - on-disk format: header (magic + salt), followed by AES-256-GCM sealed segments
+//   (encSegSize plaintext bytes each, except the last one); segment nonce is
+//   its index plus "last" flag, header is authenticated with every segment -
+//   all of the above to detect reordering, truncation, and extension
+// - random access (ReadAt, Seek) is segment-granular
+// - object size and checksum always refer to the logical (plaintext) content
+
+const (
+	encMagic   = "\x00aisenc1"
+	encSaltLen = 32
+	encHdrLen  = len(encMagic) + encSaltLen
+
+	encSegSize = 64 * cos.KiB
+	encTagSize = 16 // GCM
+)
+
+type (
+	// unwrapped bucket data key
+	Ekey struct {
+		dek []byte
+	}
+
+	// encrypting writer (see Cwriter)
+	ewriter struct {
+		dst    io.Writer
+		aead   cipher.AEAD
+		buf    []byte // current (not yet sealed) segment
+		hdr    [encHdrLen]byte
+		sidx   uint64
+		hdone  bool
+		closed bool
+	}
+
+	// decrypting reader over an encrypted file
+	// - is a LomReader and a ReadOpenCloser
+	// - also implements io.Seeker (logical offsets)
+	// - not thread-safe (caches the most recently decrypted segment)
+	Ereader struct {
+		fh    *os.File
+		aead  cipher.AEAD
+		spec  *cspec
+		buf   []byte // decrypted segment
+		hdr   [encHdrLen]byte
+		psize int64
+		size  int64 // logical
+		nseg  int64
+		sidx  int64 // index of the segment in buf, or -1
+		off   int64 // logical Read offset
+	}
+)
+
+// interface guard
+var (
+	_ cos.LomReader      = (*Ereader)(nil)
+	_ cos.ReadOpenCloser = (*Ereader)(nil)
+	_ io.Seeker          = (*Ereader)(nil)
+)
+
+var (
+	ebufPool sync.Pool
+
+	errEncrypted = errors.New("failed to decrypt (corrupted or tampered with, or wrong key)")
+)
+
+// bucket's data key (unwrapped by the configured master key provider)
+func bckEkey(conf *cmn.EncryptionConf, cname string) (*Ekey, error) {
+	if conf.Key == "" {
+		return nil, fmt.Errorf("%s: bucket has no data key", cname)
+	}
+	dek, err := kms.Unwrap(conf.KekID, conf.Key)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", cname, err)
+	}
+	return &Ekey{dek: dek}, nil
+}
+
+func (lom *LOM) IsEncrypted() bool { return lom.md.flags&lmflEncrypt != 0 }
+
+// whether raw (file-level) copy from lom to dst preserves readability
+func (lom *LOM) sameEkey(dst *LOM) bool {
+	if !lom.IsEncrypted() && !lom.IsChunked() {
+		return true
+	}
+	src, dstp := lom.Bprops(), dst.Bprops()
+	if src == nil || dstp == nil {
+		return true
+	}
+	return src.Encryption.SameKey(&dstp.Encryption)
+}
+
+// per-file cipher
+func (ekey *Ekey) aead(salt []byte) (cipher.AEAD, error) {
+	mac := hmac.New(sha256.New, ekey.dek)
+	mac.Write(salt)
+	block, err := aes.NewCipher(mac.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func encNonce(nonce []byte, sidx uint64, last bool) []byte {
+	binary.BigEndian.PutUint64(nonce, sidx)
+	clear(nonce[8:])
+	if last {
+		nonce[len(nonce)-1] = 1
+	}
+	return nonce
+}
+
+func allocEbuf() []byte {
+	if b, ok := ebufPool.Get().(*[]byte); ok {
+		return (*b)[:0]
+	}
+	return make([]byte, 0, encSegSize+encTagSize)
+}
+
+func freeEbuf(b []byte) {
+	if b != nil {
+		ebufPool.Put(&b)
+	}
+}
+
+/////////////
+// ewriter //
+/////////////
+
+func newEwriter(dst io.Writer, ekey *Ekey) (*ewriter, error) {
+	ew := &ewriter{dst: dst}
+	copy(ew.hdr[:], encMagic)
+	if _, err := rand.Read(ew.hdr[len(encMagic):]); err != nil {
+		return nil, err
+	}
+	var err error
+	if ew.aead, err = ekey.aead(ew.hdr[len(encMagic):]); err != nil {
+		return nil, err
+	}
+	ew.buf = allocEbuf()
+	return ew, nil
+}
+
+func (ew *ewriter) Write(p []byte) (n int, err error) {
+	debug.Assert(!ew.closed)
+	for len(p) > 0 {
+		// seal a full segment only when there's more to write (the last one is sealed upon Close)
+		if len(ew.buf) == encSegSize {
+			if err = ew.seal(false); err != nil {
+				return n, err
+			}
+		}
+		m := min(encSegSize-len(ew.buf), len(p))
+		ew.buf = append(ew.buf, p[:m]...)
+		p = p[m:]
+		n += m
+	}
+	return n, nil
+}
+
+func (ew *ewriter) seal(last bool) error {
+	if !ew.hdone {
+		if _, err := ew.dst.Write(ew.hdr[:]); err != nil {
+			return err
+		}
+		ew.hdone = true
+	}
+	var nonce [12]byte
+	sealed := ew.aead.Seal(ew.buf[:0], encNonce(nonce[:], ew.sidx, last), ew.buf, ew.hdr[:])
+	if _, err := ew.dst.Write(sealed); err != nil {
+		return err
+	}
+	ew.buf = ew.buf[:0]
+	ew.sidx++
+	return nil
+}
+
+// seals the last (possibly empty) segment; idempotent
+func (ew *ewriter) Close() (err error) {
+	if ew.closed {
+		return nil
+	}
+	err = ew.seal(true)
+	ew.closed = true
+	ew.free()
+	return err
+}
+
+func (ew *ewriter) free() {
+	if ew == nil {
+		return
+	}
+	freeEbuf(ew.buf)
+	ew.buf = nil
+}
+
+/////////////
+// Ereader //
+/////////////
+
+func newEreader(fh *os.File, spec *cspec) (*Ereader, error) {
+	finfo, err := fh.Stat()
+	if err != nil {
+		return nil, err
+	}
+	r := &Ereader{fh: fh, spec: spec, psize: finfo.Size(), sidx: -1}
+	if r.psize < int64(encHdrLen+encTagSize) {
+		return nil, fmt.Errorf("%s: %w (size %d)", spec.fqn, errEncrypted, r.psize)
+	}
+	if _, err := fh.ReadAt(r.hdr[:], 0); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(r.hdr[:len(encMagic)], []byte(encMagic)) {
+		return nil, fmt.Errorf("%s: not encrypted or corrupted (invalid header)", spec.fqn)
+	}
+	if r.aead, err = spec.ekey.aead(r.hdr[len(encMagic):]); err != nil {
+		return nil, err
+	}
+	body := r.psize - int64(encHdrLen)
+	r.nseg = (body + encSegSize + encTagSize - 1) / (encSegSize + encTagSize)
+	r.size = body - r.nseg*encTagSize
+	if spec.codec == "" && spec.size >= 0 && spec.size != r.size {
+		return nil, fmt.Errorf("%s: %w (size %d vs %d)", spec.fqn, errEncrypted, r.size, spec.size)
+	}
+	r.buf = allocEbuf()
+	return r, nil
+}
+
+// logical size of the encrypted file
+func (r *Ereader) Size() int64 { return r.size }
+
+func (r *Ereader) load(sidx int64) error {
+	if sidx == r.sidx {
+		return nil
+	}
+	var (
+		nonce [12]byte
+		off   = int64(encHdrLen) + sidx*(encSegSize+encTagSize)
+		n     = min(encSegSize+encTagSize, r.psize-off)
+		b     = r.buf[:n]
+	)
+	r.sidx = -1
+	if _, err := r.fh.ReadAt(b, off); err != nil {
+		return err
+	}
+	plain, err := r.aead.Open(b[:0], encNonce(nonce[:], uint64(sidx), sidx == r.nseg-1), b, r.hdr[:])
+	if err != nil {
+		return fmt.Errorf("%s: %w (segment %d)", r.spec.fqn, errEncrypted, sidx)
+	}
+	r.buf, r.sidx = plain, sidx
+	return nil
+}
+
+func (r *Ereader) ReadAt(p []byte, off int64) (n int, err error) {
+	if off < 0 {
+		return 0, errors.New("ereader: negative offset")
+	}
+	for n < len(p) {
+		if off >= r.size {
+			return n, io.EOF
+		}
+		sidx := off / encSegSize
+		if err = r.load(sidx); err != nil {
+			return n, err
+		}
+		m := copy(p[n:], r.buf[off-sidx*encSegSize:])
+		n += m
+		off += int64(m)
+	}
+	return n, nil
+}
+
+func (r *Ereader) Read(p []byte) (n int, err error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	n, err = r.ReadAt(p, r.off)
+	r.off += int64(n)
+	if err == io.EOF && n > 0 {
+		err = nil
+	}
+	return n, err
+}
+
+func (r *Ereader) Seek(offset int64, whence int) (int64, error) {
+	var abs int64
+	switch whence {
+	case io.SeekStart:
+		abs = offset
+	case io.SeekCurrent:
+		abs = r.off + offset
+	case io.SeekEnd:
+		abs = r.size + offset
+	default:
+		return 0, errors.New("ereader: invalid whence")
+	}
+	if abs < 0 {
+		return 0, fmt.Errorf("ereader: invalid offset %d (size %d)", abs, r.size)
+	}
+	r.off = abs
+	return abs, nil
+}
+
+func (r *Ereader) Open() (cos.ReadOpenCloser, error) { return r.spec.reopen() }
+
+func (r *Ereader) Close() error {
+	freeEbuf(r.buf)
+	r.buf = nil
+	return r.fh.Close()
+}
+
+//
+// EC slices (and other content types that are not objects or chunks)
+//
+
+// (nil when the bucket is not encrypted)
+func (ct *CT) ekey() (*Ekey, error) {
+	bprops := ct.bck.Props
+	if ct.contentType != fs.ECSliceCT || bprops == nil || !bprops.Encryption.Enabled {
+		return nil, nil
+	}
+	return bckEkey(&bprops.Encryption, ct.Cname())
+}
+
+func (ct *CT) writeEncrypted(reader io.Reader, size int64, workFQN string, ekey *Ekey, buf []byte) error {
+	fqn := cos.Left(workFQN, ct.fqn)
+	wfh, err := cos.CreateFile(fqn)
+	if err != nil {
+		return err
+	}
+	cw, err := NewCwriter(wfh, "", ekey)
+	if err == nil {
+		var written int64
+		written, err = cos.CopyBuffer(cw, io.LimitReader(reader, size), buf)
+		if err == nil && written != size {
+			err = fmt.Errorf("wrong size %s: expected %d, got %d", fqn, size, written)
+		}
+		if erc := cw.Close(); err == nil {
+			err = erc
+		}
+	}
+	if erc := wfh.Close(); err == nil {
+		err = erc
+	}
+	if err == nil && workFQN != "" {
+		err = cos.Rename(workFQN, ct.fqn)
+	}
+	if err != nil {
+		os.Remove(fqn)
+	}
+	return err
+}
+
+// OpenCT opens existing (EC slice) file for reading and returns the reader along
+// with the file's logical size; decrypts if the file is encrypted
+// (slices are self-describing - see encMagic)
+func OpenCT(fqn string, bck *meta.Bck) (cos.ReadOpenCloser, int64, error) {
+	fh, err := cos.NewFileHandle(fqn)
+	if err != nil {
+		return nil, 0, err
+	}
+	finfo, err := fh.Stat()
+	if err != nil {
+		cos.Close(fh)
+		return nil, 0, err
+	}
+	bprops := bck.Props
+	if bprops == nil || bprops.Encryption.Key == "" {
+		return fh, finfo.Size(), nil
+	}
+	var magic [len(encMagic)]byte
+	if n, _ := fh.ReadAt(magic[:], 0); n < len(magic) || string(magic[:]) != encMagic {
+		return fh, finfo.Size(), nil // (written prior to enabling encryption)
+	}
+	cos.Close(fh)
+
+	spec := &cspec{fqn: fqn, size: -1 /*unknown*/}
+	if spec.ekey, err = bckEkey(&bprops.Encryption, bck.Cname("")); err != nil {
+		return nil, 0, err
+	}
+	r, err := spec.reopen()
+	if err != nil {
+		return nil, 0, err
+	}
+	return r, r.(*Ereader).size, nil
+}
@@ -0,0 +1,202 @@
+// Package core_test provides tests for cluster package
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/env"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/kms"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("At-rest encryption", func() {
+	const (
+		tmpDir    = "/tmp/lencrypt_test"
+		mpath     = tmpDir + "/mpath"
+		bckEnc    = "LENCRYPT_TEST"
+		bckEncZst = "LENCRYPT_ZSTD_TEST"
+		segSize   = 64 * cos.KiB
+	)
+
+	var (
+		mix  = fs.Mountpath{Path: mpath}
+		data []byte
+	)
+
+	data = make([]byte, 300*cos.KiB+17)
+	for i := range data {
+		data[i] = byte(i/7) ^ byte(i%13)
+	}
+
+	BeforeEach(func() {
+		os.Setenv(env.AisKMSMasterKey, hex.EncodeToString(bytes.Repeat([]byte{0x5a}, kms.KeySize)))
+		kekID, key, err := kms.NewDataKey()
+		Expect(err).NotTo(HaveOccurred())
+		encryption := cmn.EncryptionConf{Enabled: true, Key: key, KekID: kekID}
+
+		_ = cos.CreateDir(mpath)
+		_, _ = fs.AddTestMpath(mpath, "daeID")
+		_ = mock.NewTarget(mock.NewBaseBownerMock(
+			meta.NewBck(bckEnc, apc.AIS, cmn.NsGlobal, &cmn.Bprops{
+				Cksum:      cmn.CksumConf{Type: cos.ChecksumOneXxh},
+				Encryption: encryption,
+				BID:        401,
+			}),
+			meta.NewBck(bckEncZst, apc.AIS, cmn.NsGlobal, &cmn.Bprops{
+				Cksum:       cmn.CksumConf{Type: cos.ChecksumOneXxh},
+				Compression: cmn.CompressionConf{Codec: apc.CodecZstd},
+				Encryption:  encryption,
+				BID:         402,
+			}),
+		))
+	})
+
+	AfterEach(func() {
+		_, _ = fs.Remove(mpath)
+		_ = os.RemoveAll(tmpDir)
+		os.Unsetenv(env.AisKMSMasterKey)
+	})
+
+	store := func(bckName string, content []byte) (*core.LOM, int64) {
+		bck := cmn.Bck{Name: bckName, Provider: apc.AIS, Ns: cmn.NsGlobal}
+		fqn := mix.MakePathFQN(&bck, fs.ObjCT, "obj")
+		lom := &core.LOM{}
+		Expect(lom.InitFQN(fqn, &bck)).NotTo(HaveOccurred())
+		Expect(cos.CreateDir(filepath.Dir(fqn))).NotTo(HaveOccurred())
+
+		fh, err := cos.CreateFile(fqn)
+		Expect(err).NotTo(HaveOccurred())
+		cw, err := lom.NewCwriter(fh)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cw.Encrypted()).To(BeTrue())
+		_, err = cw.Write(content)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cw.Close()).NotTo(HaveOccurred())
+		Expect(fh.Close()).NotTo(HaveOccurred())
+
+		lom.SetSize(int64(len(content)))
+		lom.SetStored(cw)
+		lom.SetCksum(cos.NewCksum(cos.ChecksumNone, ""))
+		lom.IncVersion()
+		Expect(lom.Persist()).NotTo(HaveOccurred())
+		lom.UncacheUnless()
+
+		lom2 := &core.LOM{}
+		Expect(lom2.InitFQN(fqn, &bck)).NotTo(HaveOccurred())
+		Expect(lom2.Load(false, false)).NotTo(HaveOccurred())
+		return lom2, cw.Psize()
+	}
+
+	readAll := func(lom *core.LOM) ([]byte, error) {
+		lh, err := lom.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer cos.Close(lh)
+		return io.ReadAll(lh)
+	}
+
+	for _, bckName := range []string{bckEnc, bckEncZst} {
+		It("should round-trip "+bckName, func() {
+			for _, size := range []int{0, 1, segSize, 2*segSize + 1, len(data)} {
+				lom, psize := store(bckName, data[:size])
+				Expect(lom.IsEncrypted()).To(BeTrue())
+				Expect(lom.IsCompressed()).To(Equal(bckName == bckEncZst))
+				Expect(lom.Lsize()).To(BeEquivalentTo(size))
+				Expect(lom.Psize()).To(Equal(psize))
+
+				raw, err := os.ReadFile(lom.FQN)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(int64(len(raw))).To(Equal(psize))
+				if size > 16 {
+					Expect(bytes.Contains(raw, data[:16])).To(BeFalse())
+				}
+
+				b, err := readAll(lom)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(bytes.Equal(b, data[:size])).To(BeTrue(), "size %d", size)
+			}
+		})
+	}
+
+	It("should support random access", func() {
+		lom, _ := store(bckEnc, data)
+		lh, err := lom.Open()
+		Expect(err).NotTo(HaveOccurred())
+		defer cos.Close(lh)
+
+		// including reads that straddle segment boundaries
+		for _, off := range []int64{segSize - 5, 3, 4*segSize - 1, int64(len(data)) - 10} {
+			p := make([]byte, 10)
+			n, err := lh.ReadAt(p, off)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(p[:n]).To(Equal(data[off : off+10]))
+		}
+		_, err = lh.ReadAt(make([]byte, 1), int64(len(data)))
+		Expect(err).To(Equal(io.EOF))
+
+		seeker := lh.(io.Seeker)
+		abs, err := seeker.Seek(segSize+100, io.SeekStart)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(abs).To(BeEquivalentTo(segSize + 100))
+		p := make([]byte, segSize)
+		_, err = io.ReadFull(lh, p)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(p).To(Equal(data[segSize+100 : 2*segSize+100]))
+	})
+
+	It("should detect tampering", func() {
+		lom, psize := store(bckEnc, data)
+		raw, err := os.ReadFile(lom.FQN)
+		Expect(err).NotTo(HaveOccurred())
+
+		// flipped bit
+		tampered := bytes.Clone(raw)
+		tampered[psize/2] ^= 1
+		Expect(os.WriteFile(lom.FQN, tampered, cos.PermRWR)).NotTo(HaveOccurred())
+		_, err = readAll(lom)
+		Expect(err).To(HaveOccurred())
+
+		// truncated at a segment boundary: header + 4 full segments (logical size adjusted accordingly)
+		truncated := raw[:40+4*(segSize+16)]
+		Expect(os.WriteFile(lom.FQN, truncated, cos.PermRWR)).NotTo(HaveOccurred())
+		lom.SetSize(4 * segSize)
+		_, err = readAll(lom)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should encrypt EC slices", func() {
+		lom, _ := store(bckEnc, data)
+		ct := core.NewCTFromLOM(lom, fs.ECSliceCT)
+		Expect(cos.CreateDir(filepath.Dir(ct.FQN()))).NotTo(HaveOccurred())
+		Expect(ct.Write(bytes.NewReader(data), int64(len(data)), "" /*work fqn*/)).NotTo(HaveOccurred())
+
+		raw, err := os.ReadFile(ct.FQN())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bytes.Contains(raw, data[:16])).To(BeFalse())
+
+		r, size, err := core.OpenCT(ct.FQN(), lom.Bck())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(size).To(BeEquivalentTo(len(data)))
+		b, err := io.ReadAll(r)
+		cos.Close(r)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bytes.Equal(b, data)).To(BeTrue())
+	})
+})
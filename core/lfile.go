@@ -88,9 +88,12 @@ var _ io.ReaderAt = (*os.File)(nil) // sanity (whereby UfestReader is guarded el
 // see also: lom.GetROC()
 func (lom *LOM) Open() (lh cos.LomReader, err error) {
 	debug.Assert(lom.IsLocked() > apc.LockNone, lom.Cname(), " is not locked")
-	if lom.IsChunked() {
+	switch {
+	case lom.IsChunked():
 		lh, err = lom.NewUfestReader()
-	} else {
+	case lom.IsEncoded():
+		lh, err = lom.OpenFile(lom.FQN)
+	default:
 		lh, err = os.Open(lom.FQN)
 	}
 	switch {
@@ -501,7 +504,6 @@ func (lom *LOM) newSection(lh cos.LomReader, off, size int64, own bool) (cos.Rea
 		return &ufestSection{LimitedReader: io.LimitedReader{R: r, N: size}, baseSection: base}, nil
 	}
 
-	fh, ok := lh.(*os.File)
-	debug.Assert(ok)
-	return &fileSection{SectionReader: io.NewSectionReader(fh, off, size), baseSection: base}, nil
+	// (*os.File or, when compressed, *Creader)
+	return &fileSection{SectionReader: io.NewSectionReader(lh, off, size), baseSection: base}, nil
 }
@@ -55,6 +55,9 @@ func (lom *LOM) PostInit() error {
 	}
 	uname := lom.bck.MakeUname(lom.ObjName)
 	lom.md.uname = cos.UnsafeSptr(uname)
+	if lom.tiered() {
+		lom.setHRW(lom.isTierHRW())
+	}
 	return nil
 }
 
@@ -93,7 +96,12 @@ func (lom *LOM) InitBck(bck *meta.Bck) (err error) {
 	}
 	uname := lom.bck.MakeUname(lom.ObjName)
 	lom.md.uname = cos.UnsafeSptr(uname)
-	lom.mi, lom.digest, err = fs.Hrw(uname)
+	if lom.tiered() {
+		// new objects go to the hot tier
+		lom.mi, lom.digest, err = lom.hrwTier(fs.GetAvail(), false /*cold*/)
+	} else {
+		lom.mi, lom.digest, err = fs.Hrw(uname)
+	}
 	if err != nil {
 		return
 	}
@@ -45,16 +45,21 @@ const (
 	LcacheEvictedCount   = "lcache.evicted.n"
 	LcacheErrCount       = "err.lcache.n" // errPrefix + "lcache.n"
 	LcacheFlushColdCount = "lcache.flush.cold.n"
+
+	// write-back (see lwback)
+	WritebackPendingCount = "wb.pending.n"
 )
 
 type (
-	lmeta struct { // sizeof = 72
+	lmeta struct { // sizeof = 88
 		copies fs.MPI
 		uname  *string
 		cmn.ObjAttrs
-		atimefs uint64 // (high bit `lomDirtyMask` | int64: atime)
-		lid     lomBID // (for bitwise structure, see lombid.go)
-		flags   uint64 // reserve (storage-class, compression/encryption, write-back, etc.)
+		atimefs uint64  // (high bit `lomDirtyMask` | int64: atime)
+		lid     lomBID  // (for bitwise structure, see lombid.go)
+		flags   uint64  // reserve (storage-class, compression/encryption, write-back, etc.)
+		psize   int64   // physical (on-disk) size iff compressed and/or encrypted, zero otherwise
+		cidx    *cindex // compressed frames (see lcompress.go)
 	}
 	LOM struct {
 		mi      *fs.Mountpath
@@ -653,7 +658,13 @@ func (lom *LOM) Load(cacheit, locked bool) error {
 		defer lom.Unlock(false)
 	}
 	if err := lom.FromFS(); err != nil {
-		return err
+		if !cos.IsNotExist(err) || !lom.tiered() {
+			return err
+		}
+		// (tiering) not in the expected tier
+		if lcache, err = lom.loadOtherTier(); err != nil {
+			return err
+		}
 	}
 
 	if lom.bid() == 0 { // when LOM is a _handle_
@@ -828,7 +839,7 @@ exist:
 
 	// fstat & atime
 	if !lom.md.lid.haslmfl(lmflChunk) {
-		if lom.md.Size != size { // corruption or tampering
+		if lom.Psize() != size { // corruption or tampering
 			return cmn.NewErrLmetaCorrupted(lom.whingeSize(size))
 		}
 	}
@@ -839,7 +850,7 @@ exist:
 }
 
 func (lom *LOM) whingeSize(size int64) error {
-	return fmt.Errorf("errsize (%d != %d)", lom.md.Size, size)
+	return fmt.Errorf("errsize (%d != %d)", lom.Psize(), size)
 }
 
 //
@@ -103,6 +103,8 @@ const (
 	packedCustom
 	packedLid
 	packedFlags
+	packedPsize
+	packedCidx
 )
 
 const (
@@ -114,6 +116,8 @@ const (
 	haveCustom
 	haveLid
 	haveFlags
+	havePsize
+	haveCidx
 )
 
 const prefLen = 10 // 10B prefix [ version = 1 | checksum-type | 64-bit xxhash ]
@@ -381,6 +385,7 @@ func (md *lmeta) unpack(buf []byte) error {
 	if expectedCksum != actualCksum {
 		return cos.NewErrMetaCksum(expectedCksum, actualCksum, md.String())
 	}
+	md.psize, md.cidx = 0, nil // (optional records)
 
 	for off := 0; !last; {
 		var (
@@ -495,6 +500,25 @@ func (md *lmeta) unpack(buf []byte) error {
 			debug.Assert(flags&lmflHRW == 0, "unexpected persisted HRW bit")
 			md.flags = (md.flags & lmflHRW) | (flags &^ lmflHRW)
 			seen |= haveFlags
+		case packedPsize:
+			if seen&havePsize != 0 {
+				return errors.New(badLmeta + " #8")
+			}
+			if len(record) != cos.SizeofI16+cos.SizeofI64 {
+				return errors.New(badLmeta + " #8.1")
+			}
+			md.psize = int64(binary.BigEndian.Uint64(record[cos.SizeofI16:]))
+			seen |= havePsize
+		case packedCidx:
+			if seen&haveCidx != 0 {
+				return errors.New(badLmeta + " #9")
+			}
+			cidx := &cindex{}
+			if err := cidx.unpack(string(record[cos.SizeofI16:])); err != nil {
+				return fmt.Errorf("%s #9.1: %v", badLmeta, err)
+			}
+			md.cidx = cidx
+			seen |= haveCidx
 		default:
 			return errors.New(badLmeta + " #101")
 		}
@@ -549,6 +573,18 @@ func (md *lmeta) pack(mdSize int64) (buf []byte) {
 	binary.BigEndian.PutUint64(b8[:], flags)
 	buf = _prb(buf, b8[:], packedFlags)
 
+	// physical size (compressed only)
+	if md.psize > 0 {
+		binary.BigEndian.PutUint64(b8[:], uint64(md.psize))
+		buf = g.smm.AppendBytes(buf, recdupSepa[:])
+		buf = _prb(buf, b8[:], packedPsize)
+	}
+	if md.cidx != nil {
+		buf = g.smm.AppendBytes(buf, recdupSepa[:])
+		buf = _prso(buf, packedCidx)
+		buf = g.smm.AppendString(buf, md.cidx.pack())
+	}
+
 	// copies
 	if len(md.copies) > 0 {
 		buf = g.smm.AppendBytes(buf, recdupSepa[:])
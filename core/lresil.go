@@ -20,7 +20,15 @@ import (
 func (lom *LOM) Hrw(avail fs.MPI) (*fs.Mountpath, bool /*ok*/) {
 	debug.Assert(lom.IsLocked() == apc.LockWrite, lom.Cname(), "expecting w-locked")
 
-	hrwMi, _, err := avail.Hrw(cos.UnsafeB(*lom.md.uname))
+	var (
+		hrwMi *fs.Mountpath
+		err   error
+	)
+	if lom.tiered() {
+		hrwMi, _, err = lom.hrwTier(avail, lom.IsCold()) // (stay in the current tier)
+	} else {
+		hrwMi, _, err = avail.Hrw(cos.UnsafeB(*lom.md.uname))
+	}
 	if err != nil {
 		nlog.Warningln(err)
 		return nil, false
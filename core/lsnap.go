@@ -0,0 +1,239 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Bucket snapshots (ais:// buckets; see cmn.BckSnapshot):
+// - objects written while the bucket has snapshots are stamped with the write time
+//   (cmn.SnapWrittenObjMD) - object belongs to a snapshot if it was written before the
+//   snapshot was taken, or has no stamp at all (written when there were no snapshots);
+// - prior to being overwritten or deleted, such object gets preserved - hard-linked (fs.ObjSnapCT)
+//   on the same mountpath - for each snapshot that does not have it yet;
+// - preserved objects share metadata (xattr) with the original until the latter is overwritten;
+// - preserved content follows the object: relocated by resilver and sent to the new
+//   owner by rebalance (see reb/preserved);
+// - chunked objects and objects with too-long names cannot be preserved: overwriting
+//   or deleting them fails while they belong to any snapshot.
+
+func (lom *LOM) snapFQN(snap *cmn.BckSnapshot) string {
+	return lom.GenFQN(fs.ObjSnapCT, snap.ID())
+}
+
+// (loaded)
+func (lom *LOM) snapWritten() int64 {
+	v, ok := lom.GetCustomKey(cmn.SnapWrittenObjMD)
+	if !ok {
+		return 0
+	}
+	written, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0 // (treating as belonging to all snapshots)
+	}
+	return written
+}
+
+// StampSnaps marks the object (that is being written) for snapshot membership
+func (lom *LOM) StampSnaps(now int64) {
+	lom.SetCustomKey(cmn.SnapWrittenObjMD, strconv.FormatInt(now, 10))
+}
+
+// PreserveSnaps (under wlock) preserves the current (loaded) object for the bucket snapshots
+// it belongs to - to be called prior to overwriting or deleting the object
+func (lom *LOM) PreserveSnaps() error {
+	snaps := lom.Bprops().Snapshots
+	if len(snaps) == 0 {
+		return nil
+	}
+	written := lom.snapWritten()
+	for i := range snaps {
+		snap := &snaps[i]
+		if !snap.Has(written) {
+			continue
+		}
+		fqn := lom.snapFQN(snap)
+		if lom.IsChunked() || fs.IsFntl(lom.ObjName) {
+			return cmn.NewErrUnsupp("overwrite or delete chunked (or long-named) object", lom.Cname()+" that belongs to snapshot "+snap.Name)
+		}
+		if cos.Stat(fqn) == nil {
+			continue // preserved earlier
+		}
+		if err := cos.CreateDir(filepath.Dir(fqn)); err != nil {
+			return err
+		}
+		if err := os.Link(lom.FQN, fqn); err != nil && !os.IsExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// LoadSnap returns the object as of the specified snapshot:
+// - the preserved object - a clone that must be freed by the caller;
+// - (nil, nil) when the current object (still) belongs to the snapshot;
+// - cos.ErrNotFound otherwise
+// (read-locked or not locked at all is fine)
+func (lom *LOM) LoadSnap(snap *cmn.BckSnapshot) (*LOM, error) {
+	if !fs.IsFntl(lom.ObjName) {
+		clone, err := lom.LoadPreserved(lom.snapFQN(snap))
+		if err == nil {
+			return clone, nil
+		}
+		if !cmn.IsErrLmetaNotFound(err) && !cos.IsNotExist(err) {
+			return nil, err
+		}
+	}
+	err := lom.Load(false /*cache it*/, false /*locked*/)
+	if err == nil && snap.Has(lom.snapWritten()) {
+		return nil, nil
+	}
+	if err != nil && !cos.IsNotExist(err) && !cmn.IsErrObjNought(err) {
+		return nil, err
+	}
+	return nil, cos.NewErrNotFound(T, lom.Cname()+" in snapshot "+snap.Name)
+}
+
+// LoadPreserved loads the object preserved at the given fqn (fs.ObjSnapCT or fs.ObjVerCT);
+// returns a clone that must be freed by the caller
+func (lom *LOM) LoadPreserved(fqn string) (*LOM, error) {
+	clone := lom.CloneTo(fqn)
+	clone.md.SetCustomMD(nil) // (not to share with lom)
+	if _, err := clone.lmfs(true); err != nil {
+		FreeLOM(clone)
+		return nil, err
+	}
+	clone.md.copies = nil
+	clone.md.uname = cos.UnsafeSptr(lom.bck.MakeUname(lom.ObjName))
+	return clone, nil
+}
+
+// RecvPreserved stores preserved content received from another target (rebalance)
+// unless the snapshot is gone or the content is already here
+func (lom *LOM) RecvPreserved(snapID string, oa *cmn.ObjAttrs, r io.Reader, buf []byte) error {
+	var found bool
+	for i := range lom.Bprops().Snapshots {
+		if lom.Bprops().Snapshots[i].ID() == snapID {
+			found = true
+			break
+		}
+	}
+	if !found || fs.IsFntl(lom.ObjName) {
+		return nil
+	}
+	fqn := lom.GenFQN(fs.ObjSnapCT, snapID)
+	if cos.Stat(fqn) == nil {
+		return nil
+	}
+	return lom.recvPreserved(fqn, fs.WorkfileRecvSnap, oa, r, buf)
+}
+
+// (received content is decoded - compress and/or encrypt as per bucket configuration)
+func (lom *LOM) recvPreserved(fqn, workTag string, oa *cmn.ObjAttrs, r io.Reader, buf []byte) error {
+	workFQN := lom.GenFQN(fs.WorkCT, workTag)
+	wfh, err := cos.CreateFile(workFQN)
+	if err != nil {
+		return err
+	}
+	var w io.Writer = wfh
+	cw, err := lom.NewCwriter(wfh)
+	if err != nil {
+		cos.Close(wfh)
+		_ = cos.RemoveFile(workFQN)
+		return err
+	}
+	if cw != nil {
+		w = cw
+	}
+	_, err = io.CopyBuffer(w, r, buf)
+	if cw != nil {
+		if errC := cw.Close(); err == nil {
+			err = errC
+		}
+	}
+	if err != nil {
+		cos.Close(wfh)
+		_ = cos.RemoveFile(workFQN)
+		return err
+	}
+	if err = wfh.Close(); err == nil {
+		clone := lom.CloneTo(workFQN)
+		clone.md.SetCustomMD(nil)
+		clone.CopyAttrs(oa, false /*skip cksum*/)
+		clone.SetStored(cw)
+		clone.setbid(lom.Bprops().BID)
+		err = clone.persistTo()
+		FreeLOM(clone)
+	}
+	if err == nil {
+		err = cos.Rename(workFQN, fqn)
+	}
+	if err != nil {
+		_ = cos.RemoveFile(workFQN)
+	}
+	return err
+}
+
+// (clone) write metadata to clone.FQN
+func (lom *LOM) persistTo() error {
+	md := lom.pack()
+	err := lom.SetXattr(md)
+	g.smm.Free(md)
+	return err
+}
+
+// RelocatePreserved moves misplaced preserved content - fs.ObjSnapCT or fs.ObjVerCT - to its
+// object's HRW mountpath (resilver)
+func RelocatePreserved(ct *CT, buf []byte) error {
+	ci := fs.CSM.ParseUbase(ct.ObjectName(), ct.ContentType())
+	if !ci.Ok {
+		return nil // (space cleanup)
+	}
+	mi, _, err := fs.Hrw(ct.Bck().MakeUname(ci.Base))
+	if err != nil {
+		return err
+	}
+	if mi.Path == ct.Mountpath().Path {
+		return nil
+	}
+	dst := mi.MakePathFQN(ct.Bucket(), ct.ContentType(), ct.ObjectName())
+	if cos.Stat(dst) == nil {
+		return cos.RemoveFile(ct.FQN()) // (same content)
+	}
+	var md []byte
+	if ct.ContentType() == fs.ObjVerCT {
+		// (with the time it got superseded - see LoadRetained)
+		lom := AllocLOM(ci.Base)
+		defer FreeLOM(lom)
+		if err := lom.InitBck(ct.Bck()); err != nil {
+			return err
+		}
+		clone, err := lom.LoadRetained(ct.FQN(), ci.Extras[0], len(ci.Extras) > 1)
+		if err != nil {
+			return err
+		}
+		md = clone.pack()
+		defer g.smm.Free(md)
+		FreeLOM(clone)
+	} else if md, err = fs.GetXattr(ct.FQN(), fs.XattrLOM); err != nil {
+		return err
+	}
+	if _, _, err := cos.CopyFile(ct.FQN(), dst, buf, cos.ChecksumNone); err != nil {
+		return err
+	}
+	if err := fs.SetXattr(dst, fs.XattrLOM, md); err != nil {
+		_ = cos.RemoveFile(dst)
+		return err
+	}
+	return cos.RemoveFile(ct.FQN())
+}
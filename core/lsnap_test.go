@@ -0,0 +1,117 @@
+// Package core_test provides tests for cluster package
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core_test
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Bucket snapshots", func() {
+	const (
+		tmpDir  = "/tmp/lsnap_test"
+		mpath   = tmpDir + "/mp"
+		bckName = "LSNAP_TEST"
+		size    = cos.KiB
+	)
+
+	var (
+		now  = time.Now()
+		snap = cmn.BckSnapshot{Name: "daily", Created: now.UnixNano()}
+		bck  = cmn.Bck{Name: bckName, Provider: apc.AIS, Ns: cmn.NsGlobal}
+		mbck = meta.NewBck(bckName, apc.AIS, cmn.NsGlobal, &cmn.Bprops{
+			Cksum:     cmn.CksumConf{Type: cos.ChecksumNone},
+			Snapshots: []cmn.BckSnapshot{snap},
+			BID:       403,
+		})
+		fqns []string // (see lworm_test)
+	)
+
+	BeforeEach(func() {
+		_ = cos.CreateDir(mpath)
+		_, _ = fs.AddTestMpath(mpath, "daeID")
+		_ = mock.NewTarget(mock.NewBaseBownerMock(mbck))
+	})
+
+	AfterEach(func() {
+		for _, fqn := range fqns {
+			_ = os.Remove(fqn)
+		}
+		fqns = fqns[:0]
+		_, _ = fs.Remove(mpath)
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	// put and load; zero `written` - no stamp
+	put := func(objName string, written time.Time) *core.LOM {
+		lom := &core.LOM{ObjName: objName}
+		Expect(lom.InitCmnBck(&bck)).NotTo(HaveOccurred())
+		fqns = append(fqns, lom.FQN, lom.GenFQN(fs.ObjSnapCT, snap.ID()))
+		lom = filePut(lom.FQN, size)
+		if !written.IsZero() {
+			lom.StampSnaps(written.UnixNano())
+			Expect(persist(lom)).NotTo(HaveOccurred())
+			lom.UncacheUnless()
+		}
+		Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+		return lom
+	}
+
+	It("should decide membership by the stamp rather than mtime", func() {
+		// written before the snapshot (or with no snapshots at all): current object belongs
+		for _, lom := range []*core.LOM{put("a/before", now.Add(-time.Minute)), put("a/nostamp", time.Time{})} {
+			slom, err := lom.LoadSnap(&snap)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(slom).To(BeNil())
+		}
+
+		// written after: not in the snapshot
+		lom := put("a/after", now.Add(time.Minute))
+		_, err := lom.LoadSnap(&snap)
+		Expect(cos.IsNotExist(err)).To(BeTrue())
+		Expect(lom.PreserveSnaps()).NotTo(HaveOccurred())
+		Expect(cos.Stat(lom.GenFQN(fs.ObjSnapCT, snap.ID()))).To(HaveOccurred())
+	})
+
+	It("should preserve member prior to overwriting it", func() {
+		lom := put("b/obj", now.Add(-time.Minute))
+		cksum := lom.Checksum()
+		Expect(lom.PreserveSnaps()).NotTo(HaveOccurred())
+		Expect(cos.Stat(lom.GenFQN(fs.ObjSnapCT, snap.ID()))).NotTo(HaveOccurred())
+
+		// overwrite
+		Expect(os.Remove(lom.FQN)).NotTo(HaveOccurred())
+		lom = put("b/obj", now.Add(time.Minute))
+		slom, err := lom.LoadSnap(&snap)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(slom).NotTo(BeNil())
+		Expect(slom.Lsize()).To(BeEquivalentTo(size))
+		Expect(slom.Checksum().Equal(cksum)).To(BeTrue())
+		core.FreeLOM(slom)
+	})
+
+	It("should refuse to overwrite or delete chunked member", func() {
+		lom := &core.LOM{ObjName: "c/chunked"}
+		Expect(lom.InitCmnBck(&bck)).NotTo(HaveOccurred())
+		fqns = append(fqns, lom.FQN)
+		lom = prepareLOMChunked(lom.FQN, 2)
+		err := lom.PreserveSnaps()
+		Expect(err).To(HaveOccurred())
+		var errUnsupp *cmn.ErrUnsupp
+		Expect(errors.As(err, &errUnsupp)).To(BeTrue())
+	})
+})
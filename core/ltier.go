@@ -0,0 +1,129 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Storage-class tiering (see cmn.TieringConf):
+// - each tier is HRW-placed across the mountpaths labeled with the tier's storage class
+//   (or across all mountpaths when the target has none - see fs.HrwLabel);
+// - new objects are written to the hot tier (InitBck);
+// - an object is properly located (IsHRW) if it is at its HRW location within the tier
+//   it currently resides in;
+// - Load looks up the object in the other tier when it's not where expected;
+// - x-tiering moves objects between tiers (MoveTo).
+
+func (lom *LOM) tiered() bool { return lom.bck.Props != nil && lom.bck.Props.Tiering.Enabled }
+
+// returns true if the object resides in the cold tier (ie., on a mountpath labeled as such)
+func (lom *LOM) IsCold() bool {
+	return lom.tiered() && lom.mi.Label == lom.bck.Props.Tiering.ColdLabel()
+}
+
+// returns expected (HRW) mountpath within the hot or cold tier
+func (lom *LOM) TierMpath(avail fs.MPI, cold bool) (*fs.Mountpath, error) {
+	mi, _, err := lom.hrwTier(avail, cold)
+	return mi, err
+}
+
+func (lom *LOM) hrwTier(avail fs.MPI, cold bool) (*fs.Mountpath, uint64, error) {
+	tc := &lom.bck.Props.Tiering
+	label := tc.HotLabel()
+	if cold {
+		label = tc.ColdLabel()
+	}
+	return avail.HrwLabel(cos.UnsafeB(*lom.md.uname), label)
+}
+
+// (PostInit)
+func (lom *LOM) isTierHRW() bool {
+	mi, err := lom.TierMpath(fs.GetAvail(), lom.IsCold())
+	return err == nil && mi.Path == lom.mi.Path
+}
+
+// (Load) not found at its current location - try the other tier;
+// if found, lom.mi and lom.FQN get updated to point to the object's actual location
+func (lom *LOM) loadOtherTier() (lcache *sync.Map, err error) {
+	mi, err := lom.TierMpath(fs.GetAvail(), !lom.IsCold())
+	if err != nil || mi.Path == lom.mi.Path {
+		return nil, cos.NewErrNotFound(T, lom.Cname())
+	}
+	var (
+		savedMi  = lom.mi
+		savedFQN = lom.FQN
+		savedHRW = lom.IsHRW()
+	)
+	lom.mi, lom.FQN = mi, mi.MakePathFQN(lom.Bucket(), fs.ObjCT, lom.ObjName)
+	lom.setHRW(true)
+
+	lcache, lmd := lom.fromCache()
+	if lmd != nil {
+		lom.md = *lmd
+		lom.setHRW(true)
+		if lom.IsFntl() {
+			lom.fixupFntl()
+		}
+		return lcache, nil
+	}
+	if err = lom.FromFS(); err != nil {
+		lom.mi, lom.FQN = savedMi, savedFQN
+		lom.setHRW(savedHRW)
+		return nil, err
+	}
+	return lcache, nil
+}
+
+// (PUT) the new version is written to the hot tier - remove the (now stale) cold one, if any
+// (and vice versa); must be w-locked
+func (lom *LOM) DelOtherTier() {
+	debug.Assert(lom.IsLocked() == apc.LockWrite, lom.Cname(), "expecting w-locked")
+	if !lom.tiered() {
+		return
+	}
+	mi, err := lom.TierMpath(fs.GetAvail(), !lom.IsCold())
+	if err != nil || mi.Path == lom.mi.Path {
+		return
+	}
+	other := lom.CloneTo(mi.MakePathFQN(lom.Bucket(), fs.ObjCT, lom.ObjName))
+	other.mi = mi
+	other.lcache().Delete(other.digest)
+	if err := cos.RemoveFile(other.FQN); err != nil && !cos.IsNotExist(err) {
+		nlog.Errorln("failed to remove stale", lom.Cname(), "from the other tier:", err)
+	}
+	FreeLOM(other)
+}
+
+// move the object to a given mountpath in the other tier: copy, and then remove the source
+// - must be w-locked
+// - not supporting chunked objects and mirrored copies (see x-tiering)
+func (lom *LOM) MoveTo(mi *fs.Mountpath, buf []byte) error {
+	debug.Assert(lom.IsLocked() == apc.LockWrite, lom.Cname(), "expecting w-locked")
+	debug.Assert(!lom.IsChunked() && !lom.HasCopies(), lom.Cname())
+
+	dst, err := lom.Copy2FQN(mi.MakePathFQN(lom.Bucket(), fs.ObjCT, lom.ObjName), buf)
+	if err != nil {
+		return err
+	}
+	dstFQN := dst.FQN
+	FreeLOM(dst)
+
+	lom.UncacheDel()
+	if err := lom.RemoveMain(); err != nil {
+		// undo (keep a single instance)
+		if errV := cos.RemoveFile(dstFQN); errV != nil {
+			nlog.Errorln("nested err:", errV)
+		}
+		return err
+	}
+	return nil
+}
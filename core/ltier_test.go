@@ -0,0 +1,118 @@
+// Package core_test provides tests for cluster package
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core_test
+
+import (
+	"os"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Storage-class tiering", func() {
+	const (
+		tmpDir  = "/tmp/ltier_test"
+		hotMp   = tmpDir + "/nvme"
+		coldMp  = tmpDir + "/hdd"
+		bckName = "LTIER_TEST"
+		objName = "dir/obj"
+		size    = 10 * cos.KiB
+	)
+
+	bck := cmn.Bck{Name: bckName, Provider: apc.AIS, Ns: cmn.NsGlobal}
+
+	BeforeEach(func() {
+		for mpath, label := range map[string]cos.MountpathLabel{hotMp: "nvme", coldMp: "hdd"} {
+			_ = cos.CreateDir(mpath)
+			_, _ = fs.AddTestMpath(mpath, "daeID")
+			fs.GetAvail()[mpath].Label = label
+		}
+		_ = mock.NewTarget(mock.NewBaseBownerMock(
+			meta.NewBck(bckName, apc.AIS, cmn.NsGlobal, &cmn.Bprops{
+				Cksum:   cmn.CksumConf{Type: cos.ChecksumNone},
+				Tiering: cmn.TieringConf{Hot: "nvme", Cold: "hdd", ColdAfter: cos.Duration(time.Hour), Enabled: true},
+				BID:     401,
+			}),
+		))
+	})
+
+	AfterEach(func() {
+		_, _ = fs.Remove(hotMp)
+		_, _ = fs.Remove(coldMp)
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	initLOM := func() *core.LOM {
+		lom := &core.LOM{ObjName: objName}
+		Expect(lom.InitCmnBck(&bck)).NotTo(HaveOccurred())
+		return lom
+	}
+
+	It("should write to the hot tier and find objects in the cold one", func() {
+		lom := initLOM()
+		Expect(lom.Mountpath().Path).To(Equal(hotMp))
+		Expect(lom.IsHRW()).To(BeTrue())
+		Expect(lom.IsCold()).To(BeFalse())
+		hotFQN := lom.FQN
+		filePut(hotFQN, size)
+
+		// demote
+		lom = initLOM()
+		lom.Lock(true)
+		Expect(lom.Load(true /*cache it*/, true /*locked*/)).NotTo(HaveOccurred())
+		mi, err := lom.TierMpath(fs.GetAvail(), true /*cold*/)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mi.Path).To(Equal(coldMp))
+		Expect(lom.MoveTo(mi, make([]byte, cos.KiB))).NotTo(HaveOccurred())
+		lom.Unlock(true)
+
+		coldFQN := mi.MakePathFQN(&bck, fs.ObjCT, objName)
+		Expect(cos.Stat(hotFQN)).To(HaveOccurred())
+		Expect(cos.Stat(coldFQN)).NotTo(HaveOccurred())
+
+		// transparent lookup
+		lom = initLOM()
+		Expect(lom.Load(false /*cache it*/, false /*locked*/)).NotTo(HaveOccurred())
+		Expect(lom.FQN).To(Equal(coldFQN))
+		Expect(lom.Lsize()).To(BeEquivalentTo(size))
+		Expect(lom.IsCold()).To(BeTrue())
+		Expect(lom.IsHRW()).To(BeTrue())
+
+		// properly located in the cold tier (ie., not misplaced)
+		lom = &core.LOM{}
+		Expect(lom.InitFQN(coldFQN, &bck)).NotTo(HaveOccurred())
+		Expect(lom.IsHRW()).To(BeTrue())
+	})
+
+	It("should remove stale cold version upon write", func() {
+		lom := initLOM()
+		hotFQN := lom.FQN
+		coldMi, err := lom.TierMpath(fs.GetAvail(), true /*cold*/)
+		Expect(err).NotTo(HaveOccurred())
+		coldFQN := coldMi.MakePathFQN(&bck, fs.ObjCT, objName)
+
+		filePut(coldFQN, size)
+		filePut(hotFQN, 2*size)
+
+		lom = initLOM()
+		lom.Lock(true)
+		Expect(lom.Load(false /*cache it*/, true /*locked*/)).NotTo(HaveOccurred())
+		Expect(lom.Lsize()).To(BeEquivalentTo(2 * size))
+		lom.DelOtherTier()
+		lom.Unlock(true)
+
+		Expect(cos.Stat(hotFQN)).NotTo(HaveOccurred())
+		Expect(cos.Stat(coldFQN)).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,291 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/ios"
+)
+
+// Object version history (ais:// buckets; see cmn.VersionConf.KeepHistory):
+// - prior versions are hard links (fs.ObjVerCT) to the overwritten or deleted object
+//   on the same mountpath, with object metadata (xattr) traveling along;
+// - deletion additionally produces a delete marker (an empty fs.ObjVerCT file with metadata of its own);
+// - the time a version gets superseded is recorded in its metadata (cmn.VerSupersededObjMD)
+//   once the object is overwritten or deleted (prior to that, the two share metadata);
+//   history retained prior to this change falls back to inode ctime;
+// - not retained: chunked objects and objects with too-long names;
+// - history follows the object: relocated by resilver and sent to the new owner
+//   by rebalance (see reb/preserved).
+// Unless noted otherwise, the methods below require a write-locked LOM.
+
+type ObjVer struct {
+	Time      time.Time // when superseded (ie., overwritten or deleted)
+	Version   string
+	FQN       string
+	DelMarker bool
+}
+
+func (lom *LOM) verFQN(version string, delMarker bool) string {
+	if delMarker {
+		return lom.GenFQN(fs.ObjVerCT, version, fs.VerDelMarker)
+	}
+	return lom.GenFQN(fs.ObjVerCT, version)
+}
+
+// Versions returns retained versions and delete markers, oldest first
+// (read-locked or not locked at all is fine)
+func (lom *LOM) Versions() ([]*ObjVer, error) {
+	if fs.IsFntl(lom.ObjName) {
+		return nil, nil
+	}
+	dir := filepath.Dir(lom.verFQN(lomInitialVersion, false))
+	des, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			err = nil
+		}
+		return nil, err
+	}
+	prefix := lom.ObjName + fs.VerDirSuffix + cos.PathSeparator
+	vers := make([]*ObjVer, 0, len(des))
+	for _, de := range des {
+		if !de.Type().IsRegular() {
+			continue
+		}
+		ci := fs.CSM.ParseUbase(prefix+de.Name(), fs.ObjVerCT)
+		if !ci.Ok || ci.Base != lom.ObjName {
+			continue
+		}
+		finfo, err := de.Info()
+		if err != nil {
+			continue // removed in the meantime
+		}
+		fqn := filepath.Join(dir, de.Name())
+		ov := &ObjVer{
+			Time:      lom.SupersededAt(fqn, finfo),
+			Version:   ci.Extras[0],
+			FQN:       fqn,
+			DelMarker: len(ci.Extras) > 1,
+		}
+		vers = append(vers, ov)
+	}
+	sort.Slice(vers, func(i, j int) bool { return verLess(vers[i].Version, vers[j].Version) })
+	return vers, nil
+}
+
+// SupersededAt returns the time the version (or delete marker) at the given fqn got superseded:
+// recorded in its metadata or, if not (yet), inode ctime (linked or created)
+func (lom *LOM) SupersededAt(fqn string, finfo os.FileInfo) time.Time {
+	if clone, err := lom.LoadPreserved(fqn); err == nil {
+		v, ok := clone.GetCustomKey(cmn.VerSupersededObjMD)
+		FreeLOM(clone)
+		if ok {
+			if ns, err := strconv.ParseInt(v, 10, 64); err == nil {
+				return time.Unix(0, ns)
+			}
+		}
+	}
+	return ios.GetCTime(finfo)
+}
+
+// (valid versions are positive integers - see fs.ObjVerCT)
+func verLess(a, b string) bool {
+	if len(a) != len(b) {
+		return len(a) < len(b)
+	}
+	return a < b
+}
+
+// NextVersion returns the version to assign to a new object: the greater of
+// current and the latest retained, incremented (so that version numbers are never reused)
+func NextVersion(cur string, vers []*ObjVer) string {
+	if l := len(vers); l > 0 && (cur == "" || verLess(cur, vers[l-1].Version)) {
+		cur = vers[l-1].Version
+	}
+	if cur == "" {
+		return lomInitialVersion
+	}
+	n, err := strconv.ParseInt(cur, 10, 64)
+	if err != nil {
+		return lomInitialVersion
+	}
+	return strconv.FormatInt(n+1, 10)
+}
+
+// SaveVersion retains the current (loaded) object as a prior version
+func (lom *LOM) SaveVersion() error {
+	if lom.IsChunked() || fs.IsFntl(lom.ObjName) {
+		nlog.Warningln("version history: not retaining", lom.Cname(), "[ chunked or name too long ]")
+		return nil
+	}
+	fqn := lom.verFQN(lom.Version(), false)
+	if err := cos.CreateDir(filepath.Dir(fqn)); err != nil {
+		return err
+	}
+	err := os.Link(lom.FQN, fqn)
+	if os.IsExist(err) {
+		// (unlikely) e.g., same version re-written after failing to finalize
+		if err = os.Remove(fqn); err == nil {
+			err = os.Link(lom.FQN, fqn)
+		}
+	}
+	return err
+}
+
+// Supersede records the time the saved version got superseded - to be called
+// once the object is overwritten or deleted (see SaveVersion)
+func (lom *LOM) Supersede(version string, now int64) error {
+	clone, err := lom.LoadPreserved(lom.verFQN(version, false))
+	if err != nil {
+		if cmn.IsErrLmetaNotFound(err) || cos.IsNotExist(err) {
+			err = nil // (not retained)
+		}
+		return err
+	}
+	clone.SetCustomKey(cmn.VerSupersededObjMD, strconv.FormatInt(now, 10))
+	err = clone.persistTo()
+	FreeLOM(clone)
+	return err
+}
+
+// PutDelMarker records deletion of the object (see NextVersion)
+func (lom *LOM) PutDelMarker(version string, now int64) error {
+	if fs.IsFntl(lom.ObjName) {
+		return nil
+	}
+	fqn := lom.verFQN(version, true)
+	fh, err := cos.CreateFile(fqn)
+	if err != nil {
+		return err
+	}
+	if err := fh.Close(); err != nil {
+		return err
+	}
+	marker := lom.delMarker(fqn, version, now)
+	err = marker.persistTo()
+	FreeLOM(marker)
+	return err
+}
+
+func (lom *LOM) delMarker(fqn, version string, now int64) *LOM {
+	marker := lom.CloneTo(fqn)
+	marker.md = lmeta{uname: cos.UnsafeSptr(lom.bck.MakeUname(lom.ObjName))}
+	marker.SetVersion(version)
+	marker.SetAtimeUnix(now)
+	marker.setbid(lom.Bprops().BID)
+	marker.SetCustomKey(cmn.VerSupersededObjMD, strconv.FormatInt(now, 10))
+	return marker
+}
+
+// LoadRetained loads the version or delete marker at the given fqn (fs.ObjVerCT) to be
+// migrated - a clone that must be freed by the caller - with the time it got superseded
+// recorded (see SupersededAt)
+func (lom *LOM) LoadRetained(fqn, version string, delMarker bool) (*LOM, error) {
+	finfo, err := os.Stat(fqn)
+	if err != nil {
+		return nil, err
+	}
+	clone, err := lom.LoadPreserved(fqn)
+	if err != nil {
+		if !delMarker || !cmn.IsErrLmetaNotFound(err) {
+			return nil, err
+		}
+		// (delete marker with no metadata)
+		return lom.delMarker(fqn, version, ios.GetCTime(finfo).UnixNano()), nil
+	}
+	if _, ok := clone.GetCustomKey(cmn.VerSupersededObjMD); !ok {
+		clone.SetCustomKey(cmn.VerSupersededObjMD, strconv.FormatInt(ios.GetCTime(finfo).UnixNano(), 10))
+	}
+	return clone, nil
+}
+
+// RecvVersion stores the version or delete marker received from another target (rebalance)
+// unless the bucket does not keep history (anymore) or the version is already here
+func (lom *LOM) RecvVersion(version string, delMarker bool, oa *cmn.ObjAttrs, r io.Reader, buf []byte) error {
+	if !lom.Bprops().Versioning.KeepHistory() || fs.IsFntl(lom.ObjName) {
+		return nil
+	}
+	fqn := lom.verFQN(version, delMarker)
+	if cos.Stat(fqn) == nil {
+		return nil
+	}
+	return lom.recvPreserved(fqn, fs.WorkfileRecvVer, oa, r, buf)
+}
+
+// LoadVersion returns a prior version's LOM - a clone that must be freed by the caller;
+// returns cos.ErrNotFound if the version does not exist or is a delete marker
+func (lom *LOM) LoadVersion(version string) (*LOM, error) {
+	if fs.IsFntl(lom.ObjName) {
+		return nil, cos.NewErrNotFound(T, lom.Cname()+" version "+version)
+	}
+	clone, err := lom.LoadPreserved(lom.verFQN(version, false))
+	if err != nil {
+		if cmn.IsErrLmetaNotFound(err) || cos.IsNotExist(err) {
+			err = cos.NewErrNotFound(T, lom.Cname()+" version "+version)
+		}
+		return nil, err
+	}
+	return clone, nil
+}
+
+// RestoreVersion makes the specified (data) version current again
+// (expecting the object itself to be not present)
+func (lom *LOM) RestoreVersion(ov *ObjVer) error {
+	clone, err := lom.LoadPreserved(ov.FQN)
+	if err != nil {
+		return err
+	}
+	if _, ok := clone.GetCustomKey(cmn.VerSupersededObjMD); ok {
+		clone.DelCustomKey(cmn.VerSupersededObjMD)
+		err = clone.persistTo()
+	}
+	FreeLOM(clone)
+	if err != nil {
+		return err
+	}
+	if err := cos.Rename(ov.FQN, lom.FQN); err != nil {
+		return err
+	}
+	lom.Uncache()
+	return nil
+}
+
+// PruneVersions removes versions in excess of `keep_versions` or older than `keep_days`;
+// returns the number of removed versions and delete markers
+func (lom *LOM) PruneVersions(vers []*ObjVer, now time.Time) (n int) {
+	var (
+		vconf  = lom.VersionConf()
+		excess = len(vers) - vconf.KeepVersions
+		cutoff time.Time
+	)
+	if vconf.KeepVersions == 0 {
+		excess = 0
+	}
+	if vconf.KeepDays > 0 {
+		cutoff = now.Add(-time.Duration(vconf.KeepDays) * 24 * time.Hour)
+	}
+	for i, ov := range vers {
+		if i >= excess && (cutoff.IsZero() || ov.Time.After(cutoff)) {
+			continue
+		}
+		if err := cos.RemoveFile(ov.FQN); err != nil {
+			nlog.Warningln("version history: failed to prune", lom.Cname(), ov.Version, err)
+			continue
+		}
+		n++
+	}
+	return n
+}
@@ -0,0 +1,112 @@
+// Package core_test provides tests for cluster package
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core_test
+
+import (
+	"os"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Object version history", func() {
+	const (
+		tmpDir  = "/tmp/lversion_test"
+		mpath   = tmpDir + "/mp"
+		bckName = "LVERSION_TEST"
+		size    = cos.KiB
+	)
+
+	var (
+		bck  = cmn.Bck{Name: bckName, Provider: apc.AIS, Ns: cmn.NsGlobal}
+		mbck = meta.NewBck(bckName, apc.AIS, cmn.NsGlobal, &cmn.Bprops{
+			Cksum:      cmn.CksumConf{Type: cos.ChecksumNone},
+			Versioning: cmn.VersionConf{Enabled: true, KeepDays: 1},
+			BID:        404,
+		})
+	)
+
+	BeforeEach(func() {
+		_ = cos.CreateDir(mpath)
+		_, _ = fs.AddTestMpath(mpath, "daeID")
+		_ = mock.NewTarget(mock.NewBaseBownerMock(mbck))
+	})
+
+	AfterEach(func() {
+		_, _ = fs.Remove(mpath)
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	put := func(objName string) *core.LOM {
+		lom := &core.LOM{ObjName: objName}
+		Expect(lom.InitCmnBck(&bck)).NotTo(HaveOccurred())
+		lom = filePut(lom.FQN, size)
+		Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+		return lom
+	}
+
+	It("should record when version got superseded and prune by it", func() {
+		var (
+			lom        = put("a/obj")
+			superseded = time.Now().Add(-48 * time.Hour)
+		)
+		Expect(lom.SaveVersion()).NotTo(HaveOccurred())
+		Expect(lom.Supersede(lom.Version(), superseded.UnixNano())).NotTo(HaveOccurred())
+		Expect(lom.PutDelMarker("2", superseded.UnixNano())).NotTo(HaveOccurred())
+
+		vers, err := lom.Versions()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vers).To(HaveLen(2))
+		Expect(vers[1].DelMarker).To(BeTrue())
+		for _, ov := range vers {
+			Expect(ov.Time.UnixNano()).To(Equal(superseded.UnixNano()))
+		}
+
+		// (regardless of inode ctime)
+		Expect(lom.PruneVersions(vers, time.Now())).To(Equal(2))
+	})
+
+	It("should record supersede time when migrating legacy history", func() {
+		lom := put("b/obj")
+		fqn := lom.GenFQN(fs.ObjVerCT, "2", fs.VerDelMarker)
+		fh, err := cos.CreateFile(fqn) // (delete marker with no metadata)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fh.Close()).NotTo(HaveOccurred())
+
+		marker, err := lom.LoadRetained(fqn, "2", true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(marker.Version()).To(Equal("2"))
+		_, ok := marker.GetCustomKey(cmn.VerSupersededObjMD)
+		Expect(ok).To(BeTrue())
+		core.FreeLOM(marker)
+	})
+
+	It("should not carry supersede time when restoring version", func() {
+		lom := put("c/obj")
+		ver := lom.Version()
+		Expect(lom.SaveVersion()).NotTo(HaveOccurred())
+		Expect(os.Remove(lom.FQN)).NotTo(HaveOccurred())
+		Expect(lom.Supersede(ver, time.Now().UnixNano())).NotTo(HaveOccurred())
+
+		vers, err := lom.Versions()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vers).To(HaveLen(1))
+		Expect(lom.RestoreVersion(vers[0])).NotTo(HaveOccurred())
+
+		Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+		Expect(lom.Version()).To(Equal(ver))
+		_, ok := lom.GetCustomKey(cmn.VerSupersededObjMD)
+		Expect(ok).To(BeFalse())
+	})
+})
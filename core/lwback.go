@@ -0,0 +1,215 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Delayed (write-back) data write policy for remote buckets (`write_policy.data` = apc.WriteDelayed):
+// - PUT returns upon local write, with the object marked as pending - an fs.WritebackCT
+//   file that is co-located with the object on the same mountpath;
+// - x-write-back (xs/wback) uploads pending objects to the remote backend and unmarks them;
+// - the marks are the (crash-safe) upload queue: upon restart, pending uploads resume;
+// - each write re-marks the object with a new generation, so that concurrent overwrite
+//   is never unmarked without being uploaded;
+// - written through: objects with too-long names; buckets with S3 presigned requests.
+
+type WbMark struct {
+	Hdr http.Header `json:"hdr,omitempty"` // user metadata from the original request (e.g., "X-Amz-Meta-*")
+	Gen int64       `json:"gen,string"`    // (unix nano) write generation
+}
+
+// buckets that may have pending write-back (see WbDirtyBcks)
+var wbDirty sync.Map // uname => cmn.Bck
+
+// WbEnabled returns true if the object must be written back (rather than through)
+func WbEnabled(lom *LOM) bool {
+	return lom.Bck().IsRemote() && lom.Bprops().WritePolicy.Data == apc.WriteDelayed &&
+		!fs.IsFntl(lom.ObjName) && !lom.IsFeatureSet(feat.S3PresignedRequest)
+}
+
+func (lom *LOM) wbFQN() string { return lom.GenFQN(fs.WritebackCT) }
+
+// WbPending returns true if the object is pending write-back
+func (lom *LOM) WbPending() bool {
+	if !lom.Bck().IsRemote() || fs.IsFntl(lom.ObjName) {
+		return false
+	}
+	return cos.Stat(lom.wbFQN()) == nil
+}
+
+// WbMark (under wlock) marks the object as pending write-back - prior to finalizing
+// the (new) object in place
+func (lom *LOM) WbMark(oreq *http.Request) error {
+	mark := WbMark{Gen: time.Now().UnixNano()}
+	if oreq != nil {
+		for k, v := range oreq.Header {
+			if strings.HasPrefix(k, cmn.AwsHeaderMetaPrefix) || strings.HasPrefix(k, cmn.OCIHeaderMetaPrefix) {
+				if mark.Hdr == nil {
+					mark.Hdr = make(http.Header, 4)
+				}
+				mark.Hdr[k] = v
+			}
+		}
+	}
+	var (
+		fqn     = lom.wbFQN()
+		workFQN = lom.GenFQN(fs.WorkCT, fs.WritebackCT)
+		existed = cos.Stat(fqn) == nil
+	)
+	fh, err := cos.CreateFile(workFQN)
+	if err != nil {
+		return err
+	}
+	_, err = fh.Write(cos.MustMarshal(&mark))
+	cos.Close(fh)
+	if err == nil {
+		err = cos.Rename(workFQN, fqn)
+	}
+	if err != nil {
+		if errRm := cos.RemoveFile(workFQN); errRm != nil {
+			nlog.Errorln("nested err:", errRm)
+		}
+		return cmn.NewErrFailedTo(T, "mark for write-back", lom.Cname(), err)
+	}
+	if !existed {
+		T.StatsUpdater().Add(WritebackPendingCount, 1)
+	}
+	WbSetDirty(lom.Bucket())
+	return nil
+}
+
+// WbCancel (under wlock) unmarks the object - e.g., when deleting it
+// returns true if the object was pending
+func (lom *LOM) WbCancel() bool {
+	if fs.IsFntl(lom.ObjName) {
+		return false
+	}
+	return wbUnmark(lom.wbFQN(), lom.Mountpath(), lom.Bucket())
+}
+
+// LoadWbMark reads the mark (see WbMark above) given its FQN
+func LoadWbMark(fqn string) (*WbMark, error) {
+	b, err := os.ReadFile(fqn)
+	if err != nil {
+		return nil, err
+	}
+	mark := &WbMark{}
+	if err := cos.JSON.Unmarshal(b, mark); err != nil {
+		return nil, err
+	}
+	return mark, nil
+}
+
+// Req returns (surrogate) original request to pass user metadata to the backend, if any
+func (mark *WbMark) Req() *http.Request {
+	if len(mark.Hdr) == 0 {
+		return nil
+	}
+	return &http.Request{Method: http.MethodPut, Header: mark.Hdr, URL: &url.URL{}}
+}
+
+// WbDone (under wlock) unmarks the object upon successful upload, unless it has been
+// overwritten (re-marked) in the meantime; returns true when unmarked
+func (lom *LOM) WbDone(fqn string, gen int64) bool {
+	mark, err := LoadWbMark(fqn)
+	if err != nil || mark.Gen != gen {
+		return false
+	}
+	return wbUnmark(fqn, lom.Mountpath(), lom.Bucket())
+}
+
+func wbUnmark(fqn string, mi *fs.Mountpath, bck *cmn.Bck) bool {
+	if err := os.Remove(fqn); err != nil {
+		if !os.IsNotExist(err) {
+			nlog.Errorln("failed to unmark write-back:", err)
+		}
+		return false
+	}
+	T.StatsUpdater().Add(WritebackPendingCount, -1)
+
+	// remove empty parent directories, if any (failing when not empty)
+	root := mi.MakePathCT(bck, fs.WritebackCT)
+	for dir := filepath.Dir(fqn); len(dir) > len(root) && strings.HasPrefix(dir, root); dir = filepath.Dir(dir) {
+		if os.Remove(dir) != nil {
+			break
+		}
+	}
+	return true
+}
+
+func WbSetDirty(bck *cmn.Bck) { wbDirty.Store(string(bck.MakeUname("")), *bck) }
+
+// WbDirtyBcks returns (and clears) the buckets that may have pending write-back
+func WbDirtyBcks() (bcks []cmn.Bck) {
+	wbDirty.Range(func(k, v any) bool {
+		wbDirty.Delete(k)
+		bcks = append(bcks, v.(cmn.Bck))
+		return true
+	})
+	return bcks
+}
+
+// WbHasPending returns true if any of the bucket's objects is pending write-back
+func WbHasPending(bck *cmn.Bck) (found bool) {
+	avail := fs.GetAvail()
+	for _, mi := range avail {
+		dir := mi.MakePathCT(bck, fs.WritebackCT)
+		if cos.Stat(dir) != nil {
+			continue
+		}
+		_ = fs.Walk(&fs.WalkOpts{Dir: dir, Callback: func(_ string, de fs.DirEntry) error {
+			if de.IsDir() {
+				return nil
+			}
+			found = true
+			return fs.ErrWalkStopped
+		}})
+		if found {
+			break
+		}
+	}
+	return found
+}
+
+// WbRestore (upon startup) counts the bucket's objects pending write-back
+func WbRestore(bck *meta.Bck) (n int64) {
+	avail := fs.GetAvail()
+	for _, mi := range avail {
+		dir := mi.MakePathCT(bck.Bucket(), fs.WritebackCT)
+		if cos.Stat(dir) != nil {
+			continue
+		}
+		err := fs.Walk(&fs.WalkOpts{Dir: dir, Callback: func(_ string, de fs.DirEntry) error {
+			if !de.IsDir() {
+				n++
+			}
+			return nil
+		}})
+		if err != nil {
+			nlog.Warningln("write-back:", bck.Cname(""), err)
+		}
+	}
+	if n > 0 {
+		T.StatsUpdater().Add(WritebackPendingCount, n)
+		WbSetDirty(bck.Bucket())
+	}
+	return n
+}
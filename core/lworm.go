@@ -0,0 +1,65 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Object lock (WORM): retention and legal hold are stored in custom metadata
+// (see cmn.ObjectLockConf and cmn.RetainUntilObjMD et al.)
+// - enforcement is the caller's responsibility; all methods below require loaded LOM
+
+func (lom *LOM) Retention() cmn.ObjRetention {
+	return cmn.ObjRetentionFromMD(lom.GetCustomMD())
+}
+
+// IsLockedWORM returns true if the object is currently retained or under legal hold.
+func (lom *LOM) IsLockedWORM() bool {
+	ret := lom.Retention()
+	return ret.Locked(time.Now())
+}
+
+// CheckWORM returns cmn.ErrObjLocked when the object cannot be overwritten or removed.
+func (lom *LOM) CheckWORM(bypassGovernance bool) error {
+	ret := lom.Retention()
+	return ret.Check(lom.Cname(), time.Now(), bypassGovernance)
+}
+
+// FindLockedWORM walks the bucket's objects stored on this target and returns
+// cmn.ErrObjLocked for the first one that is currently retained or under legal hold
+func FindLockedWORM(bck *meta.Bck) (errLocked error) {
+	avail := fs.GetAvail()
+	for _, mi := range avail {
+		dir := mi.MakePathCT(bck.Bucket(), fs.ObjCT)
+		if cos.Stat(dir) != nil {
+			continue
+		}
+		_ = fs.Walk(&fs.WalkOpts{Dir: dir, Callback: func(fqn string, de fs.DirEntry) error {
+			if de.IsDir() {
+				return nil
+			}
+			lom := AllocLOM("")
+			defer FreeLOM(lom)
+			if lom.InitFQN(fqn, bck.Bucket()) != nil || lom.Load(false /*cache it*/, false /*locked*/) != nil {
+				return nil
+			}
+			if err := lom.CheckWORM(false /*bypass governance*/); err != nil {
+				errLocked = err
+				return fs.ErrWalkStopped
+			}
+			return nil
+		}})
+		if errLocked != nil {
+			break
+		}
+	}
+	return errLocked
+}
@@ -0,0 +1,85 @@
+// Package core_test provides tests for cluster package
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package core_test
+
+import (
+	"os"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Object lock (WORM)", func() {
+	const (
+		tmpDir  = "/tmp/lworm_test"
+		mpath   = tmpDir + "/mp"
+		bckName = "LWORM_TEST"
+		size    = cos.KiB
+	)
+
+	var (
+		bck  = cmn.Bck{Name: bckName, Provider: apc.AIS, Ns: cmn.NsGlobal}
+		mbck = meta.NewBck(bckName, apc.AIS, cmn.NsGlobal, &cmn.Bprops{
+			Cksum:      cmn.CksumConf{Type: cos.ChecksumNone},
+			ObjectLock: cmn.ObjectLockConf{Enabled: true},
+			BID:        402,
+		})
+		fqns []string // (other specs may leave their mountpaths behind)
+	)
+
+	BeforeEach(func() {
+		_ = cos.CreateDir(mpath)
+		_, _ = fs.AddTestMpath(mpath, "daeID")
+		_ = mock.NewTarget(mock.NewBaseBownerMock(mbck))
+	})
+
+	AfterEach(func() {
+		for _, fqn := range fqns {
+			_ = os.Remove(fqn)
+		}
+		fqns = fqns[:0]
+		_, _ = fs.Remove(mpath)
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	put := func(objName string, md cos.StrKVs) {
+		lom := &core.LOM{ObjName: objName}
+		Expect(lom.InitCmnBck(&bck)).NotTo(HaveOccurred())
+		fqns = append(fqns, lom.FQN)
+		lom = filePut(lom.FQN, size)
+		for k, v := range md {
+			lom.SetCustomKey(k, v)
+		}
+		Expect(persist(lom)).NotTo(HaveOccurred())
+		lom.UncacheUnless()
+	}
+
+	It("should find retained and legally held objects", func() {
+		past := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+		future := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+
+		put("a/expired", cos.StrKVs{cmn.RetainUntilObjMD: past, cmn.RetainModeObjMD: cmn.ObjLockCompliance})
+		put("b/plain", nil)
+		Expect(core.FindLockedWORM(mbck)).NotTo(HaveOccurred())
+
+		put("c/held", cos.StrKVs{cmn.LegalHoldObjMD: cmn.LegalHoldOn})
+		Expect(cmn.IsErrObjLocked(core.FindLockedWORM(mbck))).To(BeTrue())
+
+		put("c/held", nil)
+		Expect(core.FindLockedWORM(mbck)).NotTo(HaveOccurred())
+
+		put("d/retained", cos.StrKVs{cmn.RetainUntilObjMD: future, cmn.RetainModeObjMD: cmn.ObjLockGovernance})
+		Expect(cmn.IsErrObjLocked(core.FindLockedWORM(mbck))).To(BeTrue())
+	})
+})
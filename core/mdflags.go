@@ -70,6 +70,12 @@ func (lid lomBID) clrlmfl(fl lomFlags) lomBID {
 const (
 	lmflHRW      = uint64(1) << 63 // high bit: object is at HRW location (runtime-only, never persisted)
 	lmflShardIdx = uint64(1) << 0  // persisted: object has an associated shard index in ais://.sys-shardidx
+	lmflZstd     = uint64(1) << 1  // persisted: object is stored zstd-compressed (see lcompress.go)
+	lmflLZ4      = uint64(1) << 2  // persisted: ditto, lz4
+	lmflEncrypt  = uint64(1) << 3  // persisted: object is stored encrypted with the bucket's data key (see lencrypt.go)
+
+	lmflCodecMask = lmflZstd | lmflLZ4
+	lmflStoreMask = lmflCodecMask | lmflEncrypt // on-disk content differs from the object's
 )
 
 // runtime-only bits may need a (future) mask, e.g.:
@@ -30,10 +30,12 @@ type (
 	// - BMD is immutable and versioned
 	// - BMD versioning is monotonic and incremental
 	BMD struct {
-		Ext       any       `json:"ext,omitempty"`  // within meta-version extensions
-		Providers Providers `json:"providers"`      // (provider, namespace, bucket) hierarchy
-		UUID      string    `json:"uuid"`           // unique & immutable
-		Version   int64     `json:"version,string"` // gets incremented on every update
+		Ext       any                         `json:"ext,omitempty"`       // within meta-version extensions
+		Providers Providers                   `json:"providers"`           // (provider, namespace, bucket) hierarchy
+		NsQuotas  map[string]*cmn.QuotaConf   `json:"ns_quotas,omitempty"` // namespace uname => quota (see cmn/quota)
+		Schedules map[string]*cmn.JobSchedule `json:"schedules,omitempty"` // name => recurring job (see cmn/schedule)
+		UUID      string                      `json:"uuid"`                // unique & immutable
+		Version   int64                       `json:"version,string"`      // gets incremented on every update
 	}
 )
 
@@ -67,6 +69,14 @@ func (m *BMD) Get(bck *Bck) (p *cmn.Bprops, present bool) {
 	return
 }
 
+// NsQuota returns namespace quota, if any
+func (m *BMD) NsQuota(ns *cmn.Ns) *cmn.QuotaConf {
+	if len(m.NsQuotas) == 0 {
+		return nil
+	}
+	return m.NsQuotas[ns.Uname()]
+}
+
 func (m *BMD) Del(bck *Bck) (deleted bool) {
 	buckets := m.getBuckets(bck)
 	if buckets == nil {
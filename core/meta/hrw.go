@@ -18,6 +18,8 @@ import (
 
 // A variant of consistent hash based on rendezvous algorithm by Thaler and Ravishankar,
 // aka highest random weight (HRW)
+// When targets are weighted (Snode.HrwWeight), object placement is weighted as well -
+// each target gets its proportional share (see cos.HrwWeighted).
 // See also: fs/hrw.go
 
 func (smap *Smap) HrwName2T(uname []byte) (*Snode, error) {
@@ -39,12 +41,15 @@ func (smap *Smap) HrwMultiHome(uname []byte) (si *Snode, netName string, err err
 }
 
 func (smap *Smap) HrwHash2T(digest uint64) (si *Snode, err error) {
-	var maxH uint64
+	var (
+		maxH  uint64
+		dfltW = smap.dfltWeight()
+	)
 	for _, tsi := range smap.Tmap {
 		if tsi.InMaintOrDecomm() { // always skipping targets 'in maintenance mode'
 			continue
 		}
-		cs := xoshiro256.Hash(tsi.digest() ^ digest)
+		cs := tsi.hrw(digest, dfltW)
 		if cs >= maxH {
 			maxH = cs
 			si = tsi
@@ -58,9 +63,12 @@ func (smap *Smap) HrwHash2T(digest uint64) (si *Snode, err error) {
 
 // NOTE: including targets 'in maintenance mode', if any
 func (smap *Smap) HrwHash2Tall(digest uint64) (si *Snode, err error) {
-	var maxH uint64
+	var (
+		maxH  uint64
+		dfltW = smap.dfltWeight()
+	)
 	for _, tsi := range smap.Tmap {
-		cs := xoshiro256.Hash(tsi.digest() ^ digest)
+		cs := tsi.hrw(digest, dfltW)
 		if cs >= maxH {
 			maxH = cs
 			si = tsi
@@ -139,6 +147,76 @@ func (smap *Smap) HrwTargetTask(uuid string) (si *Snode, err error) {
 	return si, err
 }
 
+// target's (weighted) HRW score; dfltW == 0 (no weighted targets): plain HRW
+func (d *Snode) hrw(digest, dfltW uint64) uint64 {
+	cs := xoshiro256.Hash(d.digest() ^ digest)
+	if dfltW == 0 {
+		return cs
+	}
+	w := d.HrwWeight
+	if w == 0 {
+		w = dfltW
+	}
+	return cos.HrwWeighted(cs, w)
+}
+
+// Estimates (by sampling) object placement under the current and the proposed
+// target weights (target ID => weight; zero to remove the weight), and the resulting
+// data movement - the fraction of objects that'd be migrated by global rebalance.
+// See also: Snode.HrwWeight
+type HrwEstimate struct {
+	Before map[string]float64 // target ID => fraction of all objects
+	After  map[string]float64 // ditto, with proposed weights
+	Moved  float64            // fraction of all objects that'd change location
+}
+
+func (smap *Smap) EstimateHrw(weights map[string]uint64, samples int) (*HrwEstimate, error) {
+	debug.Assert(samples > 0)
+	next := &Smap{Tmap: make(NodeMap, len(smap.Tmap)), Version: smap.Version}
+	for tid, tsi := range smap.Tmap {
+		nsi := tsi.Clone()
+		nsi.setDigest()
+		if w, ok := weights[tid]; ok {
+			nsi.HrwWeight = w
+		}
+		next.Tmap[tid] = nsi
+	}
+	for tid := range weights {
+		if smap.GetTarget(tid) == nil {
+			return nil, cos.NewErrNotFound(smap, "target "+tid)
+		}
+	}
+	est := &HrwEstimate{
+		Before: make(map[string]float64, len(smap.Tmap)),
+		After:  make(map[string]float64, len(smap.Tmap)),
+	}
+	var moved int
+	for i := range samples {
+		digest := xoshiro256.Hash(uint64(i))
+		from, err := smap.HrwHash2T(digest)
+		if err != nil {
+			return nil, err
+		}
+		to, err := next.HrwHash2T(digest)
+		if err != nil {
+			return nil, err
+		}
+		est.Before[from.ID()]++
+		est.After[to.ID()]++
+		if from.ID() != to.ID() {
+			moved++
+		}
+	}
+	for tid := range est.Before {
+		est.Before[tid] /= float64(samples)
+	}
+	for tid := range est.After {
+		est.After[tid] /= float64(samples)
+	}
+	est.Moved = float64(moved) / float64(samples)
+	return est, nil
+}
+
 /////////////
 // hrwList //
 /////////////
@@ -153,6 +231,10 @@ type hrwList struct {
 // returns resulting subset (aka slice) that has the requested length = count.
 // Returns error if the cluster does not have enough targets.
 // If count == length of Smap.Tmap, the function returns as many targets as possible.
+//
+// When targets are labeled with failure domains (Snode.FailureDomain), the selection
+// spreads across domains - see spreadDomains. Either way, the first target in the
+// resulting list is the one HrwName2T returns.
 
 func (smap *Smap) HrwTargetList(uname *string, count int) (sis Nodes, err error) {
 	const fmterr = "required %d, available %d, %s"
@@ -163,13 +245,19 @@ func (smap *Smap) HrwTargetList(uname *string, count int) (sis Nodes, err error)
 	}
 	b := cos.UnsafeBptr(uname)
 	digest := onexxh.Checksum64S(*b, cos.MLCG32)
-	hlist := newHrwList(count)
+	domains := smap.HasDomains()
+	n := count
+	if domains {
+		n = cnt // all (active) targets, to select from
+	}
+	hlist := newHrwList(n)
+	dfltW := smap.dfltWeight()
 
 	for _, tsi := range smap.Tmap {
-		cs := xoshiro256.Hash(tsi.digest() ^ digest)
 		if tsi.InMaintOrDecomm() {
 			continue
 		}
+		cs := tsi.hrw(digest, dfltW)
 		hlist.add(cs, tsi)
 	}
 	sis = hlist.get()
@@ -177,9 +265,42 @@ func (smap *Smap) HrwTargetList(uname *string, count int) (sis Nodes, err error)
 		s := fmt.Sprintf(fmterr, count, len(sis), smap)
 		return nil, cmn.NewErrNotEnoughTargets(s)
 	}
+	if domains {
+		sis = spreadDomains(sis, min(count, len(sis)))
+	}
 	return sis, nil
 }
 
+// Given all targets in HRW order, select `count` of them in rounds: each round
+// takes (at most) one target from each failure domain - the one with the highest
+// weight that's not selected yet. The result: targets spread across domains as
+// evenly as possible while otherwise preserving HRW order.
+func spreadDomains(all Nodes, count int) Nodes {
+	var (
+		sis   = make(Nodes, 0, count)
+		used  = make(map[string]int, count) // domain => num selected
+		taken = make([]bool, len(all))
+	)
+	for round := 0; len(sis) < count; round++ {
+		for i, tsi := range all {
+			if taken[i] {
+				continue
+			}
+			domain := tsi.Domain()
+			if used[domain] != round {
+				continue
+			}
+			used[domain]++
+			taken[i] = true
+			sis = append(sis, tsi)
+			if len(sis) == count {
+				break
+			}
+		}
+	}
+	return sis
+}
+
 func newHrwList(count int) *hrwList {
 	return &hrwList{hs: make([]uint64, 0, count), sis: make(Nodes, 0, count), n: count}
 }
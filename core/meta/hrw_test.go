@@ -0,0 +1,162 @@
+// Package meta_test: unit tests for the package
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package meta_test
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HRW", func() {
+	// numRacks x perRack targets; no labels when numRacks == 0
+	newSmap := func(numRacks, perRack int) *meta.Smap {
+		smap := &meta.Smap{Tmap: make(meta.NodeMap), Pmap: make(meta.NodeMap), Version: 1}
+		for r := range max(numRacks, 1) {
+			for i := range perRack {
+				si := &meta.Snode{}
+				si.Init(fmt.Sprintf("t%d-%d", r, i), apc.Target, nil)
+				if numRacks > 0 {
+					si.FailureDomain = fmt.Sprintf("rack%d", r)
+				}
+				smap.Tmap.Add(si)
+			}
+		}
+		return smap
+	}
+	perDomain := func(sis meta.Nodes) map[string]int {
+		m := make(map[string]int, len(sis))
+		for _, si := range sis {
+			m[si.Domain()]++
+		}
+		return m
+	}
+
+	It("should select targets in HRW order when not labeled", func() {
+		smap := newSmap(0, 12)
+		smap.InitDigests()
+		Expect(smap.HasDomains()).To(BeFalse())
+		Expect(smap.CountDomains()).To(Equal(12))
+		for i := range 100 {
+			uname := fmt.Sprintf("ais/@#/bck/obj-%d", i)
+			all, err := smap.HrwTargetList(&uname, 12)
+			Expect(err).NotTo(HaveOccurred())
+			sis, err := smap.HrwTargetList(&uname, 5)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sis).To(Equal(all[:5]))
+			tsi, err := smap.HrwName2T(cos.UnsafeB(uname))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sis[0]).To(Equal(tsi))
+		}
+	})
+
+	It("should spread targets across failure domains", func() {
+		smap := newSmap(4, 3)
+		Expect(smap.HasDomains()).To(BeTrue())
+		smap.InitDigests() // (computed once per Smap version)
+		Expect(smap.HasDomains()).To(BeTrue())
+		Expect(smap.CountDomains()).To(Equal(4))
+		for i := range 100 {
+			uname := fmt.Sprintf("ais/@#/bck/obj-%d", i)
+			tsi, err := smap.HrwName2T(cos.UnsafeB(uname))
+			Expect(err).NotTo(HaveOccurred())
+
+			sis, err := smap.HrwTargetList(&uname, 4)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sis[0]).To(Equal(tsi))
+			Expect(perDomain(sis)).To(HaveLen(4))
+
+			// e.g., EC (D=8, P=2) plus the main target: at most 3 per rack
+			sis, err = smap.HrwTargetList(&uname, 11)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sis).To(HaveLen(11))
+			Expect(sis[0]).To(Equal(tsi))
+			for _, n := range perDomain(sis) {
+				Expect(n).To(BeNumerically(">=", 2))
+				Expect(n).To(BeNumerically("<=", 3))
+			}
+
+			// deterministic
+			again, err := smap.HrwTargetList(&uname, 11)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(again).To(Equal(sis))
+		}
+	})
+
+	It("should treat unlabeled targets as separate domains", func() {
+		smap := newSmap(2, 3)
+		for i := range 2 {
+			si := &meta.Snode{}
+			si.Init(fmt.Sprintf("u%d", i), apc.Target, nil)
+			smap.Tmap.Add(si)
+		}
+		Expect(smap.CountDomains()).To(Equal(4))
+		for i := range 100 {
+			uname := fmt.Sprintf("ais/@#/bck/obj-%d", i)
+			sis, err := smap.HrwTargetList(&uname, 4)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(perDomain(sis)).To(HaveLen(4))
+		}
+	})
+
+	Describe("weighted", func() {
+		const samples = 100_000
+		newWeighted := func(weights ...uint64) *meta.Smap {
+			smap := newSmap(0, len(weights))
+			for i, w := range weights {
+				smap.GetTarget(fmt.Sprintf("t0-%d", i)).HrwWeight = w
+			}
+			smap.InitDigests()
+			return smap
+		}
+
+		It("should place objects in proportion to target weights", func() {
+			smap := newWeighted(15, 15, 60, 60)
+			Expect(smap.IsWeighted()).To(BeTrue())
+			est, err := smap.EstimateHrw(nil, samples)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(est.Moved).To(BeZero())
+			for i, share := range []float64{0.1, 0.1, 0.4, 0.4} {
+				Expect(est.Before[fmt.Sprintf("t0-%d", i)]).To(BeNumerically("~", share, 0.01))
+			}
+		})
+
+		It("should not change placement when all weights are equal", func() {
+			smap := newSmap(0, 8)
+			Expect(smap.IsWeighted()).To(BeFalse())
+			weights := make(map[string]uint64, 8)
+			for tid := range smap.Tmap {
+				weights[tid] = 15
+			}
+			est, err := smap.EstimateHrw(weights, samples)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(est.Moved).To(BeZero())
+		})
+
+		It("should move data only to the target that got heavier", func() {
+			smap := newWeighted(15, 15, 15, 15)
+			est, err := smap.EstimateHrw(map[string]uint64{"t0-0": 60}, samples)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(est.After["t0-0"]).To(BeNumerically("~", 60.0/105, 0.01))
+			Expect(est.Moved).To(BeNumerically("~", est.After["t0-0"]-est.Before["t0-0"], 0.001))
+		})
+
+		It("should assign the average weight to unweighted targets", func() {
+			smap := newWeighted(10, 30, 0)
+			Expect(smap.Weight(smap.GetTarget("t0-2"))).To(Equal(uint64(20)))
+			est, err := smap.EstimateHrw(nil, samples)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(est.Before["t0-2"]).To(BeNumerically("~", 20.0/60, 0.01))
+
+			_, err = smap.EstimateHrw(map[string]uint64{"t-nonexistent": 1}, samples)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -80,6 +80,10 @@ type (
 		UUID         string  `json:"uuid"`          // is assigned once at creation time, never changes
 		CreationTime string  `json:"creation_time"` // UTC creation timestamp, cos.DateTimeSec
 		Version      int64   `json:"version,string"`
+		// residual (in-memory) state computed once per Smap version - see InitDigests
+		hrwDflt  uint64 // weight of unweighted targets, zero when none is weighted
+		domains  bool   // any target labeled with failure domain (see HasDomains)
+		digested bool
 	}
 )
 
@@ -344,6 +348,14 @@ func (d *Snode) InMaintPostReb() bool {
 func (d *Snode) nonElectable() bool { return d.Flags.IsSet(SnodeNonElectable) }
 func (d *Snode) IsIC() bool         { return d.Flags.IsSet(SnodeIC) }
 
+// failure domain: the (optional) label or, when not labeled, the node itself
+func (d *Snode) Domain() string {
+	if d.FailureDomain != "" {
+		return d.FailureDomain
+	}
+	return d.DaeID
+}
+
 func (d *Snode) Fl2S() string {
 	if d.Flags == 0 {
 		return "none"
@@ -422,6 +434,45 @@ func (m *Smap) InitDigests() {
 	for _, node := range m.Pmap {
 		node.setDigest()
 	}
+	m.ResetDigests() // (cloned from the previous version)
+	m.hrwDflt = m.dfltWeight()
+	m.domains = m.HasDomains()
+	m.digested = true
+}
+
+// must be called upon cloning (prior to modifying) - see InitDigests
+func (m *Smap) ResetDigests() {
+	m.hrwDflt, m.domains, m.digested = 0, false, false
+}
+
+// Weighted HRW: targets that are not weighted (Snode.HrwWeight == 0) get the average
+// weight of those that are; zero when none is (in which case HRW is not weighted).
+func (m *Smap) dfltWeight() uint64 {
+	if m.digested {
+		return m.hrwDflt
+	}
+	var total, n uint64
+	for _, t := range m.Tmap {
+		if t.HrwWeight != 0 {
+			total += t.HrwWeight
+			n++
+		}
+	}
+	if n == 0 {
+		return 0
+	}
+	return max(total/n, 1)
+}
+
+// whether any of the targets is weighted
+func (m *Smap) IsWeighted() bool { return m.dfltWeight() != 0 }
+
+// effective weight: the configured one, the default (see dfltWeight), or zero when not weighted
+func (m *Smap) Weight(tsi *Snode) uint64 {
+	if tsi.HrwWeight != 0 {
+		return tsi.HrwWeight
+	}
+	return m.dfltWeight()
 }
 
 func (m *Smap) String() string {
@@ -485,6 +536,30 @@ func (m *Smap) CountActiveTs() (count int) {
 	return
 }
 
+// whether any of the targets is labeled with a failure domain
+func (m *Smap) HasDomains() bool {
+	if m.digested {
+		return m.domains
+	}
+	for _, t := range m.Tmap {
+		if t.FailureDomain != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// number of distinct failure domains (see Snode.Domain) of the active targets
+func (m *Smap) CountDomains() int {
+	domains := make(cos.StrSet, len(m.Tmap))
+	for _, t := range m.Tmap {
+		if !t.InMaintOrDecomm() {
+			domains.Add(t.Domain())
+		}
+	}
+	return len(domains)
+}
+
 // whether this target has active peers
 func (m *Smap) HasActiveTs(except string) bool {
 	for tid, t := range m.Tmap {
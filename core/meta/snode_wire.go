@@ -37,5 +37,11 @@ type (
 
 		// added in v5.0
 		VerifyingKey []byte `json:"verifying_key,omitempty" msg:"v,omitempty"`
+
+		// optional failure domain (rack, zone) label - see HrwTargetList
+		FailureDomain string `json:"failure_domain,omitempty" msg:"z,omitempty"`
+
+		// optional capacity weight (targets only) - see Smap.HrwHash2T and cmn.LocalConfig.HrwWeight
+		HrwWeight uint64 `json:"hrw_weight,omitempty" msg:"w,omitempty"`
 	}
 )
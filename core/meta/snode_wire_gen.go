@@ -207,6 +207,18 @@ func (z *Snode) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "VerifyingKey")
 				return
 			}
+		case "z":
+			z.FailureDomain, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "FailureDomain")
+				return
+			}
+		case "w":
+			z.HrwWeight, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "HrwWeight")
+				return
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -221,8 +233,8 @@ func (z *Snode) DecodeMsg(dc *msgp.Reader) (err error) {
 // EncodeMsg implements msgp.Encodable
 func (z *Snode) EncodeMsg(en *msgp.Writer) (err error) {
 	// omitempty: check for empty values
-	zb0001Len := uint32(10)
-	var zb0001Mask uint16 /* 10 bits */
+	zb0001Len := uint32(12)
+	var zb0001Mask uint16 /* 12 bits */
 	if z.PubExtra == nil {
 		zb0001Len--
 		zb0001Mask |= 0x40
@@ -231,6 +243,14 @@ func (z *Snode) EncodeMsg(en *msgp.Writer) (err error) {
 		zb0001Len--
 		zb0001Mask |= 0x200
 	}
+	if z.FailureDomain == "" {
+		zb0001Len--
+		zb0001Mask |= 0x400
+	}
+	if z.HrwWeight == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x800
+	}
 	// variable map header, size zb0001Len
 	err = en.Append(0x80 | uint8(zb0001Len))
 	if err != nil {
@@ -350,6 +370,30 @@ func (z *Snode) EncodeMsg(en *msgp.Writer) (err error) {
 			return
 		}
 	}
+	if (zb0001Mask & 0x400) == 0 { // if not empty
+		// write "z"
+		err = en.Append(0xa1, 0x7a)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.FailureDomain)
+		if err != nil {
+			err = msgp.WrapError(err, "FailureDomain")
+			return
+		}
+	}
+	if (zb0001Mask & 0x800) == 0 { // if not empty
+		// write "w"
+		err = en.Append(0xa1, 0x77)
+		if err != nil {
+			return
+		}
+		err = en.WriteUint64(z.HrwWeight)
+		if err != nil {
+			err = msgp.WrapError(err, "HrwWeight")
+			return
+		}
+	}
 	return
 }
 
@@ -359,6 +403,6 @@ func (z *Snode) Msgsize() (s int) {
 	for za0001 := range z.PubExtra {
 		s += z.PubExtra[za0001].Msgsize()
 	}
-	s += 2 + msgp.Uint64Size + 2 + msgp.Uint64Size + 2 + msgp.BytesPrefixSize + len(z.VerifyingKey)
+	s += 2 + msgp.Uint64Size + 2 + msgp.Uint64Size + 2 + msgp.BytesPrefixSize + len(z.VerifyingKey) + 2 + msgp.StringPrefixSize + len(z.FailureDomain) + 2 + msgp.Uint64Size
 	return
 }
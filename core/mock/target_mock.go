@@ -68,6 +68,10 @@ func (*TargetMock) HeadObjT2T(*core.LOM, *meta.Snode, ...string) (*cmn.ObjectPro
 	return nil, nil
 }
 
+func (*TargetMock) DeleteObjectIf(*core.LOM, bool, func(*core.LOM) bool) (int, error) {
+	return 0, nil
+}
+
 func (*TargetMock) SoftFSHC()                         {}
 func (*TargetMock) FSHC(error, *fs.Mountpath, string) {}
 
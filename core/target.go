@@ -123,6 +123,9 @@ type (
 		FinalizeObj(lom *LOM, workFQN string, xctn Xact, owt cmn.OWT) (ecode int, err error)
 		EvictObject(lom *LOM) (ecode int, err error)
 		DeleteObject(lom *LOM, evict bool) (ecode int, err error)
+		// same as above but only if `cond` - evaluated under write lock - holds;
+		// returns cmn.ErrSkip otherwise
+		DeleteObjectIf(lom *LOM, evict bool, cond func(*LOM) bool) (ecode int, err error)
 
 		GetCold(ctx context.Context, lom *LOM, xkind string, owt cmn.OWT) (ecode int, err error)
 
@@ -122,7 +122,7 @@ type (
 		MD5   []byte     // ditto
 		size  int64      // this chunk size
 		num   uint16     // chunk/part number
-		flags uint16     // bit flags (compression; future use)
+		flags uint16     // bit flags (compression codec - see lcompress.go)
 	}
 	Ufest struct {
 		created         time.Time      // creation time
@@ -1041,7 +1041,7 @@ func (u *Ufest) ComputeWholeChecksum(cksumH *cos.CksumHash) error {
 	for i := range u.count {
 		c := &u.chunks[i]
 
-		fh, err := os.Open(c.path)
+		fh, err := u.openChunk(c)
 		if err != nil {
 			fs.CleanPathErr(err)
 			return fmt.Errorf("%s %s chunk %d: open: %w", tag, u._rtag(), c.num, err)
@@ -1338,6 +1338,8 @@ func (lom *LOM) CompleteUfest(u *Ufest, locked bool) (err error) {
 	}
 
 	lom.SetSize(u.size)
+	lom.SetStored(nil) // (chunks are compressed and/or encrypted individually - see Uchunk flags)
+	lom.md.psize = u.psize()
 	if err := u.storeCompleted(lom, false /*override*/); err != nil {
 		u.Abort(lom)
 		return err
@@ -1392,7 +1394,7 @@ type (
 		// parent
 		u *Ufest
 		// chunk
-		cfh  *os.File
+		cfh  cfile // *os.File or (compressed chunk) *Creader
 		coff int64
 		cidx int
 		// global
@@ -1435,7 +1437,7 @@ func (r *UfestReader) Read(p []byte) (n int, err error) {
 		// open on demand
 		if r.cfh == nil {
 			debug.Assert(r.coff == 0)
-			r.cfh, err = os.Open(c.path)
+			r.cfh, err = u.openChunk(c)
 			if err != nil {
 				return n, fmt.Errorf("%s: failed to open chunk (%d/%d)", r.u._rtag(), r.cidx+1, u.count)
 			}
@@ -1527,7 +1529,7 @@ func (r *UfestReader) seekTo(off int64) error {
 		return nil
 	}
 
-	fh, errN := os.Open(r.u.chunks[r.cidx].path)
+	fh, errN := r.u.openChunk(&r.u.chunks[r.cidx])
 	if errN != nil {
 		return errN
 	}
@@ -1565,7 +1567,7 @@ func (r *UfestReader) ReadAt(p []byte, off int64) (n int, err error) {
 		c := &u.chunks[idx]
 		debug.Assert(c.size-coff > 0, c.size, " vs ", coff)
 		toRead := min(int64(total-n), c.size-coff)
-		fh, err := os.Open(c.path)
+		fh, err := u.openChunk(c)
 		if err != nil {
 			return n, fmt.Errorf("%s: failed to open chunk (%d/%d)", r.u._rtag(), idx+1, u.count)
 		}
@@ -53,6 +53,9 @@ import (
 // list is calculated by HrwTargetList. The first target in the list is the
 // "main" target that keeps the full object, the others keep only slices/replicas
 //
+// NOTE: When targets are labeled with failure domains (racks, zones), HrwTargetList
+// spreads slices/replicas across the domains (see also Metadata.DomainViolation)
+//
 // NOTE: All slices must be of the same size. So, the last slice can be padded
 // with zeros. In most cases, padding results in the total size of data
 // replicas being a bit bigger than the size of the original object.
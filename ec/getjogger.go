@@ -288,12 +288,30 @@ loop: //nolint:gocritic // keeping label for readability
 			nlog.Errorf("failed to create file: %v", err)
 			break loop
 		}
+		// compress and/or encrypt as per bucket configuration
+		var w io.Writer = wfh
+		cw, err := ctx.lom.NewCwriter(wfh)
+		if err != nil {
+			nlog.Errorln(err)
+			cos.Close(wfh)
+			cos.RemoveFile(tmpFQN)
+			break loop
+		}
+		if cw != nil {
+			w = cw
+		}
 		iReqBuf := newIntraReq(reqGet, ctx.meta, ctx.lom.Bck()).NewPack(g.smm)
-		size, err = c.parent.readRemote(ctx.lom, node, uname, iReqBuf, wfh)
+		size, err = c.parent.readRemote(ctx.lom, node, uname, iReqBuf, w)
 		g.smm.Free(iReqBuf)
+		if cw != nil {
+			if errC := cw.Close(); err == nil {
+				err = errC
+			}
+		}
 
 		if err == nil && size > 0 {
 			// found valid replica
+			ctx.lom.SetStored(cw)
 			if ctx.lom.IsFeatureSet(feat.FsyncPUT) {
 				err = wfh.Sync()
 			}
@@ -94,6 +94,33 @@ func (md *Metadata) RemoteTargets() []*meta.Snode {
 	return nodes
 }
 
+// Failure-domain policy: no single domain (see meta.Snode.Domain) may hold more
+// of the object's slices and replicas (including the main one) than the object can lose -
+// or, when there are not enough domains, more than an even spread would put there.
+// Returns the first domain that violates the policy, if any.
+func (md *Metadata) DomainViolation(smap *meta.Smap) (domain string, cnt int) {
+	ndomains := smap.CountDomains()
+	if ndomains < 2 || len(md.Daemons) < 2 {
+		return "", 0
+	}
+	var (
+		total = len(md.Daemons)
+		limit = max(md.Parity, (total+ndomains-1)/ndomains)
+		m     = make(map[string]int, ndomains)
+	)
+	for tid := range md.Daemons {
+		if tsi := smap.GetTarget(tid); tsi != nil {
+			m[tsi.Domain()]++
+		}
+	}
+	for d, n := range m {
+		if n > limit {
+			return d, n
+		}
+	}
+	return "", 0
+}
+
 // TODO: use 'buf, slab = smm.Alloc()'
 func (md *Metadata) NewPack() []byte {
 	var (
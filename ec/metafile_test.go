@@ -5,10 +5,13 @@
 package ec_test
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/ec"
 )
 
@@ -62,3 +65,37 @@ func TestMetadataUnpackValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestMetadataDomainViolation(t *testing.T) {
+	// 3 racks x 4 targets
+	smap := &meta.Smap{Tmap: make(meta.NodeMap)}
+	for r := range 3 {
+		for i := range 4 {
+			si := &meta.Snode{FailureDomain: fmt.Sprintf("rack%d", r)}
+			si.Init(fmt.Sprintf("t%d-%d", r, i), apc.Target, nil)
+			smap.Tmap.Add(si)
+		}
+	}
+	place := func(parity int, tids ...string) *ec.Metadata {
+		md := &ec.Metadata{Data: len(tids) - parity - 1, Parity: parity, Daemons: make(cos.MapStrUint16, len(tids))}
+		for i, tid := range tids {
+			md.Daemons[tid] = uint16(i)
+		}
+		return md
+	}
+
+	// (D=2, P=1) over 3 racks
+	if d, n := place(1, "t0-0", "t1-0", "t2-0").DomainViolation(smap); n != 0 {
+		t.Fatalf("unexpected violation: %s(%d)", d, n)
+	}
+	if d, n := place(1, "t0-0", "t0-1", "t2-0").DomainViolation(smap); d != "rack0" || n != 2 {
+		t.Fatalf("expected rack0(2), got %s(%d)", d, n)
+	}
+	// (D=4, P=2): 7 over 3 racks - up to 3 per rack
+	if d, n := place(2, "t0-0", "t0-1", "t0-2", "t1-0", "t1-1", "t2-0", "t2-1").DomainViolation(smap); n != 0 {
+		t.Fatalf("unexpected violation: %s(%d)", d, n)
+	}
+	if d, n := place(2, "t0-0", "t0-1", "t0-2", "t0-3", "t1-1", "t2-0", "t2-1").DomainViolation(smap); d != "rack0" || n != 4 {
+		t.Fatalf("expected rack0(4), got %s(%d)", d, n)
+	}
+}
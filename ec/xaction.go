@@ -7,7 +7,6 @@ package ec
 import (
 	"fmt"
 	"io"
-	"os"
 	"sync"
 
 	"github.com/NVIDIA/aistore/cmn"
@@ -103,18 +102,13 @@ func (r *xactECBase) init(config *cmn.Config, bck *cmn.Bck, mgr *Manager) {
 	r.mgr = mgr
 }
 
-func newSliceResponse(md *Metadata, attrs *cmn.ObjAttrs, fqn string) (reader cos.ReadOpenCloser, err error) {
+func newSliceResponse(md *Metadata, attrs *cmn.ObjAttrs, fqn string, bck *meta.Bck) (reader cos.ReadOpenCloser, err error) {
 	attrs.SetVersion(md.ObjVersion)
 	attrs.Cksum = cos.NewCksum(md.CksumType, md.CksumValue)
 
-	stat, err := os.Stat(fqn)
+	reader, attrs.Size, err = core.OpenCT(fqn, bck) // (decrypts if need be)
 	if err != nil {
-		return nil, err
-	}
-	attrs.Size = stat.Size()
-	reader, err = cos.NewFileHandle(fqn)
-	if err != nil {
-		nlog.Warningln("failed to read file stats:", err)
+		nlog.Warningln("failed to open slice:", err)
 		return nil, err
 	}
 	return reader, nil
@@ -155,7 +149,7 @@ func (r *xactECBase) dataResponse(act intraReqType, hdr *transport.ObjHdr, fqn s
 	ireq := newIntraReq(act, nil, bck)
 	if md != nil && md.SliceID != 0 {
 		// slice request
-		reader, err = newSliceResponse(md, &objAttrs, fqn)
+		reader, err = newSliceResponse(md, &objAttrs, fqn, bck)
 		ireq.exists = err == nil
 	} else {
 		// replica/full object request
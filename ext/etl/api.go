@@ -17,6 +17,7 @@ import (
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/feat"
 	"github.com/NVIDIA/aistore/cmn/k8s"
 
 	jsoniter "github.com/json-iterator/go"
@@ -66,6 +67,24 @@ const (
 	DefaultContainerPort = 8000
 )
 
+// Outside Kubernetes, ETL servers run as local processes (one per target) that must
+// listen on the loopback port provided via this environment variable.
+// The variable can also be referenced in `runtime.command` as $(AIS_ETL_PORT).
+const LocalPortEnv = "AIS_ETL_PORT"
+
+// Local ETL processes execute user-provided commands on target hosts with the
+// target's privileges; the runtime is disabled unless explicitly enabled by the
+// administrator (see feat.EnableLocalETL).
+var ErrLocalDisabled = errors.New("ETL requires Kubernetes; to run ETL servers as local processes on target hosts, " +
+	"enable feature flag \"Enable-Local-ETL\" (see docs/etl.md)")
+
+func CheckLocal() error {
+	if k8s.IsK8s() || cmn.Rom.Features().IsSet(feat.EnableLocalETL) {
+		return nil
+	}
+	return ErrLocalDisabled
+}
+
 // enum ETL lifecycle status (see docs/etl.md#etl-pod-lifecycle for details)
 type Stage int
 
@@ -347,7 +366,13 @@ func (m *InitSpecMsg) Validate() error {
 func (e *ETLSpecMsg) Validate() error {
 	errCtx := &cmn.ETLErrCtx{ETLName: e.Name()}
 	if e.Runtime.Image == "" {
-		return cmn.NewErrETLf(errCtx, "runtime.image must be specified")
+		if k8s.IsK8s() {
+			return cmn.NewErrETLf(errCtx, "runtime.image must be specified")
+		}
+		// local (non-K8s) deployments execute runtime.command directly, image is optional
+		if len(e.Runtime.Command) == 0 {
+			return cmn.NewErrETLf(errCtx, "runtime.image or runtime.command must be specified")
+		}
 	}
 	return e.InitMsgBase.Validate(e.String())
 }
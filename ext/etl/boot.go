@@ -217,15 +217,17 @@ func (b *etlBootstrapper) waitPodReady(podCtx context.Context) error {
 	)
 }
 
-func initComm(msg InitMsg, xid, secret string, boot *etlBootstrapper) (comm Communicator, err error) {
-	if comm, _ = mgr.getByName(msg.Name()); comm != nil {
+// ei: runtime-specific part of the ETL instance (K8s bootstrapper or local process)
+func initComm(msg InitMsg, xid, secret string, ei etlInstance) (comm Communicator, err error) {
+	if _, exists := mgr.getByName(msg.Name()); exists {
 		return nil, cos.NewErrAlreadyExists(core.T, msg.Name())
 	}
 	if comm, err = newCommunicator(msg, secret, cmn.GCO.Get()); err != nil {
 		return nil, err
 	}
 
-	if err = mgr.add(msg.Name(), comm, boot); err != nil {
+	ei.comm = comm
+	if err = mgr.add(msg.Name(), ei); err != nil {
 		return nil, err
 	}
 
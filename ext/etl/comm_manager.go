@@ -20,7 +20,8 @@ import (
 type (
 	etlInstance struct {
 		comm Communicator
-		boot *etlBootstrapper // TODO: move all bootstrapper logic to proxy
+		boot *etlBootstrapper // K8s runtime; TODO: move all bootstrapper logic to proxy
+		proc *localProc       // local runtime (non-K8s deployments)
 	}
 	manager struct {
 		m   map[string]etlInstance
@@ -35,25 +36,23 @@ func Tinit() {
 	xreg.RegNonBckXact(&factory{})
 }
 
-func (r *manager) add(name string, c Communicator, boot *etlBootstrapper) (err error) {
+func (r *manager) add(name string, ei etlInstance) (err error) {
+	debug.Assert(ei.comm != nil && (ei.boot == nil) != (ei.proc == nil))
 	r.mtx.Lock()
 	if _, ok := r.m[name]; ok {
 		err = fmt.Errorf("etl[%s] already exists", name)
 	} else {
-		r.m[name] = etlInstance{comm: c, boot: boot}
+		r.m[name] = ei
 	}
 	r.mtx.Unlock()
 	return err
 }
 
-func (r *manager) getByName(name string) (Communicator, *etlBootstrapper) {
+func (r *manager) getByName(name string) (ei etlInstance, exists bool) {
 	r.mtx.RLock()
-	defer r.mtx.RUnlock()
-
-	if ei, exists := r.m[name]; exists {
-		return ei.comm, ei.boot
-	}
-	return nil, nil
+	ei, exists = r.m[name]
+	r.mtx.RUnlock()
+	return ei, exists
 }
 
 func (r *manager) getByXid(xid string) Communicator {
@@ -109,3 +108,14 @@ func ValidateSecret(etlName, secret string) error {
 
 	return errors.New("unrecognized request source")
 }
+
+/////////////////
+// etlInstance //
+/////////////////
+
+func (ei *etlInstance) uri() string {
+	if ei.proc != nil {
+		return localSchema + ei.proc.addr
+	}
+	return ei.boot.schema + ei.boot.addr
+}
@@ -0,0 +1,362 @@
+//go:build etl
+
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/k8s"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/sys"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// Local runtime: when the cluster is deployed outside Kubernetes (bare metal,
+// CI, development) each target runs its ETL server as a supervised child process:
+// - `runtime.command` of the ETLSpecMsg is executed as is (no container image);
+// - the process listens on a loopback port that is passed via $AIS_ETL_PORT;
+// - readiness is determined by the server's /health endpoint;
+// - unexpected termination aborts the corresponding ETL xaction (same as pod watcher);
+// - stdout and stderr are retained (most recent localLogSize bytes) for `PodLogs`.
+// Everything else - init message, communicators, inline and offline transforms -
+// remains the same.
+
+const (
+	localLogSize     = 256 * cos.KiB
+	localStopTimeout = 10 * time.Second
+	localSchema      = "http://"
+	localHost        = "127.0.0.1"
+)
+
+type (
+	localProc struct {
+		errCtx  *cmn.ETLErrCtx
+		msg     *ETLSpecMsg
+		xetl    core.Xact
+		cmd     *exec.Cmd
+		logs    *logBuf
+		done    chan struct{} // closed upon process exit
+		exitErr error         // valid once `done` is closed
+		secret  string
+		name    string
+		addr    string
+		stopped atomic.Bool
+	}
+
+	// bounded, concurrency-safe buffer that keeps the most recent output
+	logBuf struct {
+		b   []byte
+		max int
+		mu  sync.Mutex
+	}
+)
+
+// same syntax K8s uses to expand container command: $(VAR_NAME)
+var envRefRegex = regexp.MustCompile(`\$\(([A-Za-z_][A-Za-z0-9_]*)\)`)
+
+func startLocal(msg InitMsg, xid, secret string) (podInfo PodInfo, xctn core.Xact, err error) {
+	var (
+		comm   Communicator
+		errCtx = &cmn.ETLErrCtx{TID: core.T.SID(), ETLName: msg.Name()}
+	)
+	if err := CheckLocal(); err != nil {
+		return podInfo, nil, cmn.NewErrETL(errCtx, err.Error(), http.StatusForbidden)
+	}
+	spec, ok := msg.(*ETLSpecMsg)
+	if !ok {
+		return podInfo, nil, cmn.NewErrETLf(errCtx, "%s init requires Kubernetes, use %s with runtime.command instead",
+			msg.MsgType(), ETLSpecType)
+	}
+	if len(spec.Runtime.Command) == 0 {
+		return podInfo, nil, cmn.NewErrETLf(errCtx, "runtime.command must be specified (no Kubernetes to run %q)",
+			spec.Runtime.Image)
+	}
+	proc := &localProc{
+		errCtx: errCtx,
+		msg:    spec,
+		secret: secret,
+		name:   msg.PodName(core.T.SID()),
+		logs:   &logBuf{max: localLogSize},
+		done:   make(chan struct{}),
+	}
+	errCtx.PodName = proc.name
+
+	debug.Assert(xid != "")
+	if comm, err = initComm(msg, xid, secret, etlInstance{proc: proc}); err != nil {
+		return podInfo, nil, err
+	}
+	proc.xetl = comm.Xact()
+
+	if err = proc.start(); err != nil {
+		goto cleanup
+	}
+	if err = proc.waitReady(); err != nil {
+		goto cleanup
+	}
+	if _, err = comm.setupConnection(localSchema, proc.addr); err != nil {
+		goto cleanup
+	}
+
+	nlog.Infof("local ETL process %q (pid %d) is running at %s, %s", proc.name, proc.cmd.Process.Pid, proc.addr, errCtx)
+	podInfo.PodName, podInfo.URI = proc.name, proc.addr
+	return podInfo, comm.Xact(), nil
+
+cleanup: // initialization failed
+	Stop(msg.Name(), err)
+	errCtx.PodStatus = proc.status()
+	return podInfo, nil, cmn.NewErrETL(errCtx, err.Error())
+}
+
+///////////////
+// localProc //
+///////////////
+
+func (p *localProc) start() error {
+	port, err := freePort()
+	if err != nil {
+		return err
+	}
+	p.addr = cmn.HostPort(localHost, strconv.Itoa(port))
+
+	env := p.env(port)
+	args := make([]string, len(p.msg.Runtime.Command))
+	for i, arg := range p.msg.Runtime.Command {
+		args[i] = expandEnvRefs(arg, env)
+	}
+
+	p.cmd = exec.Command(args[0], args[1:]...) //nolint:gosec // user-provided ETL command is the whole point
+	p.cmd.Env = os.Environ()
+	for _, v := range env {
+		p.cmd.Env = append(p.cmd.Env, v.Name+"="+v.Value)
+	}
+	p.cmd.Stdout, p.cmd.Stderr = p.logs, p.logs
+	p.cmd.SysProcAttr = sysProcAttr()
+
+	if err := p.cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start %q: %w", args[0], err)
+	}
+	if cmn.Rom.V(4, cos.ModETL) {
+		nlog.Infof("started local ETL process %q: %v (pid %d, addr %s)", p.name, args, p.cmd.Process.Pid, p.addr)
+	}
+	go p.wait()
+	return nil
+}
+
+// environment variables visible to the ETL server - same as the ones set in ETL pods
+// (see _setPodEnv), plus the port to listen on
+func (p *localProc) env(port int) []corev1.EnvVar {
+	env := make([]corev1.EnvVar, 0, 3+len(p.msg.Runtime.Env)+len(p.msg.GetEnv()))
+	env = append(env,
+		corev1.EnvVar{Name: "AIS_TARGET_URL", Value: core.T.Snode().URL(cmn.NetIntraData) + apc.URLPathETLObject.Join(p.msg.Name(), p.secret)},
+		corev1.EnvVar{Name: DirectPut, Value: strconv.FormatBool(p.msg.IsDirectPut())},
+		corev1.EnvVar{Name: LocalPortEnv, Value: strconv.Itoa(port)},
+	)
+	env = append(env, p.msg.Runtime.Env...)
+	return append(env, p.msg.GetEnv()...)
+}
+
+// supervise: abort the ETL xaction upon unexpected termination
+func (p *localProc) wait() {
+	p.exitErr = p.cmd.Wait()
+	close(p.done)
+	if p.stopped.Load() {
+		return
+	}
+	err := p.exitErr
+	if err == nil {
+		err = errors.New("exited with status 0")
+	}
+	p.errCtx.PodStatus = p.status()
+	err = cmn.NewErrETLf(p.errCtx, "local ETL process %q terminated unexpectedly: %v", p.name, err)
+	nlog.Errorln(err)
+	if p.xetl != nil {
+		p.xetl.Abort(err)
+	}
+}
+
+// poll the server's health endpoint until it responds OK, the process exits, or init timeout
+func (p *localProc) waitReady() error {
+	var (
+		initTimeout, _ = p.msg.Timeouts()
+		interval       = cos.ProbingFrequency(initTimeout.D())
+		client         = &http.Client{Timeout: interval}
+		healthURL      = localSchema + p.addr + "/" + apc.ETLHealth
+		ctx, cancel    = context.WithCancel(context.Background())
+	)
+	defer cancel()
+	go func() {
+		select {
+		case <-p.done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	err := wait.PollUntilContextTimeout(ctx, interval, initTimeout.D(), true, /*immediate*/
+		func(context.Context) (bool, error) {
+			resp, err := client.Get(healthURL) //nolint:noctx // bounded by client timeout
+			if err != nil {
+				return false, nil
+			}
+			cos.DrainReader(resp.Body)
+			resp.Body.Close()
+			return resp.StatusCode == http.StatusOK, nil
+		},
+	)
+	if err != nil && p.exited() {
+		return fmt.Errorf("local ETL process %q exited during initialization: %v", p.name, p.exitErr)
+	}
+	return err
+}
+
+// terminate gracefully (SIGTERM to the entire process group), kill upon timeout
+func (p *localProc) stop() {
+	if !p.stopped.CAS(false, true) || p.cmd == nil || p.cmd.Process == nil {
+		return
+	}
+	pid := p.cmd.Process.Pid
+	if err := syscall.Kill(-pid, syscall.SIGTERM); err != nil && !p.exited() {
+		nlog.Warningln("failed to terminate local ETL process", p.name, pid, err)
+	}
+	select {
+	case <-p.done:
+	case <-time.After(localStopTimeout):
+		nlog.Warningln("local ETL process", p.name, pid, "did not terminate in", localStopTimeout, "- killing")
+		_ = syscall.Kill(-pid, syscall.SIGKILL)
+		<-p.done
+	}
+	if cmn.Rom.V(4, cos.ModETL) {
+		nlog.Infof("stopped local ETL process %q (pid %d): %v", p.name, pid, p.exitErr)
+	}
+}
+
+func (p *localProc) exited() bool {
+	select {
+	case <-p.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// reusing K8s pod phases to keep `ais etl show` output consistent across runtimes
+func (p *localProc) health() string {
+	switch {
+	case p.cmd == nil || p.cmd.Process == nil:
+		return string(corev1.PodPending)
+	case !p.exited():
+		return string(corev1.PodRunning)
+	case p.exitErr == nil:
+		return string(corev1.PodSucceeded)
+	default:
+		return string(corev1.PodFailed)
+	}
+}
+
+// (in pod watcher terms)
+func (p *localProc) status() (ps k8s.PodStatus) {
+	ps.CtrName = p.name
+	switch {
+	case p.cmd == nil || p.cmd.Process == nil:
+		ps.State = ctrWaiting
+	case !p.exited():
+		ps.State = ctrRunning
+	default:
+		ps.State = ctrTerminated
+		ps.ExitCode = int32(p.cmd.ProcessState.ExitCode())
+		if p.exitErr != nil {
+			ps.Reason = p.exitErr.Error()
+		}
+		ps.Message = string(p.logs.tail(512))
+	}
+	return ps
+}
+
+func (p *localProc) metrics() (*CPUMemUsed, error) {
+	if p.cmd == nil || p.cmd.Process == nil || p.exited() {
+		return nil, cos.NewErrNotFound(core.T, "local ETL process "+p.name)
+	}
+	stats, err := sys.ProcessStats(p.cmd.Process.Pid)
+	if err != nil {
+		return nil, err
+	}
+	return &CPUMemUsed{
+		TargetID: core.T.SID(),
+		CPU:      stats.CPU.Percent / 100 * float64(sys.NumCPU()), // cores
+		Mem:      int64(stats.Mem.Resident),
+	}, nil
+}
+
+////////////
+// logBuf //
+////////////
+
+func (lb *logBuf) Write(b []byte) (int, error) {
+	lb.mu.Lock()
+	lb.b = append(lb.b, b...)
+	if l := len(lb.b); l > lb.max {
+		n := copy(lb.b, lb.b[l-lb.max:])
+		lb.b = lb.b[:n]
+	}
+	lb.mu.Unlock()
+	return len(b), nil
+}
+
+func (lb *logBuf) bytes() []byte { return lb.tail(lb.max) }
+
+func (lb *logBuf) tail(n int) []byte {
+	lb.mu.Lock()
+	defer lb.mu.Unlock()
+	if l := len(lb.b); l > n {
+		return append([]byte(nil), lb.b[l-n:]...)
+	}
+	return append([]byte(nil), lb.b...)
+}
+
+//
+// misc
+//
+
+func freePort() (int, error) {
+	l, err := net.Listen("tcp", cmn.HostPort(localHost, "0"))
+	if err != nil {
+		return 0, err
+	}
+	port := l.Addr().(*net.TCPAddr).Port
+	return port, l.Close()
+}
+
+// expand $(VAR_NAME) references; unresolved references are left unchanged
+func expandEnvRefs(s string, env []corev1.EnvVar) string {
+	return envRefRegex.ReplaceAllStringFunc(s, func(ref string) string {
+		name := ref[2 : len(ref)-1]
+		for i := len(env) - 1; i >= 0; i-- {
+			if env[i].Name == name {
+				return env[i].Value
+			}
+		}
+		return ref
+	})
+}
@@ -0,0 +1,14 @@
+//go:build etl
+
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import "syscall"
+
+// run local ETL process in its own process group (to terminate it along with its children)
+func sysProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{Setpgid: true}
+}
@@ -0,0 +1,114 @@
+//go:build etl
+
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/cmn/k8s"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("LocalRuntime", func() {
+	newProc := func(command ...string) *localProc {
+		msg := &ETLSpecMsg{
+			InitMsgBase: InitMsgBase{EtlName: "local-etl", CommTypeX: Hpush, InitTimeout: cos.Duration(10 * time.Second)},
+			Runtime:     RuntimeSpec{Command: command, Env: []corev1.EnvVar{{Name: "FOO", Value: "bar"}}},
+		}
+		return &localProc{
+			errCtx: &cmn.ETLErrCtx{ETLName: msg.Name()},
+			msg:    msg,
+			name:   msg.PodName("tid"),
+			logs:   &logBuf{max: localLogSize},
+			done:   make(chan struct{}),
+		}
+	}
+
+	BeforeEach(func() {
+		bck := meta.NewBck("local", apc.AIS, cmn.NsGlobal, &cmn.Bprops{})
+		_ = mock.NewTarget(mock.NewBaseBownerMock(bck))
+	})
+
+	It("should require explicit enabling outside Kubernetes", func() {
+		if k8s.IsK8s() {
+			Skip("requires non-Kubernetes deployment")
+		}
+		config := cmn.GCO.BeginUpdate()
+		config.Log.Level = "3"
+		cmn.GCO.CommitUpdate(config)
+		cmn.Rom.Set(&config.ClusterConfig)
+		Expect(CheckLocal()).To(MatchError(ErrLocalDisabled))
+
+		cfg := config.ClusterConfig
+		cfg.Features = feat.EnableLocalETL
+		cmn.Rom.Set(&cfg)
+		Expect(CheckLocal()).NotTo(HaveOccurred())
+		cmn.Rom.Set(&config.ClusterConfig)
+	})
+
+	It("should expand $(VAR) references", func() {
+		env := []corev1.EnvVar{{Name: LocalPortEnv, Value: "1234"}, {Name: "A", Value: "x"}, {Name: "A", Value: "y"}}
+		Expect(expandEnvRefs("-p=$("+LocalPortEnv+")", env)).To(Equal("-p=1234"))
+		Expect(expandEnvRefs("$(A)$(A)", env)).To(Equal("yy"))
+		Expect(expandEnvRefs("$(UNKNOWN) $A ${A}", env)).To(Equal("$(UNKNOWN) $A ${A}"))
+	})
+
+	It("should retain only the most recent output", func() {
+		lb := &logBuf{max: 8}
+		lb.Write([]byte("0123"))
+		lb.Write([]byte("456789"))
+		Expect(lb.bytes()).To(Equal([]byte("23456789")))
+		Expect(lb.tail(3)).To(Equal([]byte("789")))
+	})
+
+	It("should pass environment and capture output", func() {
+		p := newProc("sh", "-c", "echo port=$"+LocalPortEnv+" foo=$FOO arg=$1", "sh", "$(FOO)")
+		Expect(p.start()).NotTo(HaveOccurred())
+		Eventually(p.done).Should(BeClosed())
+
+		port := p.addr[strings.LastIndexByte(p.addr, ':')+1:]
+		Expect(string(p.logs.bytes())).To(Equal("port=" + port + " foo=bar arg=bar\n"))
+		Expect(p.health()).To(Equal(string(corev1.PodSucceeded)))
+		Expect(p.msg.Runtime.Command[4]).To(Equal("$(FOO)")) // init message unmodified
+	})
+
+	It("should fail readiness fast when the process exits", func() {
+		p := newProc("sh", "-c", "echo oops >&2; exit 3")
+		Expect(p.start()).NotTo(HaveOccurred())
+		started := time.Now()
+		err := p.waitReady()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("exited during initialization"))
+		Expect(time.Since(started)).To(BeNumerically("<", 5*time.Second))
+
+		Expect(p.health()).To(Equal(string(corev1.PodFailed)))
+		ps := p.status()
+		Expect(ps.ExitCode).To(BeEquivalentTo(3))
+		Expect(ps.Message).To(ContainSubstring("oops"))
+	})
+
+	It("should terminate the process group on stop", func() {
+		p := newProc("sh", "-c", "sleep 60 & wait")
+		Expect(p.start()).NotTo(HaveOccurred())
+		Expect(p.health()).To(Equal(string(corev1.PodRunning)))
+
+		started := time.Now()
+		p.stop()
+		Expect(p.done).To(BeClosed())
+		Expect(time.Since(started)).To(BeNumerically("<", localStopTimeout))
+	})
+})
@@ -0,0 +1,15 @@
+//go:build etl
+
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import "syscall"
+
+// run local ETL process in its own process group (to terminate it along with its children),
+// and make sure it does not outlive the target
+func sysProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{Setpgid: true, Pdeathsig: syscall.SIGKILL}
+}
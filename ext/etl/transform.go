@@ -70,6 +70,9 @@ const (
 // 6. Finally, the ETL container is stopped using the `Stop` API. In response,
 //    each ais target in the cluster deletes its local ETL container (K8s pod).
 //
+// Outside Kubernetes, ETL containers are replaced with local processes, one per
+// target, executing `runtime.command` of the ETL spec (see local.go).
+//
 // Limitations of the current implementation (soon to be removed):
 //
 // * No idle timeout for a ETL container. It keeps running unless explicitly
@@ -84,8 +87,16 @@ const (
 
 // (common for both `InitSpec` and `ETLSpec` flows)
 func Init(msg InitMsg, xid, secret string) (core.Xact, PodInfo, error) {
-	config := cmn.GCO.Get()
-	podInfo, xctn, err := start(msg, xid, secret, config)
+	var (
+		podInfo PodInfo
+		xctn    core.Xact
+		err     error
+	)
+	if k8s.IsK8s() {
+		podInfo, xctn, err = start(msg, xid, secret, cmn.GCO.Get())
+	} else {
+		podInfo, xctn, err = startLocal(msg, xid, secret)
+	}
 	if err != nil {
 		return nil, podInfo, err
 	}
@@ -99,6 +110,9 @@ func Init(msg InitMsg, xid, secret string) (core.Xact, PodInfo, error) {
 // cleanupEntities removes provided entities. It tries its best to remove all
 // entities so it doesn't stop when encountering an error.
 func CleanupEntities(errCtx *cmn.ETLErrCtx, podName, svcName string) (err error) {
+	if !k8s.IsK8s() {
+		return nil // local ETL processes are owned (and stopped) by their respective targets
+	}
 	if svcName != "" {
 		if deleteErr := deleteEntity(errCtx, k8s.Svc, svcName); deleteErr != nil {
 			err = deleteErr
@@ -145,7 +159,7 @@ func start(msg InitMsg, xid, secret string, config *cmn.Config) (podInfo PodInfo
 	boot.createServiceSpec()
 
 	// 2. Create communicator
-	if comm, err = initComm(msg, xid, secret, boot); err != nil {
+	if comm, err = initComm(msg, xid, secret, etlInstance{boot: boot}); err != nil {
 		return podInfo, nil, err
 	}
 
@@ -204,13 +218,13 @@ func StopByXid(xid string, errCause error) error {
 // 2. initialization failed
 // 3. transaction/xaction abort (StopByXid)
 func Stop(etlName string, errCause error) (err error) {
-	comm, boot := mgr.getByName(etlName)
-	if comm == nil {
+	ei, exists := mgr.getByName(etlName)
+	if !exists {
 		return cos.NewErrNotFound(core.T, etlName+" not found")
 	}
 
 	// Note: comm.stop() is protected by atomic bool, run only once
-	if err := comm.stop(); err != nil {
+	if err := ei.comm.stop(); err != nil {
 		return err
 	}
 
@@ -218,6 +232,14 @@ func Stop(etlName string, errCause error) (err error) {
 		nlog.Infof("Stopping ETL: %s, %v", etlName, errCause)
 	}
 
+	if ei.proc != nil {
+		ei.proc.stop()
+		mgr.del(etlName)
+		xreg.AbortKind(errCause, apc.ActETLBck) // (ditto)
+		return nil
+	}
+
+	boot := ei.boot
 	boot.pw.stop(true)
 	mgr.del(etlName)
 
@@ -238,9 +260,6 @@ func Delete(etlName string) error { return Stop(etlName, cmn.ErrXactUserAbort) }
 
 // StopAll terminates all running ETLs.
 func StopAll() {
-	if !k8s.IsK8s() {
-		return
-	}
 	for _, e := range List() {
 		if err := Stop(e.Name, nil); err != nil {
 			nlog.Errorln(err)
@@ -251,24 +270,24 @@ func StopAll() {
 // GetCommunicator retrieves the Communicator from registry by etl name
 // Returns an error if not found or not in the Running stage.
 func GetCommunicator(etlName string) (Communicator, error) {
-	comm, _ := mgr.getByName(etlName)
-	if comm == nil {
+	ei, exists := mgr.getByName(etlName)
+	if !exists {
 		return nil, cos.NewErrNotFound(core.T, etlName)
 	}
-	return comm, nil
+	return ei.comm, nil
 }
 
 func GetPipeline(etlNames []string) (apc.ETLPipeline, error) {
 	pipeline := make(apc.ETLPipeline, 0, len(etlNames))
 	for _, name := range etlNames {
-		_, boot := mgr.getByName(name)
-		if boot == nil {
+		ei, exists := mgr.getByName(name)
+		if !exists {
 			return nil, cmn.NewErrETL(&cmn.ETLErrCtx{
 				TID:     core.T.SID(),
 				ETLName: name,
 			}, "entry not found in the target", http.StatusNotFound)
 		}
-		pipeline.Join(boot.schema + boot.addr)
+		pipeline.Join(ei.uri())
 	}
 	if cmn.Rom.V(4, cos.ModETL) {
 		nlog.Infof("etlNames: %v => pipeline: %s", etlNames, pipeline.String())
@@ -308,10 +327,14 @@ func GetOfflineTransform(etlName string, xctn core.Xact) (getROC core.GetROC, xe
 func List() []Info { return mgr.list() }
 
 func PodLogs(etlName string) (logs Logs, err error) {
-	_, boot := mgr.getByName(etlName)
-	if boot == nil {
+	ei, exists := mgr.getByName(etlName)
+	if !exists {
 		return logs, cos.NewErrNotFound(core.T, etlName)
 	}
+	if ei.proc != nil {
+		return Logs{TargetID: core.T.SID(), Logs: ei.proc.logs.bytes()}, nil
+	}
+	boot := ei.boot
 	client, err := k8s.GetClient()
 	if err != nil {
 		return logs, err
@@ -327,10 +350,14 @@ func PodLogs(etlName string) (logs Logs, err error) {
 }
 
 func PodHealth(etlName string) (string, error) {
-	_, boot := mgr.getByName(etlName)
-	if boot == nil {
+	ei, exists := mgr.getByName(etlName)
+	if !exists {
 		return "", cos.NewErrNotFound(core.T, etlName)
 	}
+	if ei.proc != nil {
+		return ei.proc.health(), nil
+	}
+	boot := ei.boot
 	client, err := k8s.GetClient()
 	if err != nil {
 		return "", err
@@ -339,10 +366,14 @@ func PodHealth(etlName string) (string, error) {
 }
 
 func PodMetrics(etlName string) (*CPUMemUsed, error) {
-	_, boot := mgr.getByName(etlName)
-	if boot == nil {
+	ei, exists := mgr.getByName(etlName)
+	if !exists {
 		return nil, cos.NewErrNotFound(core.T, etlName)
 	}
+	if ei.proc != nil {
+		return ei.proc.metrics()
+	}
+	boot := ei.boot
 	client, err := k8s.GetClient()
 	if err != nil {
 		return nil, err
@@ -26,6 +26,9 @@ const (
 	ECMetaCT    = "mt"
 	ChunkCT     = "ch"
 	ChunkMetaCT = "ut"
+	ObjVerCT    = "vr" // retained prior versions and delete markers (see cmn.VersionConf.KeepHistory)
+	ObjSnapCT   = "sn" // objects preserved for bucket snapshots (see cmn.BckSnapshot)
+	WritebackCT = "wb" // marks objects pending upload to remote backend (see apc.WriteDelayed)
 
 	// ext
 	DsortFileCT = "ds"
@@ -37,6 +40,12 @@ const (
 	bsepa = '.'
 )
 
+// ObjVerCT: per-object directory suffix and delete marker
+const (
+	VerDirSuffix = ".v"
+	VerDelMarker = "dm"
+)
+
 type (
 	ContentInfo struct {
 		Base   string   // original base
@@ -66,6 +75,9 @@ type (
 	ecMetaCR    struct{}
 	objChunkCR  struct{}
 	chunkMetaCR struct{}
+	objVerCR    struct{}
+	objSnapCR   struct{}
+	writebackCR struct{}
 	dsortCR     struct{}
 )
 
@@ -91,6 +103,9 @@ var (
 	_ contentRes = (*ecMetaCR)(nil)
 	_ contentRes = (*objChunkCR)(nil)
 	_ contentRes = (*chunkMetaCR)(nil)
+	_ contentRes = (*objVerCR)(nil)
+	_ contentRes = (*objSnapCR)(nil)
+	_ contentRes = (*writebackCR)(nil)
 )
 
 // register all content types
@@ -110,6 +125,9 @@ func (csm *contentSpecMgr) regAll() {
 	csm._reg(ECMetaCT, &ecMetaCR{})
 	csm._reg(ChunkCT, &objChunkCR{})
 	csm._reg(ChunkMetaCT, &chunkMetaCR{})
+	csm._reg(ObjVerCT, &objVerCR{})
+	csm._reg(ObjSnapCT, &objSnapCR{})
+	csm._reg(WritebackCT, &writebackCR{})
 
 	csm._reg(DsortFileCT, &dsortCR{})
 	csm._reg(DsortWorkCT, &dsortCR{})
@@ -279,6 +297,58 @@ func (*chunkMetaCR) parseUbase(base string) ContentInfo {
 	return ContentInfo{Base: base[:i], Extras: []string{uploadID}, Ok: true} // partial
 }
 
+// objVerCR: prior object version (base.v/version) or delete marker (base.v/version.dm),
+// keeping all versions of a given object in its own directory
+func (*objVerCR) makeUbase(base string, extras ...string) string {
+	debug.Assert(len(extras) == 1 || (len(extras) == 2 && extras[1] == VerDelMarker), extras)
+	debug.Assert(extras[0] != "", "version must be non-empty")
+	ubase := base + VerDirSuffix + cos.PathSeparator + extras[0]
+	if len(extras) == 2 {
+		ubase += ssepa + VerDelMarker
+	}
+	return ubase
+}
+
+func (*objVerCR) parseUbase(ubase string) (ci ContentInfo) {
+	i := strings.LastIndexByte(ubase, filepath.Separator)
+	if i < 0 {
+		return
+	}
+	dir, version := ubase[:i], ubase[i+1:]
+	base, ok := strings.CutSuffix(dir, VerDirSuffix)
+	if !ok || base == "" || cos.IsLastB(base, filepath.Separator) {
+		return
+	}
+	version, dm := strings.CutSuffix(version, ssepa+VerDelMarker)
+	if n, err := strconv.ParseInt(version, 10, 64); err != nil || n <= 0 || strconv.FormatInt(n, 10) != version {
+		return
+	}
+	ci = ContentInfo{Base: base, Extras: []string{version}, Ok: true}
+	if dm {
+		ci.Extras = append(ci.Extras, VerDelMarker)
+	}
+	return ci
+}
+
+// objSnapCR: object preserved for a bucket snapshot (snapID/base),
+// keeping each snapshot's content in its own directory
+func (*objSnapCR) makeUbase(base string, extras ...string) string {
+	debug.Assert(len(extras) == 1 && extras[0] != "", extras)
+	return extras[0] + cos.PathSeparator + base
+}
+
+func (*objSnapCR) parseUbase(ubase string) (ci ContentInfo) {
+	i := strings.IndexByte(ubase, filepath.Separator)
+	if i <= 0 || i == len(ubase)-1 {
+		return
+	}
+	id := ubase[:i]
+	if n, err := strconv.ParseInt(id, 10, 64); err != nil || n <= 0 || strconv.FormatInt(n, 10) != id {
+		return
+	}
+	return ContentInfo{Base: ubase[i+1:], Extras: []string{id}, Ok: true}
+}
+
 func (*ecSliceCR) makeUbase(base string, _ ...string) string { return base }
 
 func (*ecSliceCR) parseUbase(base string) ContentInfo {
@@ -291,6 +361,14 @@ func (*ecMetaCR) parseUbase(base string) ContentInfo {
 	return ContentInfo{Base: base, Ok: true}
 }
 
+// WritebackCT: (small) marker file per object that has been written locally
+// and is pending upload to the remote backend - same name as the object
+func (*writebackCR) makeUbase(base string, _ ...string) string { return base }
+
+func (*writebackCR) parseUbase(base string) ContentInfo {
+	return ContentInfo{Base: base, Ok: true}
+}
+
 func (*dsortCR) makeUbase(base string, _ ...string) string { return base }
 
 func (*dsortCR) parseUbase(base string) ContentInfo {
@@ -37,6 +37,9 @@ const (
 	WorkfileAppendToArch = "append-to-arch" // APPEND to existing archive
 	WorkfileCreateArch   = "create-arch"    // CREATE multi-object archive
 	WorkfileShardIdx     = "shardidx"       // write shard index to ais://.sys-shardidx
+	WorkfileScrub        = "scrub"          // corrupted object moved aside pending repair
+	WorkfileRecvSnap     = "recv-snap"      // receive content preserved for bucket snapshot (rebalance)
+	WorkfileRecvVer      = "recv-ver"       // receive retained object version (rebalance)
 )
 
 type ParsedFQN struct {
@@ -59,6 +59,15 @@ func TestParseFQN(t *testing.T) {
 			fs.WorkCT, "objname", false,
 			false,
 		},
+		{
+			"content type (write-back)",
+			tmpMpath + "/@gcp/bucket/%wb/dir/objname",
+			[]string{tmpMpath},
+			tmpMpath,
+			cmn.Bck{Name: "bucket", Provider: apc.GCP, Ns: cmn.NsGlobal},
+			fs.WritebackCT, "dir/objname", false,
+			false,
+		},
 		{
 			"cloud as bucket type (aws)",
 			tmpMpath + "/@aws/bucket/%ob/objname",
@@ -473,6 +482,81 @@ func TestObjCTDeepPath(t *testing.T) {
 	}
 }
 
+func TestObjVerUbase(t *testing.T) {
+	tmpMpath := t.TempDir()
+
+	mios := mock.NewIOS()
+	fs.NewTestMFS(mios)
+	_, err := fs.AddTestMpath(tmpMpath, "daeID")
+	tassert.CheckFatal(t, err)
+
+	mi := fs.GetAvail()[tmpMpath]
+	bck := &cmn.Bck{Name: "bucket", Provider: apc.AIS, Ns: cmn.NsGlobal}
+
+	tests := []struct {
+		objName string
+		extras  []string
+	}{
+		{"obj", []string{"1"}},
+		{"dir/obj.tar.5", []string{"12"}},
+		{"dir/obj.dm", []string{"3", fs.VerDelMarker}},
+	}
+	for _, tc := range tests {
+		fqn := fs.CSM.Gen(tc.objName, fs.ObjVerCT, bck, mi, tc.extras...)
+		var parsed fs.ParsedFQN
+		tassert.CheckFatal(t, parsed.Init(fqn))
+		tassert.Fatalf(t, parsed.ContentType == fs.ObjVerCT, "expected %q, got %q", fs.ObjVerCT, parsed.ContentType)
+
+		ci := fs.CSM.ParseUbase(parsed.ObjName, fs.ObjVerCT)
+		tassert.Fatalf(t, ci.Ok, "failed to parse %q", parsed.ObjName)
+		tassert.Fatalf(t, ci.Base == tc.objName, "expected base %q, got %q", tc.objName, ci.Base)
+		tassert.Fatalf(t, strings.Join(ci.Extras, ",") == strings.Join(tc.extras, ","),
+			"expected extras %v, got %v", tc.extras, ci.Extras)
+	}
+
+	for _, ubase := range []string{"obj", "obj/1", "obj.v/dm", "obj.v/0", "obj.v/01", "obj.v/v1", "obj.v/x.dm", ".v/1", "dir/.v/1"} {
+		ci := fs.CSM.ParseUbase(ubase, fs.ObjVerCT)
+		tassert.Fatalf(t, !ci.Ok, "expected %q to fail", ubase)
+	}
+}
+
+func TestObjSnapUbase(t *testing.T) {
+	tmpMpath := t.TempDir()
+
+	mios := mock.NewIOS()
+	fs.NewTestMFS(mios)
+	_, err := fs.AddTestMpath(tmpMpath, "daeID")
+	tassert.CheckFatal(t, err)
+
+	mi := fs.GetAvail()[tmpMpath]
+	bck := &cmn.Bck{Name: "bucket", Provider: apc.AIS, Ns: cmn.NsGlobal}
+
+	tests := []struct {
+		objName string
+		id      string
+	}{
+		{"obj", "1760000000000000000"},
+		{"dir/obj.tar", "12"},
+		{"dir/subdir/obj", "3"},
+	}
+	for _, tc := range tests {
+		fqn := fs.CSM.Gen(tc.objName, fs.ObjSnapCT, bck, mi, tc.id)
+		var parsed fs.ParsedFQN
+		tassert.CheckFatal(t, parsed.Init(fqn))
+		tassert.Fatalf(t, parsed.ContentType == fs.ObjSnapCT, "expected %q, got %q", fs.ObjSnapCT, parsed.ContentType)
+
+		ci := fs.CSM.ParseUbase(parsed.ObjName, fs.ObjSnapCT)
+		tassert.Fatalf(t, ci.Ok, "failed to parse %q", parsed.ObjName)
+		tassert.Fatalf(t, ci.Base == tc.objName, "expected base %q, got %q", tc.objName, ci.Base)
+		tassert.Fatalf(t, len(ci.Extras) == 1 && ci.Extras[0] == tc.id, "expected id %q, got %v", tc.id, ci.Extras)
+	}
+
+	for _, ubase := range []string{"obj", "1/", "0/obj", "01/obj", "x1/obj", "-1/obj", "/obj"} {
+		ci := fs.CSM.ParseUbase(ubase, fs.ObjSnapCT)
+		tassert.Fatalf(t, !ci.Ok, "expected %q to fail", ubase)
+	}
+}
+
 func BenchmarkParseFQN(b *testing.B) {
 	var (
 		mpath = "/tmp/mpath"
@@ -80,6 +80,7 @@ type (
 		Disks      []string           // owned disks (ios.FsDisks map => slice)
 		flags      uint64             // bit flags (set/get atomic)
 		PathDigest uint64             // (HRW logic)
+		Weight     uint64             // weighted HRW: capacity in GiB, or zero when not weighted (see InitWeights)
 		capacity   Capacity
 	}
 	MPI map[string]*Mountpath
@@ -103,7 +104,8 @@ type (
 		csExpires atomic.Int64
 		totalSize atomic.Uint64
 
-		flags uint64
+		flags    uint64
+		weighted atomic.Bool // (see InitWeights)
 
 		mu sync.Mutex
 	}
@@ -506,6 +508,9 @@ func (mi *Mountpath) _addEnabled(tid string, avail MPI, config *cmn.Config, bloc
 		}
 	}
 	mi._setDisks(fsdisks)
+	if mfs.weighted.Load() && mi.Weight == 0 {
+		mi.initWeight(true)
+	}
 	avail[mi.Path] = mi
 	return nil
 }
@@ -1290,6 +1295,44 @@ func SetVolSizeMedia() error {
 	return nil
 }
 
+// Weighted HRW (see Hrw): mountpaths of a weighted target are weighted by their
+// respective capacities. Once assigned, mountpath weights persist (in VMD) and
+// don't change. Returns the total weight of all available mountpaths and
+// whether any weight changed (to persist).
+func InitWeights(weighted bool) (total uint64, changed bool) {
+	mfs.weighted.Store(weighted)
+	avail, disabled := Get()
+	for _, mi := range disabled {
+		if mi.initWeight(weighted) {
+			changed = true
+		}
+	}
+	for _, mi := range avail {
+		if mi.initWeight(weighted) {
+			changed = true
+		}
+		if !mi.IsAnySet(FlagWaitingDD) {
+			total += mi.Weight
+		}
+	}
+	return total, changed
+}
+
+func (mi *Mountpath) initWeight(weighted bool) bool {
+	switch {
+	case !weighted:
+		if mi.Weight == 0 {
+			return false
+		}
+		mi.Weight = 0
+	case mi.Weight != 0:
+		return false
+	default:
+		mi.Weight = max(mi.diskSize()/cos.GiB, 1)
+	}
+	return true
+}
+
 func GetVolSize() (volSize uint64, err error) {
 	if volSize = mfs.totalSize.Load(); volSize < volSizeMin {
 		if volSize == 0 {
@@ -14,6 +14,7 @@ import (
 
 // A variant of consistent hash based on rendezvous algorithm by Thaler and Ravishankar,
 // aka highest random weight (HRW)
+// Mountpaths of a weighted target are weighted by capacity (see InitWeights and cos.HrwWeighted).
 // See also: core/meta/hrw.go
 
 func Hrw(uname []byte) (mi *Mountpath, digest uint64, err error) {
@@ -22,6 +23,24 @@ func Hrw(uname []byte) (mi *Mountpath, digest uint64, err error) {
 }
 
 func (avail MPI) Hrw(uname []byte) (mi *Mountpath, digest uint64, err error) {
+	return avail.hrw(uname, "")
+}
+
+// HRW within a given storage class, i.e., across the mountpaths labeled with `label`;
+// when none of the available mountpaths has the label - across all of them
+// (usage: bucket tiering - see cmn.TieringConf)
+func (avail MPI) HrwLabel(uname []byte, label cos.MountpathLabel) (mi *Mountpath, digest uint64, err error) {
+	if !label.IsNil() {
+		for _, mpathInfo := range avail {
+			if mpathInfo.Label == label && !mpathInfo.IsAnySet(FlagWaitingDD) {
+				return avail.hrw(uname, label)
+			}
+		}
+	}
+	return avail.hrw(uname, "")
+}
+
+func (avail MPI) hrw(uname []byte, label cos.MountpathLabel) (mi *Mountpath, digest uint64, err error) {
 	var (
 		maxH uint64
 	)
@@ -30,7 +49,13 @@ func (avail MPI) Hrw(uname []byte) (mi *Mountpath, digest uint64, err error) {
 		if mpathInfo.IsAnySet(FlagWaitingDD) {
 			continue
 		}
+		if !label.IsNil() && mpathInfo.Label != label {
+			continue
+		}
 		cs := xoshiro256.Hash(mpathInfo.PathDigest ^ digest)
+		if mpathInfo.Weight != 0 {
+			cs = cos.HrwWeighted(cs, mpathInfo.Weight)
+		}
 		if cs >= maxH {
 			maxH = cs
 			mi = mpathInfo
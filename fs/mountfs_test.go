@@ -5,6 +5,7 @@
 package fs_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -239,6 +240,80 @@ func TestMountpathAddAndDisableMultiple(t *testing.T) {
 	tools.AssertMountpathCount(t, 1, 1)
 }
 
+func TestMountpathWeights(t *testing.T) {
+	initFS()
+
+	mp1, mp2 := t.TempDir(), t.TempDir()
+	tools.AddMpath(t, mp1)
+	tools.AddMpath(t, mp2)
+
+	total, changed := fs.InitWeights(true)
+	tassert.Errorf(t, changed, "expected mountpath weights to be assigned")
+	avail := fs.GetAvail()
+	w1, w2 := avail[mp1].Weight, avail[mp2].Weight
+	tassert.Errorf(t, w1 > 0 && w2 > 0 && total == w1+w2, "unexpected weights: %d + %d vs total %d", w1, w2, total)
+
+	// assigned once
+	_, changed = fs.InitWeights(true)
+	tassert.Errorf(t, !changed, "expected mountpath weights to stay unchanged")
+
+	// newly attached
+	mp3 := t.TempDir()
+	tools.AddMpath(t, mp3)
+	tassert.Errorf(t, fs.GetAvail()[mp3].Weight > 0, "expected newly attached mountpath to be weighted")
+
+	// proportional placement
+	_, err := fs.Remove(mp3)
+	tassert.CheckFatal(t, err)
+	avail = fs.GetAvail()
+	avail[mp1].Weight, avail[mp2].Weight = 1, 3
+	var cnt int
+	for i := range 10000 {
+		mi, _, err := avail.Hrw(cos.UnsafeB(fmt.Sprintf("ais/@#/bck/obj-%d", i)))
+		tassert.CheckFatal(t, err)
+		if mi.Path == mp1 {
+			cnt++
+		}
+	}
+	tassert.Errorf(t, cnt > 2000 && cnt < 3000, "expected about 25%% of objects on %s, got %d", mp1, cnt)
+
+	_, changed = fs.InitWeights(false)
+	avail = fs.GetAvail()
+	tassert.Errorf(t, changed && avail[mp1].Weight == 0 && avail[mp2].Weight == 0, "expected mountpath weights to be cleared")
+}
+
+func TestHrwLabel(t *testing.T) {
+	initFS()
+
+	mp1, mp2, mp3 := t.TempDir(), t.TempDir(), t.TempDir()
+	tools.AddMpath(t, mp1)
+	tools.AddMpath(t, mp2)
+	tools.AddMpath(t, mp3)
+
+	avail := fs.GetAvail()
+	avail[mp1].Label, avail[mp2].Label, avail[mp3].Label = "nvme", "nvme", "hdd"
+	defer func() {
+		avail[mp1].Label, avail[mp2].Label, avail[mp3].Label = "", "", ""
+	}()
+
+	for i := range 1000 {
+		uname := cos.UnsafeB(fmt.Sprintf("ais/@#/bck/obj-%d", i))
+		mi, _, err := avail.HrwLabel(uname, "nvme")
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, mi.Path == mp1 || mi.Path == mp2, "expected %q to be placed on nvme, got %s", uname, mi)
+
+		mi, _, err = avail.HrwLabel(uname, "hdd")
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, mi.Path == mp3, "expected %q to be placed on hdd, got %s", uname, mi)
+
+		// no such storage class: all mountpaths
+		mi, _, err = avail.HrwLabel(uname, "ssd")
+		tassert.CheckFatal(t, err)
+		hmi, _, _ := avail.Hrw(uname)
+		tassert.Errorf(t, mi == hmi, "expected %q to fall back to %s, got %s", uname, hmi, mi)
+	}
+}
+
 func TestMoveToDeleted(t *testing.T) {
 	initFS()
 
@@ -15,6 +15,12 @@ const (
 	DelOldIval        = 24 * time.Minute // cleanup old xactions; old transactions
 	Prune2mIval       = 2 * time.Minute  // prune active xactions (from finished); cleanup notifs; remove aged idle SDM recv
 	PruneRateLimiters = 6 * time.Hour    // prune stale rate limiters on the front
+	LifecycleIval     = time.Hour        // enforce bucket lifecycle rules (target)
+	WritebackIval     = 10 * time.Second // write back objects pending upload to remote backend (target)
+	QuotaIval         = 20 * time.Second // report bucket usage (target => primary) to enforce capacity and object-count quotas
+	TieringIval       = time.Hour        // move objects between hot and cold tiers of the buckets that have tiering enabled (target)
+	JobSchedIval      = time.Minute      // evaluate recurring job schedules (primary)
+	XactQueueIval     = 10 * time.Second // retry pending requests in the xaction admission queue (primary)
 
 	//
 	// when things are getting _old_
@@ -54,3 +54,9 @@ func GetATime(osfi os.FileInfo) time.Time {
 	// NOTE: see https://en.wikipedia.org/wiki/Stat_(system_call)#Criticism_of_atime
 	return atime
 }
+
+// GetCTime returns inode change time (e.g., when the file was last linked or renamed)
+func GetCTime(osfi os.FileInfo) time.Time {
+	stat := osfi.Sys().(*syscall.Stat_t)
+	return time.Unix(stat.Ctimespec.Sec, stat.Ctimespec.Nsec)
+}
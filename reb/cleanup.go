@@ -333,6 +333,11 @@ func (j *clnJogger) _lwalk(lom *core.LOM, fqn string) error {
 	}
 	stats.loads.Inc()
 
+	// pending write-back (see core/lwback): never remove
+	if lom.WbPending() {
+		return cmn.ErrSkip
+	}
+
 	// check the expected location, request specific props to establish identity
 	// TODO -- FIXME: HeadObjT2T() must support batch request to ensure scalability
 	op, err := core.T.HeadObjT2T(lom, tsi,
@@ -137,7 +137,7 @@ func (reb *Reb) sendFromDisk(ct *core.CT, md *ec.Metadata, target *meta.Snode, x
 		defer core.FreeLOM(lom)
 		roc, errReader = lom.NewDeferROC(true /*loaded*/) // + unlock
 	} else {
-		roc, errReader = cos.NewFileHandle(fqn)
+		roc, _, errReader = core.OpenCT(fqn, ct.Bck()) // (decrypts if need be)
 	}
 	if errReader != nil {
 		return errReader
@@ -573,6 +573,11 @@ func (reb *Reb) runNoEC(rargs *rargs) error {
 	}
 	wg.Wait()
 
+	// bucket snapshots and version history
+	if rargs.xreb.AbortErr() == nil {
+		reb.runPreserved(rargs)
+	}
+
 	// drain workers
 	if nwp := rargs.nwp; nwp != nil {
 		close(nwp.workCh)
@@ -14,6 +14,9 @@ const (
 	rebMsgRegular = iota // regular rebalance: acknowledge/Object
 	rebMsgEC             // EC rebalance: acknowledge/CT/Namespace
 	rebMsgNtfn           // stage transition notification (via DM's ack stream) _or_ EC md update (via data stream)
+	rebMsgSnap           // content preserved for bucket snapshot (see reb/preserved)
+	rebMsgVer            // retained object version (ditto)
+	rebMsgVerDel         // delete marker (ditto)
 )
 
 const rebMsgKindSize = 1
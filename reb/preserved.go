@@ -0,0 +1,186 @@
+// Package reb provides global cluster-wide rebalance upon adding/removing storage nodes.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package reb
+
+import (
+	"encoding/binary"
+	"io"
+	"strconv"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/transport"
+)
+
+// Content preserved for bucket snapshots (fs.ObjSnapCT) and retained object versions
+// (fs.ObjVerCT) follows its object - gets sent to the object's HRW target and stored
+// there under the same snapshot ID or version (see core/lsnap and core/lversion).
+// As with objects, the sender keeps its (misplaced) content; unlike objects, the latter
+// is removed only when the snapshot is destroyed or, respectively, by space cleanup when
+// history is disabled or per `keep_days`.
+// Content is sent decoded (decompressed and decrypted) and gets stored by the receiver as
+// per its bucket configuration - the same way as objects.
+
+// []byte{rebMsgSnap, rebID, snapshot ID} or []byte{rebMsgVer | rebMsgVerDel, rebID, version}
+const snapOpaqueSize = regOpaqueSize + cos.SizeofI64
+
+func (reb *Reb) runPreserved(rargs *rargs) {
+	wg := &sync.WaitGroup{}
+	for _, mi := range rargs.avail {
+		wg.Add(1)
+		go reb.jogPreserved(mi, rargs, wg)
+	}
+	wg.Wait()
+}
+
+func (*Reb) jogPreserved(mi *fs.Mountpath, rargs *rargs, wg *sync.WaitGroup) {
+	defer wg.Done()
+	walk := func(bck *meta.Bck) bool {
+		if !bck.IsAIS() || bck.Props == nil {
+			return false
+		}
+		cts := make([]string, 0, 2)
+		if len(bck.Props.Snapshots) > 0 {
+			cts = append(cts, fs.ObjSnapCT)
+		}
+		if bck.Props.Versioning.KeepHistory() {
+			cts = append(cts, fs.ObjVerCT)
+		}
+		if len(cts) == 0 {
+			return false
+		}
+		opts := &fs.WalkOpts{
+			Mi:  mi,
+			CTs: cts,
+			Callback: func(fqn string, de fs.DirEntry) error {
+				if err := rargs.xreb.AbortErr(); err != nil {
+					return err
+				}
+				if de.IsDir() {
+					return nil
+				}
+				return rargs.sendPreserved(fqn)
+			},
+		}
+		opts.Bck.Copy(bck.Bucket())
+		if err := fs.Walk(opts); err != nil {
+			if !rargs.xreb.IsAborted() {
+				nlog.Errorln(core.T.String(), rargs.xreb.Name(), "failed to traverse preserved content", err)
+			}
+			return true
+		}
+		return rargs.xreb.IsAborted()
+	}
+	if rargs.bck != nil {
+		walk(rargs.bck)
+		return
+	}
+	bmd := core.T.Bowner().Get()
+	bmd.Range(nil, nil, walk)
+}
+
+func (rargs *rargs) sendPreserved(fqn string) error {
+	var parsed fs.ParsedFQN
+	if err := parsed.Init(fqn); err != nil {
+		return nil
+	}
+	ci := fs.CSM.ParseUbase(parsed.ObjName, parsed.ContentType)
+	if !ci.Ok || (rargs.prefix != "" && !cmn.ObjHasPrefix(ci.Base, rargs.prefix)) {
+		return nil
+	}
+	id, err := strconv.ParseInt(ci.Extras[0], 10, 64) // snapshot ID or version
+	if err != nil {
+		return nil
+	}
+	lom := core.AllocLOM(ci.Base)
+	defer core.FreeLOM(lom)
+	if err := lom.InitCmnBck(&parsed.Bck); err != nil {
+		return err
+	}
+	tsi, err := rargs.smap.HrwHash2T(lom.Digest())
+	if err != nil {
+		return err
+	}
+	if tsi.ID() == core.T.SID() {
+		return nil
+	}
+	var (
+		slom *core.LOM
+		kind byte = rebMsgSnap
+	)
+	if parsed.ContentType == fs.ObjVerCT {
+		kind = rebMsgVer
+		if len(ci.Extras) > 1 {
+			kind = rebMsgVerDel
+		}
+		slom, err = lom.LoadRetained(fqn, ci.Extras[0], kind == rebMsgVerDel)
+	} else {
+		slom, err = lom.LoadPreserved(fqn)
+	}
+	if err != nil {
+		if !cos.IsNotExist(err) {
+			nlog.Warningln(rargs.logHdr, "failed to load", fqn, "[", err, "]")
+		}
+		return nil
+	}
+	fh, err := slom.NewFileHandle(fqn) // decoded content (the receiver re-encodes - see core.recvPreserved)
+	if err != nil {
+		core.FreeLOM(slom)
+		return nil // (destroyed snapshot or pruned version)
+	}
+
+	o := transport.AllocSend()
+	o.Hdr.Bck.Copy(lom.Bucket())
+	o.Hdr.ObjName = lom.ObjName
+	opaque := make([]byte, snapOpaqueSize)
+	copy(opaque, rargs.opaque[:])
+	opaque[0] = kind
+	binary.BigEndian.PutUint64(opaque[regOpaqueSize:], uint64(id))
+	o.Hdr.Opaque = opaque
+	o.Hdr.ObjAttrs.CopyFrom(slom.ObjAttrs(), false /*skip cksum*/)
+	o.SentCB = rargs.snapSentCallback
+	core.FreeLOM(slom)
+
+	if err := rargs.m.dm.Send(o, fh, tsi); err != nil {
+		rargs.xreb.Abort(err) // (ditto)
+		return err
+	}
+	return nil
+}
+
+func (rargs *rargs) snapSentCallback(hdr *transport.ObjHdr, _ io.ReadCloser, _ any, err error) {
+	if err != nil && !rargs.xreb.IsAborted() {
+		nlog.Errorln(core.T.String(), rargs.xreb.Name(), "failed to send", hdr.Cname(), "preserved content:", err)
+	}
+}
+
+func (reb *Reb) recvPreserved(hdr *transport.ObjHdr, objReader io.Reader) error {
+	lom := core.AllocLOM(hdr.ObjName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitCmnBck(&hdr.Bck); err != nil {
+		nlog.Errorln(err)
+		return nil
+	}
+	var (
+		id        = strconv.FormatInt(int64(binary.BigEndian.Uint64(hdr.Opaque[regOpaqueSize:])), 10)
+		buf, slab = core.T.PageMM().Alloc()
+		err       error
+	)
+	if kind := hdr.Opaque[0]; kind == rebMsgSnap {
+		err = lom.RecvPreserved(id, &hdr.ObjAttrs, objReader, buf)
+	} else {
+		err = lom.RecvVersion(id, kind == rebMsgVerDel, &hdr.ObjAttrs, objReader, buf)
+	}
+	slab.Free(buf)
+	if err != nil {
+		nlog.Errorf("%s g[%d]: failed to receive %s preserved content: %v", core.T, reb.rebID(), lom.Cname(), err)
+	}
+	return err
+}
@@ -79,6 +79,21 @@ func (reb *Reb) recvObj(hdr *transport.ObjHdr, objReader io.Reader, err error) e
 		return nil
 	}
 
+	if kind := hdr.Opaque[0]; kind == rebMsgSnap || kind == rebMsgVer || kind == rebMsgVerDel {
+		if len(hdr.Opaque) != snapOpaqueSize {
+			err := fmt.Errorf("g[%d]: invalid opaque len=%d (expecting %d)", rebID, len(hdr.Opaque), snapOpaqueSize)
+			debug.AssertNoErr(err)
+			return reb._recvAbrt(err, xreb)
+		}
+		if senderRebID := int64(binary.BigEndian.Uint64(hdr.Opaque[1:])); senderRebID != rebID {
+			return nil
+		}
+		if err := reb.recvPreserved(hdr, objReader); err != nil {
+			return reb._recvAbrt(err, xreb)
+		}
+		return nil
+	}
+
 	unpacker := cos.NewUnpacker(hdr.Opaque)
 	act, err := unpacker.ReadByte()
 	if err != nil {
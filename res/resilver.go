@@ -133,9 +133,9 @@ func (res *Res) Run(args *Args, tstats cos.StatsUpdater) {
 		j         = &jogger{p: res, xres: xres, avail: avail}
 		opts      = &mpather.JgroupOpts{
 			Parent:   xres,
-			CTs:      []string{fs.ObjCT, fs.ECSliceCT},
+			CTs:      []string{fs.ObjCT, fs.ECSliceCT, fs.ObjSnapCT, fs.ObjVerCT},
 			VisitObj: j.visitObj,
-			VisitCT:  j.visitECSlice,
+			VisitCT:  j.visitCT,
 			Slab:     slab,
 			RW:       true,
 		}
@@ -504,6 +504,25 @@ func (j *jogger) fixCopies(hlom *core.LOM, buf []byte) (abortErr error) {
 	return nil
 }
 
+func (j *jogger) visitCT(ct *core.CT, buf []byte) error {
+	if ct.ContentType() == fs.ObjSnapCT || ct.ContentType() == fs.ObjVerCT {
+		return j.visitPreserved(ct, buf)
+	}
+	return j.visitECSlice(ct, buf)
+}
+
+// content preserved for bucket snapshots and retained versions follow the object
+// (see core/lsnap and core/lversion)
+func (j *jogger) visitPreserved(ct *core.CT, buf []byte) error {
+	if j.xres.IsAborted() {
+		return nil
+	}
+	if err := core.RelocatePreserved(ct, buf); err != nil {
+		j.xres.AddErr(fmt.Errorf("%s: failed to relocate %s: %v", j.xres.Name(), ct.FQN(), err), 0)
+	}
+	return nil
+}
+
 func (j *jogger) visitECSlice(ct *core.CT, buf []byte) (err error) {
 	debug.Assert(ct.ContentType() == fs.ECSliceCT)
 	if !ct.Bck().Props.EC.Enabled {
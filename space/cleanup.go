@@ -503,7 +503,7 @@ func (j *clnJ) _jogBck() {
 	opts := &fs.WalkOpts{
 		Mi:       j.mi,
 		Bck:      j.bck,
-		CTs:      []string{fs.WorkCT, fs.ObjCT, fs.ECSliceCT, fs.ECMetaCT, fs.ChunkCT, fs.ChunkMetaCT},
+		CTs:      []string{fs.WorkCT, fs.ObjCT, fs.ECSliceCT, fs.ECMetaCT, fs.ChunkCT, fs.ChunkMetaCT, fs.ObjVerCT, fs.ObjSnapCT},
 		Callback: j.visit,
 		Sorted:   false,
 	}
@@ -703,6 +703,52 @@ func (j *clnJ) visitCT(parsed *fs.ParsedFQN, fqn string) {
 			j.rmAnyBatch(flagRmOldWork)
 		}
 
+	// object version history:
+	// - remove all retained versions and delete markers when disabled
+	// - otherwise, remove those superseded more than `keep_days` ago
+	case fs.ObjVerCT:
+		contentInfo := fs.CSM.ParseUbase(parsed.ObjName, fs.ObjVerCT)
+		if !contentInfo.Ok {
+			j.rmInvalidFQN(fqn, "object-version", nil)
+			return
+		}
+		vconf := &j.bck.Props.Versioning
+		if !vconf.KeepHistory() {
+			j.appendOldWork(fqn)
+			j.rmAnyBatch(flagRmOldWork)
+			return
+		}
+		if vconf.KeepDays == 0 {
+			return
+		}
+		finfo, err := os.Lstat(fqn)
+		if err != nil {
+			return
+		}
+		lom := core.AllocLOM(contentInfo.Base)
+		if j.initCTLOM(lom, fqn) == nil {
+			if lom.SupersededAt(fqn, finfo).Add(time.Duration(vconf.KeepDays) * 24 * time.Hour).Before(j.now) {
+				j.appendOldWork(fqn)
+				j.rmAnyBatch(flagRmOldWork)
+			}
+		}
+		core.FreeLOM(lom)
+
+	// bucket snapshots: remove content preserved for destroyed snapshots
+	case fs.ObjSnapCT:
+		contentInfo := fs.CSM.ParseUbase(parsed.ObjName, fs.ObjSnapCT)
+		if !contentInfo.Ok {
+			j.rmInvalidFQN(fqn, "snapshot", nil)
+			return
+		}
+		for i := range j.bck.Props.Snapshots {
+			if j.bck.Props.Snapshots[i].ID() == contentInfo.Extras[0] {
+				return
+			}
+		}
+		j.appendOldWork(fqn)
+		j.rmAnyBatch(flagRmOldWork)
+
 	default:
 		debug.Assert(false, "Unsupported content type: ", parsed.ContentType)
 	}
@@ -878,12 +924,17 @@ func (j *clnJ) visitObj(fqn string, lom *core.LOM) {
 	}
 	xcln.stats.visits.Add(1)
 
+	// object lock (WORM): never remove retained objects (zero-size and misplaced included)
+	worm := lom.Bprops().ObjectLock.Enabled && lom.IsLockedWORM()
+	// ditto objects pending write-back (see core/lwback)
+	wback := lom.WbPending()
+
 	switch {
 	case lom.IsHRW():
 		if lom.HasCopies() {
 			j.rmExtraCopies(lom)
 		}
-		if lom.Lsize() == 0 && j.rmZeroSize() {
+		if lom.Lsize() == 0 && j.rmZeroSize() && !worm && !wback {
 			// remove in place
 			if err := lom.RemoveMain(); err != nil {
 				e := fmt.Errorf("%s rm zero-size %s: %v", j, lom, err)
@@ -895,7 +946,7 @@ func (j *clnJ) visitObj(fqn string, lom *core.LOM) {
 			}
 			return
 		}
-		if j.peerHasIdentical(lom) {
+		if !wback && j.peerHasIdentical(lom) {
 			lom = lom.Clone()
 			j.misplaced.loms = append(j.misplaced.loms, lom)
 			j.rmAnyBatch(flagRmMisplacedLOMs)
@@ -919,6 +970,12 @@ func (j *clnJ) visitObj(fqn string, lom *core.LOM) {
 		if lom.Lsize() == 0 && j.rmZeroSize() {
 			tag, keep = "removing", false
 		}
+		if worm {
+			tag, keep = "keeping (object lock)", true
+		}
+		if wback {
+			tag, keep = "keeping (pending write-back)", true
+		}
 
 		if j.nmisplc%sparseLogCnt == 1 || cmn.Rom.V(4, cos.ModSpace) {
 			nlog.Warningln(j.String(), tag, "misplaced object:", lom.Cname(), j.nmisplc)
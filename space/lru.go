@@ -360,6 +360,14 @@ func (j *lruJ) _visit(lom *core.LOM, parsedFQN *fs.ParsedFQN) (pushed bool) {
 	if lom.HasCopies() && lom.IsCopy() {
 		return false
 	}
+	// object lock (WORM): never evict retained objects
+	if lom.Bprops().ObjectLock.Enabled && lom.IsLockedWORM() {
+		return false
+	}
+	// write-back: not yet uploaded to remote backend
+	if lom.WbPending() {
+		return false
+	}
 
 	hlen := int64(j.heap.Len())
 	if lom.AtimeUnix() > j.newest {
@@ -464,6 +472,10 @@ func (j *lruJ) capCheckAndThrottle(size int64) {
 // remove local copies that "belong" to different LRU joggers (space accounting may be temporarily not precise)
 func (j *lruJ) evictObj(lom *core.LOM) bool {
 	lom.Lock(true)
+	if lom.WbPending() { // (re)written in the meantime
+		lom.Unlock(true)
+		return false
+	}
 	err := lom.RemoveObj()
 	lom.Unlock(true)
 	if err != nil {
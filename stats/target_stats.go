@@ -144,6 +144,18 @@ const (
 
 	PrefetchBlobRejCount = "prefetch.blob.rejected.n"
 	ErrPrefetchCount     = errPrefix + "prefetch.n"
+
+	// write-back (delayed data write policy)
+	WritebackPendingCount = core.WritebackPendingCount
+	WritebackCount        = "wb.n"
+	WritebackSize         = "wb.size"
+	ErrWritebackCount     = errPrefix + "wb.n"
+
+	// bucket event notifications
+	EventCount     = "event.n"
+	EventSentCount = "event.sent.n"
+	EventDropCount = "event.drop.n"
+	ErrEventCount  = errPrefix + "event.n"
 )
 
 // 4, streams (peer-to-peer long-lived connections)
@@ -152,6 +164,14 @@ const (
 	_ = cos.StreamsOutObjSize
 	_ = cos.StreamsInObjCount
 	_ = cos.StreamsInObjSize
+
+	_ = cos.StreamsOutLZ4Size
+	_ = cos.StreamsOutLZ4CmprSize
+	_ = cos.StreamsOutLZ4Total
+	_ = cos.StreamsOutZstdSize
+	_ = cos.StreamsOutZstdCmprSize
+	_ = cos.StreamsOutZstdTotal
+	_ = cos.StreamsOutSkipSize
 )
 
 // variable label used for prometheus disk metrics
@@ -484,6 +504,41 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 			Help: "intra-cluster streaming communications: total cumulative size (bytes) of all received objects",
 		},
 	)
+	r.reg(snode, cos.StreamsOutLZ4Size, KindSize,
+		&Extra{
+			Help: "intra-cluster streaming communications: total size (bytes) of uncompressed data passed to lz4",
+		},
+	)
+	r.reg(snode, cos.StreamsOutLZ4CmprSize, KindSize,
+		&Extra{
+			Help: "intra-cluster streaming communications: total size (bytes) of lz4-compressed data sent",
+		},
+	)
+	r.reg(snode, cos.StreamsOutLZ4Total, KindTotal,
+		&Extra{
+			Help: "intra-cluster streaming communications: total lz4 compression time (nanoseconds)",
+		},
+	)
+	r.reg(snode, cos.StreamsOutZstdSize, KindSize,
+		&Extra{
+			Help: "intra-cluster streaming communications: total size (bytes) of uncompressed data passed to zstd",
+		},
+	)
+	r.reg(snode, cos.StreamsOutZstdCmprSize, KindSize,
+		&Extra{
+			Help: "intra-cluster streaming communications: total size (bytes) of zstd-compressed data sent",
+		},
+	)
+	r.reg(snode, cos.StreamsOutZstdTotal, KindTotal,
+		&Extra{
+			Help: "intra-cluster streaming communications: total zstd compression time (nanoseconds)",
+		},
+	)
+	r.reg(snode, cos.StreamsOutSkipSize, KindSize,
+		&Extra{
+			Help: "intra-cluster streaming communications: total size (bytes) sent uncompressed by compression 'auto' mode",
+		},
+	)
 
 	// downloader (ext/dload)
 	r.reg(snode, DloadSize, KindSize,
@@ -690,6 +745,50 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 			VarLabs: BckXlabs,
 		},
 	)
+
+	// write-back
+	r.reg(snode, WritebackPendingCount, KindGauge,
+		&Extra{
+			Help: "write-back: current number of locally written objects pending upload to remote backend",
+		},
+	)
+	r.reg(snode, WritebackCount, KindCounter,
+		&Extra{
+			Help: "write-back: total number of objects uploaded to remote backend (delayed data write policy)",
+		},
+	)
+	r.reg(snode, WritebackSize, KindSize,
+		&Extra{
+			Help: "write-back: total cumulative size (bytes) of objects uploaded to remote backend",
+		},
+	)
+	r.reg(snode, ErrWritebackCount, KindCounter,
+		&Extra{
+			Help: "write-back: total number of failed uploads (to be retried)",
+		},
+	)
+
+	// bucket event notifications
+	r.reg(snode, EventCount, KindCounter,
+		&Extra{
+			Help: "bucket event notifications: total number of events queued for delivery (webhook) or consumption (local queue)",
+		},
+	)
+	r.reg(snode, EventSentCount, KindCounter,
+		&Extra{
+			Help: "bucket event notifications: total number of events delivered to webhooks",
+		},
+	)
+	r.reg(snode, EventDropCount, KindCounter,
+		&Extra{
+			Help: "bucket event notifications: total number of pending events dropped upon exceeding max backlog",
+		},
+	)
+	r.reg(snode, ErrEventCount, KindCounter,
+		&Extra{
+			Help: "bucket event notifications: total number of failures to queue or deliver (to be retried) events",
+		},
+	)
 }
 
 func (r *Trunner) RegDiskMetrics(snode *meta.Snode, disk string) {
@@ -67,12 +67,12 @@ func (s *base) doPlain(body io.Reader) (err error) {
 	return err
 }
 
-func (s *base) doCmpr(body io.Reader) (err error) {
+func (s *base) doCmpr(body io.Reader, compression string) (err error) {
 	var (
 		req  = fasthttp.AcquireRequest()
 		resp = fasthttp.AcquireResponse()
 	)
-	req.Header.Set(apc.HdrCompress, apc.LZ4Compression)
+	req.Header.Set(apc.HdrCompress, compression)
 
 	err = s._do(body, req, resp)
 
@@ -52,12 +52,12 @@ func (s *base) doPlain(body io.Reader) error {
 	return s._do(req)
 }
 
-func (s *base) doCmpr(body io.Reader) error {
+func (s *base) doCmpr(body io.Reader, compression string) error {
 	req, err := http.NewRequestWithContext(context.Background(), http.MethodPut, s.dstURL, body)
 	if err != nil {
 		return err
 	}
-	req.Header.Set(apc.HdrCompress, apc.LZ4Compression)
+	req.Header.Set(apc.HdrCompress, compression)
 	err = s._do(req)
 	s.streamer.resetCompression()
 	return err
@@ -0,0 +1,470 @@
+// Package transport provides long-lived http/tcp connections for intra-cluster communications
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package transport
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/memsys"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+)
+
+// Compressed streams (Extra.Compression):
+// - "always": the entire stream (object headers and data) is compressed with the configured
+//   codec (config.Transport.Codec: lz4 or zstd)
+// - "auto": the stream is split into frames of up to `frameSize` bytes, each independently
+//   compressed or sent as is;
+//   the first frame of each object is a sample: when the codec does not reduce its size
+//   by at least (100 - frameMaxPct)%, the next `frameSkip` frames of the same object
+//   are sent uncompressed (that is, without spending CPU on incompressible payloads)
+//
+// auto-mode frame on the wire:
+// [codec (1 byte)] [uncompressed length (4 bytes)] [payload length (4 bytes)] [payload]
+
+const (
+	frameSize    = 64 * cos.KiB
+	frameHdrSize = 9
+	frameMinSize = 512 // smaller frames (e.g., header-only objects) are not worth compressing
+	frameMaxPct  = 90  // compressed/uncompressed (%) - incompressible when exceeded
+	frameSkip    = 16  // frames to send as is upon detecting incompressible payload
+)
+
+// frame codecs
+const (
+	frameRaw = iota
+	frameLZ4
+	frameZstd
+)
+
+type (
+	cmprStream struct {
+		s     *Stream
+		sgl   *memsys.SGL // zw or frames => sgl => network
+		zw    cmprWriter  // stream compressor (nil in auto mode)
+		lz4w  *lz4.Writer
+		zenc  *zstd.Encoder
+		frame *framer // auto mode
+		wire  string  // apc.HdrCompress value
+		codec string  // lz4 | zstd
+		stats cmprStats
+		// lz4
+		blockMaxSize  int  // *uncompressed* block max size
+		frameChecksum bool // true: checksum lz4 frames
+	}
+	cmprWriter interface {
+		io.Writer
+		Flush() error
+		Reset(io.Writer)
+	}
+	framer struct {
+		raw  []byte // uncompressed (and not yet sent) bytes
+		cbuf []byte // compressed frame
+		ht   []int  // lz4 hash table
+		skip int    // remaining frames to send as is
+	}
+	cmprStats struct {
+		size  int64 // passed to the codec
+		csize int64 // resulting (wire) size
+		ns    int64 // compression time
+		skip  int64 // auto mode: sent as is
+	}
+
+	// Rx: auto-mode frames
+	frameReader struct {
+		r    io.Reader
+		zdec *zstd.Decoder
+		buf  []byte // decoded frame
+		cbuf []byte // compressed payload
+		off  int
+		n    int
+		hdr  [frameHdrSize]byte
+	}
+)
+
+////////////////
+// cmprStream //
+////////////////
+
+func newCmprStream(s *Stream, extra *Extra) (cs *cmprStream) {
+	config := extra.Config
+	cs = &cmprStream{
+		s:             s,
+		codec:         cos.NonZero(config.Transport.Codec, apc.LZ4Compression),
+		blockMaxSize:  int(config.Transport.LZ4BlockMaxSize),
+		frameChecksum: config.Transport.LZ4FrameChecksum,
+	}
+	switch {
+	case extra.Compression == apc.CompressAuto:
+		cs.wire = apc.AutoCompression
+		cs.frame = &framer{
+			raw:  make([]byte, 0, frameSize),
+			cbuf: make([]byte, 0, lz4.CompressBlockBound(frameSize)),
+		}
+		cs.sgl = g.mm.NewSGL(cos.KiB*64, cos.KiB*64)
+	case cs.codec == apc.ZstdCompression:
+		cs.wire = apc.ZstdCompression
+		cs.sgl = g.mm.NewSGL(memsys.MaxPageSlabSize, memsys.MaxPageSlabSize)
+	default:
+		cs.wire = apc.LZ4Compression
+		if cs.blockMaxSize >= memsys.MaxPageSlabSize {
+			cs.sgl = g.mm.NewSGL(memsys.MaxPageSlabSize, memsys.MaxPageSlabSize)
+		} else {
+			cs.sgl = g.mm.NewSGL(cos.KiB*64, cos.KiB*64)
+		}
+	}
+	if cs.codec == apc.ZstdCompression {
+		var err error
+		cs.zenc, err = zstd.NewWriter(nil,
+			zstd.WithEncoderConcurrency(1),
+			zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(cos.NonZero(config.Transport.ZstdLevel, 1))),
+			zstd.WithLowerEncoderMem(true),
+		)
+		debug.AssertNoErr(err)
+	}
+	return cs
+}
+
+// (re)start upon each new request
+func (cs *cmprStream) reset() {
+	cs.sgl.Reset()
+	if cs.frame != nil {
+		cs.frame.raw = cs.frame.raw[:0]
+		cs.frame.skip = 0
+		return
+	}
+	if cs.codec == apc.ZstdCompression {
+		cs.zenc.Reset(cs.sgl)
+		cs.zw = cs.zenc
+		return
+	}
+	if cs.lz4w == nil {
+		cs.lz4w = lz4.NewWriter(cs.sgl)
+	} else {
+		cs.lz4w.Reset(cs.sgl)
+	}
+	err := cs.lz4w.Apply(
+		lz4.BlockChecksumOption(false),
+		lz4.ChecksumOption(cs.frameChecksum),
+		lz4.BlockSizeOption(lz4.BlockSize(cs.blockMaxSize)),
+	)
+	debug.AssertNoErr(err)
+	cs.zw = cs.lz4w
+}
+
+// upon request completion
+func (cs *cmprStream) release() {
+	cs.sgl.Reset()
+	if cs.zw != nil {
+		cs.zw.Reset(nil)
+	}
+	cs.publish()
+}
+
+func (cs *cmprStream) free() {
+	cs.sgl.Free()
+	if cs.zw != nil {
+		cs.zw.Reset(nil)
+	}
+	if cs.zenc != nil {
+		cs.zenc.Close()
+	}
+}
+
+func (cs *cmprStream) Read(b []byte) (n int, err error) {
+	if cs.frame != nil {
+		return cs.readFrames(b)
+	}
+	return cs.readStream(b)
+}
+
+// "always": stream compression
+func (cs *cmprStream) readStream(b []byte) (n int, err error) {
+	var (
+		sendoff = &cs.s.sendoff
+		last    = sendoff.obj.Hdr.isFin()
+		retry   = maxInReadRetries // insist on returning n > 0 (note that lz4 and zstd compress /blocks/)
+	)
+	if cs.sgl.Len() > 0 {
+		cs.flush()
+		n, err = cs.sgl.Read(b)
+		if err == io.EOF { // reusing/rewinding this buf multiple times
+			err = nil
+		}
+		goto ex
+	}
+re:
+	n, err = cs.s.Read(b)
+	cs.write(b[:n])
+	if last {
+		cs.flush()
+		retry = 0
+	} else if cs.s.sendoff.ins == inEOB || err != nil {
+		cs.flush()
+		cs.publish()
+		retry = 0
+	}
+	n, _ = cs.sgl.Read(b)
+	if n == 0 {
+		if retry > 0 {
+			retry--
+			runtime.Gosched()
+			goto re
+		}
+		cs.flush()
+		n, _ = cs.sgl.Read(b)
+	}
+ex:
+	if cs.sgl.Len() == 0 {
+		cs.sgl.Reset()
+	}
+	if last && err == nil {
+		err = io.EOF
+	}
+	return n, err
+}
+
+func (cs *cmprStream) write(p []byte) {
+	if len(p) == 0 {
+		return
+	}
+	var (
+		started = mono.NanoTime()
+		size    = cs.sgl.Size()
+	)
+	_, _ = cs.zw.Write(p)
+	cs.stats.ns += mono.SinceNano(started)
+	cs.stats.size += int64(len(p))
+	cs.stats.csize += cs.sgl.Size() - size
+}
+
+func (cs *cmprStream) flush() {
+	var (
+		started = mono.NanoTime()
+		size    = cs.sgl.Size()
+	)
+	cs.zw.Flush()
+	cs.stats.ns += mono.SinceNano(started)
+	cs.stats.csize += cs.sgl.Size() - size
+}
+
+// "auto": frames
+func (cs *cmprStream) readFrames(b []byte) (n int, err error) {
+	var (
+		sendoff = &cs.s.sendoff
+		last    = sendoff.obj.Hdr.isFin()
+		fr      = cs.frame
+	)
+	if cs.sgl.Len() > 0 {
+		n, err = cs.sgl.Read(b)
+		if err == io.EOF {
+			err = nil
+		}
+		goto ex
+	}
+	for {
+		var nr int
+		nr, err = cs.s.Read(b[:min(len(b), frameSize-len(fr.raw))])
+		fr.raw = append(fr.raw, b[:nr]...)
+		switch {
+		case last || err != nil:
+			cs.addFrame()
+			cs.publish()
+		case cs.s.sendoff.ins == inEOB:
+			cs.addFrame()
+			cs.publish()
+			fr.skip = 0 // next object: sample again
+		case len(fr.raw) >= frameSize:
+			cs.addFrame()
+		}
+		if cs.sgl.Len() > 0 || last || err != nil {
+			break
+		}
+	}
+	n, _ = cs.sgl.Read(b)
+ex:
+	if cs.sgl.Len() == 0 {
+		cs.sgl.Reset()
+	}
+	if last && err == nil {
+		err = io.EOF
+	}
+	return n, err
+}
+
+func (cs *cmprStream) addFrame() {
+	var (
+		fr    = cs.frame
+		raw   = fr.raw
+		codec = frameRaw
+		out   = raw
+	)
+	if len(raw) == 0 {
+		return
+	}
+	if fr.skip > 0 || len(raw) < frameMinSize {
+		fr.skip = max(fr.skip-1, 0)
+		cs.stats.skip += int64(len(raw))
+	} else {
+		started := mono.NanoTime()
+		cbuf := cs.compress(raw)
+		cs.stats.ns += mono.SinceNano(started)
+		cs.stats.size += int64(len(raw))
+		if len(cbuf) > 0 && len(cbuf)*100 <= len(raw)*frameMaxPct {
+			out = cbuf
+			codec = cos.Ternary(cs.codec == apc.ZstdCompression, frameZstd, frameLZ4)
+		} else {
+			fr.skip = frameSkip // incompressible
+		}
+		cs.stats.csize += int64(len(out))
+	}
+	var hdr [frameHdrSize]byte
+	hdr[0] = byte(codec)
+	binary.BigEndian.PutUint32(hdr[1:], uint32(len(raw)))
+	binary.BigEndian.PutUint32(hdr[5:], uint32(len(out)))
+	cs.sgl.Write(hdr[:])
+	cs.sgl.Write(out)
+	fr.raw = fr.raw[:0]
+}
+
+// returns empty when the codec fails to compress
+func (cs *cmprStream) compress(raw []byte) []byte {
+	fr := cs.frame
+	if cs.codec == apc.ZstdCompression {
+		fr.cbuf = cs.zenc.EncodeAll(raw, fr.cbuf[:0])
+		return fr.cbuf
+	}
+	if fr.ht == nil {
+		fr.ht = make([]int, 1<<16)
+	}
+	n, err := lz4.CompressBlock(raw, fr.cbuf[:cap(fr.cbuf)], fr.ht)
+	if err != nil {
+		return nil
+	}
+	return fr.cbuf[:n]
+}
+
+func (cs *cmprStream) publish() {
+	st := &cs.stats
+	if st.size == 0 && st.skip == 0 {
+		return
+	}
+	if st.size > 0 {
+		if cs.codec == apc.ZstdCompression {
+			g.tstats.Add(cos.StreamsOutZstdSize, st.size)
+			g.tstats.Add(cos.StreamsOutZstdCmprSize, st.csize)
+			g.tstats.Add(cos.StreamsOutZstdTotal, st.ns)
+		} else {
+			g.tstats.Add(cos.StreamsOutLZ4Size, st.size)
+			g.tstats.Add(cos.StreamsOutLZ4CmprSize, st.csize)
+			g.tstats.Add(cos.StreamsOutLZ4Total, st.ns)
+		}
+	}
+	if st.skip > 0 {
+		g.tstats.Add(cos.StreamsOutSkipSize, st.skip)
+	}
+	*st = cmprStats{}
+}
+
+/////////////////
+// frameReader //
+/////////////////
+
+func newFrameReader(r io.Reader, mm *memsys.MMSA) (fr *frameReader) {
+	fr = &frameReader{r: r}
+	fr.buf, _ = mm.AllocSize(frameSize)
+	fr.cbuf, _ = mm.AllocSize(int64(lz4.CompressBlockBound(frameSize)))
+	return fr
+}
+
+func (fr *frameReader) free(mm *memsys.MMSA) {
+	mm.Free(fr.buf)
+	mm.Free(fr.cbuf)
+	if fr.zdec != nil {
+		fr.zdec.Close()
+	}
+}
+
+func (fr *frameReader) Read(b []byte) (n int, err error) {
+	for fr.off == fr.n {
+		if err = fr.next(); err != nil {
+			return 0, err
+		}
+	}
+	n = copy(b, fr.buf[fr.off:fr.n])
+	fr.off += n
+	return n, nil
+}
+
+func (fr *frameReader) next() error {
+	if _, err := io.ReadFull(fr.r, fr.hdr[:]); err != nil {
+		return err // io.EOF iff between frames
+	}
+	var (
+		codec = fr.hdr[0]
+		rlen  = int(binary.BigEndian.Uint32(fr.hdr[1:]))
+		plen  = int(binary.BigEndian.Uint32(fr.hdr[5:]))
+	)
+	if rlen > len(fr.buf) || plen > len(fr.cbuf) || (codec == frameRaw && plen != rlen) {
+		return fmt.Errorf("invalid compression frame: codec %d, length %d, payload %d", codec, rlen, plen)
+	}
+	if codec == frameRaw {
+		if _, err := io.ReadFull(fr.r, fr.buf[:rlen]); err != nil {
+			return _unexpected(err)
+		}
+		fr.off, fr.n = 0, rlen
+		return nil
+	}
+
+	cbuf := fr.cbuf[:plen]
+	if _, err := io.ReadFull(fr.r, cbuf); err != nil {
+		return _unexpected(err)
+	}
+	var (
+		n   int
+		err error
+	)
+	switch codec {
+	case frameLZ4:
+		n, err = lz4.UncompressBlock(cbuf, fr.buf[:rlen])
+	case frameZstd:
+		if fr.zdec == nil {
+			fr.zdec, err = zstd.NewReader(nil, zstd.WithDecoderConcurrency(1), zstd.WithDecoderLowmem(true))
+			debug.AssertNoErr(err)
+		}
+		var out []byte
+		out, err = fr.zdec.DecodeAll(cbuf, fr.buf[:0])
+		n = len(out)
+		if err == nil && n > 0 && &out[0] != &fr.buf[0] {
+			err = errors.New("decoded frame exceeds buffer")
+		}
+	default:
+		err = errors.New("unknown codec")
+	}
+	if err == nil && n != rlen {
+		err = fmt.Errorf("decoded %d != %d", n, rlen)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to decompress frame (codec %d): %w", codec, err)
+	}
+	fr.off, fr.n = 0, rlen
+	return nil
+}
+
+func _unexpected(err error) error {
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -489,6 +489,95 @@ func TestCompressedOne(t *testing.T) {
 	tlog.Logf("Compressed stream test: sent %d objects (%d header-only) totaling %d GiB\n", num, numhdr, size/cos.GiB)
 }
 
+// zstd and lz4, "always" and "auto": compressible, incompressible, and header-only objects
+func TestCompressedCodecs(t *testing.T) {
+	tests := []struct {
+		compression string
+		codec       string
+		usePDU      bool
+	}{
+		{apc.CompressAlways, apc.LZ4Compression, false},
+		{apc.CompressAlways, apc.ZstdCompression, false},
+		{apc.CompressAlways, apc.ZstdCompression, true},
+		{apc.CompressAuto, apc.LZ4Compression, false},
+		{apc.CompressAuto, apc.ZstdCompression, false},
+		{apc.CompressAuto, apc.ZstdCompression, true},
+	}
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	for i, test := range tests {
+		name := fmt.Sprintf("%s-%s-pdu=%t", test.compression, test.codec, test.usePDU)
+		t.Run(name, func(t *testing.T) {
+			config := cmn.GCO.BeginUpdate()
+			config.Transport.Codec = test.codec
+			config.Transport.ZstdLevel = 3
+			cmn.GCO.CommitUpdate(config)
+			tassert.CheckFatal(t, config.Transport.Validate())
+
+			var (
+				random   = newRand(mono.NanoTime())
+				objs     = make(map[string][]byte, 64)
+				received atomic.Int64
+				trname   = "cmpr-codecs-" + strconv.Itoa(i)
+			)
+			for j := range 64 {
+				var data []byte
+				switch j % 4 {
+				case 0: // header-only
+				case 1: // incompressible
+					data = make([]byte, random.IntN(4*cos.MiB)+1)
+					_, _ = cryptorand.Read(data)
+				default: // compressible
+					data = []byte(strings.Repeat(text, random.IntN(8*cos.KiB)+1))
+				}
+				objs["obj-"+strconv.Itoa(j)] = data
+			}
+			recv := func(hdr *transport.ObjHdr, objReader io.Reader, err error) error {
+				if err != nil && !cos.IsOkEOF(err) {
+					return err
+				}
+				data, err := io.ReadAll(objReader)
+				if err != nil {
+					return err
+				}
+				if exp := objs[hdr.ObjName]; !reflect.DeepEqual(data, exp) && len(data)+len(exp) > 0 {
+					t.Errorf("%s: received %d bytes differ from the %d sent", hdr.ObjName, len(data), len(exp))
+				}
+				received.Inc()
+				return nil
+			}
+			tassert.CheckFatal(t, transport.Handle(trname, recv))
+			defer transport.Unhandle(trname)
+
+			extra := &transport.Extra{Config: cmn.GCO.Get(), Compression: test.compression}
+			if test.usePDU {
+				extra.SizePDU = memsys.DefaultBufSize
+			}
+			url := ts.URL + transport.ObjURLPath(trname)
+			stream := transport.NewObjStream(transport.NewIntraDataClient(), url, cos.GenTie(), extra)
+			for oname, data := range objs {
+				hdr := transport.ObjHdr{Bck: cmn.Bck{Name: "abc", Provider: apc.AIS}, ObjName: oname}
+				hdr.ObjAttrs.Size = int64(len(data))
+				if len(data) == 0 {
+					stream.Send(&transport.Obj{Hdr: hdr})
+					continue
+				}
+				if test.usePDU {
+					hdr.ObjAttrs.Size = transport.SizeUnknown
+				}
+				stream.Send(&transport.Obj{Hdr: hdr, Reader: io.NopCloser(strings.NewReader(string(data)))})
+			}
+			stream.Fin()
+			tassert.Errorf(t, received.Load() == int64(len(objs)), "received %d objects, expected %d", received.Load(), len(objs))
+		})
+	}
+
+	config := cmn.GCO.BeginUpdate()
+	config.Transport.Codec = apc.LZ4Compression
+	cmn.GCO.CommitUpdate(config)
+}
+
 // TODO: Skip unmaintained dry-run test to reduce test runtime (revisit)
 func TestDryRun(t *testing.T) {
 	t.Skipf("skipping %s", t.Name())
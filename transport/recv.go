@@ -23,6 +23,7 @@ import (
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/memsys"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/pierrec/lz4/v4"
 )
 
@@ -64,6 +65,8 @@ func RxAnyStream(w http.ResponseWriter, r *http.Request) {
 	var (
 		reader    io.Reader = r.Body
 		lz4Reader *lz4.Reader
+		zdec      *zstd.Decoder
+		frames    *frameReader
 		trname    = path.Base(r.URL.Path)
 		mm        = memsys.PageMM()
 	)
@@ -103,10 +106,25 @@ func RxAnyStream(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// compression
-	if compressionType := r.Header.Get(apc.HdrCompress); compressionType != "" {
-		debug.Assert(compressionType == apc.LZ4Compression)
+	compressionType := r.Header.Get(apc.HdrCompress)
+	switch compressionType {
+	case "":
+	case apc.LZ4Compression:
 		lz4Reader = lz4.NewReader(r.Body)
 		reader = lz4Reader
+	case apc.ZstdCompression:
+		zdec, err = zstd.NewReader(r.Body, zstd.WithDecoderConcurrency(1), zstd.WithDecoderLowmem(true))
+		if err != nil {
+			cmn.WriteErr(w, r, err)
+			return
+		}
+		reader = zdec
+	case apc.AutoCompression:
+		frames = newFrameReader(r.Body, mm)
+		reader = frames
+	default:
+		cmn.WriteErr(w, r, fmt.Errorf("%s: unsupported compression %q", trname, compressionType), http.StatusBadRequest)
+		return
 	}
 
 	var (
@@ -121,12 +139,17 @@ func RxAnyStream(w http.ResponseWriter, r *http.Request) {
 	it.hbuf, _ = mm.AllocSize(_sizeHdr(config, 0))
 
 	// receive loop (until eof or error)
-	it.loghdr = _loghdr(h.trname, it.sid, core.T.SID(), false /*transmit*/, lz4Reader != nil)
+	it.loghdr = _loghdr(h.trname, it.sid, core.T.SID(), false /*transmit*/, compressionType != "")
 	err = it.rxloop(mm)
 
 	// cleanup
-	if lz4Reader != nil {
+	switch {
+	case lz4Reader != nil:
 		lz4Reader.Reset(nil)
+	case zdec != nil:
+		zdec.Close()
+	case frames != nil:
+		frames.free(mm)
 	}
 	if it.pdu != nil {
 		it.pdu.free(mm)
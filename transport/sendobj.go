@@ -7,35 +7,24 @@ package transport
 import (
 	"fmt"
 	"io"
-	"runtime"
 
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
-	"github.com/NVIDIA/aistore/memsys"
-
-	"github.com/pierrec/lz4/v4"
 )
 
 // object stream & private types
 type (
 	Stream struct {
-		workCh  chan *Obj // send queue (SQ): next object to stream
-		cmplCh  chan cmpl // SCQ (note: SQ and SCQ form a FIFO)
-		sentCB  SentCB    // to free SGLs, close files, etc. cleanup
-		lz4s    *lz4Stream
+		workCh  chan *Obj   // send queue (SQ): next object to stream
+		cmplCh  chan cmpl   // SCQ (note: SQ and SCQ form a FIFO)
+		sentCB  SentCB      // to free SGLs, close files, etc. cleanup
+		cmpr    *cmprStream // see cmpr.go
 		sendoff sendoff
 		base
 		chanFull cos.ChanFull
 	}
-	lz4Stream struct {
-		s             *Stream
-		zw            *lz4.Writer // orig reader => zw
-		sgl           *memsys.SGL // zw => bb => network
-		blockMaxSize  int         // *uncompressed* block max size
-		frameChecksum bool        // true: checksum lz4 frames
-	}
 	sendoff struct {
 		obj Obj
 		off int64
@@ -75,33 +64,17 @@ func (s *Stream) terminate(err error) (actErr error) {
 	gc.remove(&s.base)
 
 	if s.compressed() {
-		s.lz4s.sgl.Free()
-		if s.lz4s.zw != nil {
-			s.lz4s.zw.Reset(nil)
-		}
+		s.cmpr.free()
 	}
 	return actErr
 }
 
-func (s *Stream) initCompression(extra *Extra) {
-	s.lz4s = &lz4Stream{}
-	s.lz4s.s = s
-	s.lz4s.blockMaxSize = int(extra.Config.Transport.LZ4BlockMaxSize)
-	s.lz4s.frameChecksum = extra.Config.Transport.LZ4FrameChecksum
-	if s.lz4s.blockMaxSize >= memsys.MaxPageSlabSize {
-		s.lz4s.sgl = g.mm.NewSGL(memsys.MaxPageSlabSize, memsys.MaxPageSlabSize)
-	} else {
-		s.lz4s.sgl = g.mm.NewSGL(cos.KiB*64, cos.KiB*64)
-	}
-}
+func (s *Stream) initCompression(extra *Extra) { s.cmpr = newCmprStream(s, extra) }
 
-func (s *Stream) compressed() bool { return s.lz4s != nil }
+func (s *Stream) compressed() bool { return s.cmpr != nil }
 func (s *Stream) usePDU() bool     { return s.pdu != nil }
 
-func (s *Stream) resetCompression() {
-	s.lz4s.sgl.Reset()
-	s.lz4s.zw.Reset(nil)
-}
+func (s *Stream) resetCompression() { s.cmpr.release() }
 
 func (s *Stream) cmplLoop() {
 	for {
@@ -168,21 +141,8 @@ func (s *Stream) doRequest() error {
 	if !s.compressed() {
 		return s.doPlain(s)
 	}
-	s.lz4s.sgl.Reset()
-	if s.lz4s.zw == nil {
-		s.lz4s.zw = lz4.NewWriter(s.lz4s.sgl)
-	} else {
-		s.lz4s.zw.Reset(s.lz4s.sgl)
-	}
-
-	err := s.lz4s.zw.Apply(
-		lz4.BlockChecksumOption(false),
-		lz4.ChecksumOption(s.lz4s.frameChecksum),
-		lz4.BlockSizeOption(lz4.BlockSize(s.lz4s.blockMaxSize)),
-	)
-	debug.AssertNoErr(err)
-
-	return s.doCmpr(s.lz4s)
+	s.cmpr.reset()
+	return s.doCmpr(s.cmpr, s.cmpr.wire)
 }
 
 // as io.Reader
@@ -413,51 +373,3 @@ func (s *Stream) idleTick() {
 		}
 	}
 }
-
-///////////////
-// lz4Stream //
-///////////////
-
-func (lz4s *lz4Stream) Read(b []byte) (n int, err error) {
-	var (
-		sendoff = &lz4s.s.sendoff
-		last    = sendoff.obj.Hdr.isFin()
-		retry   = maxInReadRetries // insist on returning n > 0 (note that lz4 compresses /blocks/)
-	)
-	if lz4s.sgl.Len() > 0 {
-		lz4s.zw.Flush()
-		n, err = lz4s.sgl.Read(b)
-		if err == io.EOF { // reusing/rewinding this buf multiple times
-			err = nil
-		}
-		goto ex
-	}
-re:
-	n, err = lz4s.s.Read(b)
-	_, _ = lz4s.zw.Write(b[:n])
-	if last {
-		lz4s.zw.Flush()
-		retry = 0
-	} else if lz4s.s.sendoff.ins == inEOB || err != nil {
-		lz4s.zw.Flush()
-		retry = 0
-	}
-	n, _ = lz4s.sgl.Read(b)
-	if n == 0 {
-		if retry > 0 {
-			retry--
-			runtime.Gosched()
-			goto re
-		}
-		lz4s.zw.Flush()
-		n, _ = lz4s.sgl.Read(b)
-	}
-ex:
-	if lz4s.sgl.Len() == 0 {
-		lz4s.sgl.Reset()
-	}
-	if last && err == nil {
-		err = io.EOF
-	}
-	return n, err
-}
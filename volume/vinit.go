@@ -160,6 +160,7 @@ func initMPI(tid string, config *cmn.Config, devs ios.BlockDevs, vmd *VMD, pass
 				mi.Fs = fsMpathMD.Fs
 				mi.FsType = fsMpathMD.FsType
 				mi.FsID = fsMpathMD.FsID
+				mi.Weight = fsMpathMD.Weight
 				mi.AddDisabled(disabled)
 			}
 			continue
@@ -217,6 +218,7 @@ func initMPI(tid string, config *cmn.Config, devs ios.BlockDevs, vmd *VMD, pass
 				nlog.Warningf("%s: %v", mi, errLoad)
 			}
 		} else {
+			mi.Weight = fsMpathMD.Weight
 			if err := mi.AddEnabled(tid, avail, config, devs); err != nil {
 				return nil, false, err
 			}
@@ -29,6 +29,7 @@ type (
 		FsType  string             `json:"fs_type"`
 		FsID    cos.FsID           `json:"fs_id"`
 		Enabled bool               `json:"enabled"`
+		Weight  uint64             `json:"weight,omitempty"` // weighted HRW (see fs.InitWeights)
 	}
 
 	// VMD is AIS target's volume metadata structure
@@ -78,6 +79,7 @@ func (vmd *VMD) addMountpath(mi *fs.Mountpath, enabled bool) {
 		FsType:  mi.FsType,
 		FsID:    mi.FsID,
 		Enabled: enabled,
+		Weight:  mi.Weight,
 	}
 }
 
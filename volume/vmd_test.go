@@ -25,6 +25,7 @@ func TestVMD(t *testing.T) {
 
 	t.Run("CreateNewVMD", func(t *testing.T) { testVMDCreate(t, mpaths, daemonID) })
 	t.Run("VMDPersist", func(t *testing.T) { testVMDPersist(t, daemonID) })
+	t.Run("VMDWeights", func(t *testing.T) { testVMDWeights(t, daemonID) })
 }
 
 func testVMDCreate(t *testing.T, mpaths fs.MPI, daemonID string) {
@@ -56,3 +57,21 @@ func testVMDPersist(t *testing.T, daemonID string) {
 	tassert.Errorf(t, reflect.DeepEqual(newVMD.Mountpaths, vmd.Mountpaths),
 		"expected VMDs to be equal. got: %+v vs %+v", newVMD, vmd)
 }
+
+func testVMDWeights(t *testing.T, daemonID string) {
+	fs.InitWeights(true)
+	defer fs.InitWeights(false)
+
+	_, err := volume.NewFromMPI(daemonID)
+	tassert.CheckFatal(t, err)
+	newVMD, err := volume.LoadVMDTest()
+	tassert.CheckFatal(t, err)
+
+	avail := fs.GetAvail()
+	for mpath, md := range newVMD.Mountpaths {
+		mi, ok := avail[mpath]
+		tassert.Fatalf(t, ok, "vmd has unknown %q mountpath", mpath)
+		tassert.Errorf(t, md.Weight != 0 && md.Weight == mi.Weight,
+			"expected %q weight %d, got %d", mpath, mi.Weight, md.Weight)
+	}
+}
@@ -129,6 +129,49 @@ var Table = map[string]Descriptor{
 	apc.ActResilver: {Scope: ScopeT, Startable: true, Resilver: true}, // ICMode: ICNone - ScopeT, single-target, no aggregation
 	apc.ActRechunk:  {Scope: ScopeB, Startable: true, RefreshCap: true, ConflictRebRes: true, AbortByReb: true, ICMode: ICUponTerm},
 
+	// periodically (and on demand) enforces bucket lifecycle rules: expires objects, aborts stale multipart uploads
+	apc.ActLifecycle: {
+		Scope:          ScopeB,
+		Access:         apc.AceObjDELETE,
+		Startable:      true,
+		RefreshCap:     true,
+		ConflictRebRes: true,
+		AbortByReb:     true,
+		ICMode:         ICUponTerm,
+	},
+
+	// recomputes and validates content checksums of all objects in a bucket;
+	// with xact.FlagRepair, restores corrupted or missing replicas from local mirrors, EC slices, or remote backend
+	apc.ActScrub: {
+		Scope:          ScopeB,
+		Access:         apc.AccessRO | apc.AceObjUpdate,
+		Startable:      true,
+		RefreshCap:     true,
+		ConflictRebRes: true,
+		AbortByReb:     true,
+		ICMode:         ICUponTerm,
+	},
+
+	// uploads locally written objects that are pending write-back (see apc.WriteDelayed);
+	// runs periodically in the background and on demand (to flush)
+	apc.ActWriteBack: {
+		Scope:     ScopeB,
+		Access:    apc.AcePUT,
+		Startable: true,
+		ICMode:    ICUponTerm,
+	},
+
+	// periodically (and on demand) moves objects between hot and cold storage classes (see cmn.TieringConf)
+	apc.ActTiering: {
+		Scope:          ScopeB,
+		Access:         apc.AceObjUpdate,
+		Startable:      true,
+		RefreshCap:     true,
+		ConflictRebRes: true,
+		AbortByReb:     true,
+		ICMode:         ICUponTerm,
+	},
+
 	// IndexShard is a best-effort build: stale entries are detected via LOM checksum
 	// and fall back to tar.Next() scan. A partial index remains useful, and resumed
 	// builds atomically skip already-indexed LOMs (lom.md.flags&Indexed + index object).
@@ -46,6 +46,9 @@ const (
 	// makes global rebalance run in special cleanup mode,
 	// safely removing misplaced objects
 	FlagRemoveMisplaced
+
+	// usage: x-scrub (apc.ActScrub) to repair (not only detect) corrupted and missing replicas
+	FlagRepair
 )
 
 type (
@@ -85,6 +85,22 @@ func RenewBckRechunks(bck *meta.Bck, uuid string, msg *apc.RechunkMsg) RenewRes
 	return RenewBucketXact(apc.ActRechunk, bck, Args{Custom: msg, UUID: uuid})
 }
 
+func RenewBckLifecycle(bck *meta.Bck, uuid string, args *LcyArgs) RenewRes {
+	return RenewBucketXact(apc.ActLifecycle, bck, Args{Custom: args, UUID: uuid})
+}
+
+func RenewBckScrub(bck *meta.Bck, uuid string, args *ScrubArgs) RenewRes {
+	return RenewBucketXact(apc.ActScrub, bck, Args{Custom: args, UUID: uuid})
+}
+
+func RenewBckWriteBack(bck *meta.Bck, uuid string, args *WbArgs) RenewRes {
+	return RenewBucketXact(apc.ActWriteBack, bck, Args{Custom: args, UUID: uuid})
+}
+
+func RenewBckTiering(bck *meta.Bck, uuid string) RenewRes {
+	return RenewBucketXact(apc.ActTiering, bck, Args{UUID: uuid})
+}
+
 func RenewBckShardIndex(bck *meta.Bck, uuid string, msg *apc.IndexShardMsg) RenewRes {
 	return RenewBucketXact(apc.ActIndexShard, bck, Args{Custom: msg, UUID: uuid})
 }
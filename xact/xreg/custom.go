@@ -6,6 +6,7 @@ package xreg
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -50,6 +51,17 @@ type (
 		Config *cmn.Config
 		Smap   *meta.Smap
 	}
+	LcyArgs struct {
+		// abort (target-local) multipart uploads to a given bucket/prefix that are older
+		// than a given age; returns the number of aborted uploads
+		AbortMpt func(bck *meta.Bck, prefix string, age time.Duration) int
+	}
+	ScrubArgs struct {
+		Repair bool // see xact.FlagRepair
+	}
+	WbArgs struct {
+		Flush bool // user-requested (vs. periodic background write-back)
+	}
 	RebArgs struct {
 		Bck    *meta.Bck     // (limited-scope)
 		Prefix string        // (ditto)
@@ -782,8 +782,21 @@ func (w *blobWorker) do(wi *blobWI, buf []byte) (int, error) {
 	// If RespWriter is set, copy the data to the work-item's SGL.
 	// This SGL will later be stitched together and sequentially written
 	// to the RespWriter via the `doneCh` channel (see `XactBlobDl.write()`).
-	writers := make([]io.Writer, 0, 3)
-	writers = append(writers, chunkFh)
+	var (
+		cw      *core.Cwriter
+		writers = make([]io.Writer, 0, 3)
+	)
+	if cw, chunkFhErr = lom.NewCwriter(chunkFh); chunkFhErr != nil {
+		cos.Close(res.R)
+		cos.Close(chunkFh)
+		_ = cos.RemoveFile(chunkPath)
+		return 0, chunkFhErr
+	}
+	if cw != nil {
+		writers = append(writers, cw)
+	} else {
+		writers = append(writers, chunkFh)
+	}
 	if respWriter != nil {
 		writers = append(writers, wi.sgl)
 	}
@@ -793,6 +806,12 @@ func (w *blobWorker) do(wi *blobWI, buf []byte) (int, error) {
 	// 5. Read remote, write local
 	chwritten, cksum, copyErr := cos.CopyAndChecksum(multiWriter, res.R, buf, lom.CksumConf().Type)
 	cos.Close(res.R)
+	if cw != nil {
+		if err := cw.Close(); err != nil && copyErr == nil {
+			copyErr = err
+		}
+		chunk.SetStored(cw)
+	}
 	cos.Close(chunkFh)
 	if copyErr != nil {
 		if nerr := cos.RemoveFile(chunkPath); nerr != nil {
@@ -64,6 +64,10 @@ func Tinit(coi COI) {
 
 	xreg.RegBckXact(&rechunkFactory{kind: apc.ActRechunk})
 	xreg.RegBckXact(&shardSummFactory{})
+	xreg.RegBckXact(&lcyFactory{})
+	xreg.RegBckXact(&scrubFactory{})
+	xreg.RegBckXact(&wbFactory{})
+	xreg.RegBckXact(&tierFactory{})
 	xreg.RegBckXact(&shardIndexFactory{kind: apc.ActIndexShard})
 
 	// assign COI singleton
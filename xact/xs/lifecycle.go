@@ -0,0 +1,189 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Bucket lifecycle enforcement (see cmn.LifecycleConf):
+// - walk the bucket and delete (or, for remote buckets, evict) expired objects;
+// - abort stale multipart uploads via the target-provided callback.
+
+type (
+	lcyFactory struct {
+		xctn *XactLifecycle
+		args *xreg.LcyArgs
+		xreg.RenewBase
+	}
+	XactLifecycle struct {
+		now  time.Time
+		args *xreg.LcyArgs
+		lcy  cmn.LifecycleConf // snapshot at startup
+		nexp atomic.Int64      // expired objects
+		nmpt atomic.Int64      // aborted multipart uploads
+		xact.BckJogRunner
+	}
+)
+
+var (
+	_ core.Xact      = (*XactLifecycle)(nil)
+	_ xreg.Renewable = (*lcyFactory)(nil)
+)
+
+func (*lcyFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &lcyFactory{
+		RenewBase: xreg.RenewBase{Args: args, Bck: bck},
+		args:      args.Custom.(*xreg.LcyArgs),
+	}
+}
+
+// construction only (the renewal caller does xact.GoRunW)
+func (p *lcyFactory) Start() error {
+	r := &XactLifecycle{
+		now:  time.Now(),
+		args: p.args,
+		lcy:  p.Bck.Props.Lifecycle,
+	}
+	err := r.BckJogRunner.Init(p.UUID(), p.Kind(), p.Bck, xact.BckJogRunnerOpts{
+		CbObj:      r.visit,
+		NumWorkers: xact.NwpNone,
+		RW:         true,
+	}, cmn.GCO.Get())
+	if err != nil {
+		return err
+	}
+	p.xctn = r
+	return nil
+}
+
+func (*lcyFactory) Kind() string     { return apc.ActLifecycle }
+func (p *lcyFactory) Get() core.Xact { return p.xctn }
+
+func (*lcyFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprUse, cmn.NewErrXactUsePrev(prevEntry.Get().String())
+}
+
+func (r *XactLifecycle) Run(wg *sync.WaitGroup) {
+	wg.Done()
+
+	nlog.Infoln(r.Name(), "rules:", r.lcy.String())
+
+	if r.hasExpiration() {
+		r.BckJogRunner.Run()
+		if err := r.BckJogRunner.Wait(); err != nil {
+			r.AddErr(err)
+		}
+	}
+	if !r.IsAborted() && r.args != nil && r.args.AbortMpt != nil {
+		bck := r.Bck()
+		for i := range r.lcy.Rules {
+			rule := &r.lcy.Rules[i]
+			if rule.Disabled || rule.AbortMptDays == 0 {
+				continue
+			}
+			if n := r.args.AbortMpt(bck, rule.Prefix, rule.MptAge()); n > 0 {
+				r.nmpt.Add(int64(n))
+			}
+		}
+	}
+	r.Finish()
+}
+
+func (r *XactLifecycle) hasExpiration() bool {
+	for i := range r.lcy.Rules {
+		if rule := &r.lcy.Rules[i]; !rule.Disabled && rule.ExpirationDays > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *XactLifecycle) visit(lom *core.LOM, _ []byte) error {
+	lom.Lock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		lom.Unlock(false)
+		if cos.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if lom.IsCopy() {
+		lom.Unlock(false)
+		return nil
+	}
+	// object lock (WORM): retained objects do not expire
+	if lom.Bprops().ObjectLock.Enabled && lom.IsLockedWORM() {
+		lom.Unlock(false)
+		return nil
+	}
+	// pending write-back: do not expire (evict) until uploaded
+	if lom.WbPending() {
+		lom.Unlock(false)
+		return nil
+	}
+	mtime, err := lom.LastModified()
+	if err != nil {
+		lom.Unlock(false)
+		return err
+	}
+	id := r.lcy.Expired(lom.ObjName, lom.GetCustomMD(), mtime, r.now)
+	lom.Unlock(false)
+	if id == "" {
+		return nil
+	}
+
+	// re-check under write lock: the object may have been overwritten in the meantime
+	var size int64
+	cond := func(lom *core.LOM) bool {
+		mtime, err := lom.LastModified()
+		if err != nil || lom.WbPending() || r.lcy.Expired(lom.ObjName, lom.GetCustomMD(), mtime, r.now) != id {
+			return false
+		}
+		size = lom.Lsize()
+		return true
+	}
+	bck := lom.Bck()
+	if _, err := core.T.DeleteObjectIf(lom, bck.IsRemote() /*evict*/, cond); err != nil {
+		if cos.IsNotExist(err) || err == cmn.ErrSkip {
+			return nil
+		}
+		r.AddErr(fmt.Errorf("%s: failed to expire %s (rule %q): %w", r.Name(), lom.Cname(), id, err), 4, cos.ModXs)
+		return nil
+	}
+	r.nexp.Inc()
+	r.ObjsAdd(1, size)
+	if cmn.Rom.V(5, cos.ModXs) {
+		nlog.Infoln(r.Name(), "expired", lom.Cname(), "rule", id)
+	}
+	return nil
+}
+
+func (r *XactLifecycle) Snap() *core.Snap { return r.Base.NewSnap(r) }
+
+func (r *XactLifecycle) CtlMsg() string {
+	var sb cos.SB
+	sb.Init(80)
+	idxAppend(&sb, "expired", strconv.FormatInt(r.nexp.Load(), 10))
+	idxAppend(&sb, "aborted-uploads", strconv.FormatInt(r.nmpt.Load(), 10))
+	if n := r.ErrCnt(); n > 0 {
+		idxAppend(&sb, "errs", strconv.Itoa(n))
+	}
+	return sb.String()
+}
@@ -530,6 +530,12 @@ func (r *LsoXact) doPageA() *LsoRsp {
 	)
 	debug.Assert(int64(len(lst)) >= cnt || r.walk.done)
 	if int64(len(lst)) >= cnt {
+		if r.msg.IsFlagSet(apc.LsVersions) {
+			// never split same-name entries (versions) between pages
+			for cnt < int64(len(lst)) && lst[cnt].Name == lst[cnt-1].Name {
+				cnt++
+			}
+		}
 		entries := lst[:cnt]
 		page = &cmn.LsoRes{UUID: r.msg.UUID, Entries: entries, ContinuationToken: entries[cnt-1].Name}
 	} else {
@@ -768,7 +774,10 @@ func (r *LsoXact) nextPageA() {
 		if cmn.TokenGreaterEQ(r.token, entry.Name) {
 			continue
 		}
-		cnt++
+		// (apc.LsVersions: not counting prior versions that always precede the current entry)
+		if !entry.IsAnyFlagSet(apc.EntryIsVersion) {
+			cnt++
+		}
 		r.page = append(r.page, entry)
 	}
 }
@@ -813,7 +822,21 @@ func (r *LsoXact) doWalk(msg *apc.LsoMsg) {
 		},
 	}
 	opts.WalkOpts.Bck.Copy(r.Bck().Bucket())
-	if err := fs.WalkBck(opts); err != nil {
+	var err error
+	switch {
+	case msg.IsFlagSet(apc.LsVersions):
+		err = r.lsDeleted(msg)
+	case msg.Snapshot != "":
+		err = r.initSnap(msg)
+		opts.Callback = r.cbSnap
+	}
+	if err == nil {
+		err = fs.WalkBck(opts)
+	}
+	if err == nil && r.walk.wi.snap != nil {
+		err = r.pushSnap("")
+	}
+	if err != nil {
 		if err != filepath.SkipDir && err != errLsoStopped {
 			r.AddErr(err, 0)
 		}
@@ -884,6 +907,9 @@ func (r *LsoXact) cb(fqn string, de fs.DirEntry) error {
 	if entry.Name <= msg.StartAfter {
 		return nil
 	}
+	if err := r.pushVersions(); err != nil {
+		return err
+	}
 
 	select {
 	case r.walk.pageCh <- entry:
@@ -925,6 +951,127 @@ func (r *LsoXact) cb(fqn string, de fs.DirEntry) error {
 	return nil
 }
 
+// apc.LsVersions: push retained versions and delete markers ahead of the current entry
+func (r *LsoXact) pushVersions() error {
+	wi := r.walk.wi
+	for i, en := range wi.vers {
+		select {
+		case r.walk.pageCh <- en:
+			wi.vers[i] = nil
+		case <-r.walk.stopCh.Listen():
+			return errLsoStopped
+		}
+	}
+	wi.vers = wi.vers[:0]
+	return nil
+}
+
+// apc.LsVersions: deleted object (that is, a delete marker being its latest version)
+// is listed only when the prefix is exactly its name
+func (r *LsoXact) lsDeleted(msg *apc.LsoMsg) error {
+	if msg.Prefix == "" || cos.IsLastB(msg.Prefix, '/') || msg.Prefix <= msg.StartAfter {
+		return nil
+	}
+	wi := r.walk.wi
+	if !wi.match(msg.Prefix) {
+		return nil
+	}
+	lom := core.AllocLOM(msg.Prefix)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(r.Bck()); err != nil {
+		return nil
+	}
+	if _, local, err := lom.HrwTarget(wi.smap); err != nil || !local {
+		return nil
+	}
+	if err := lom.Load(false /*cache it*/, false /*locked*/); !cmn.IsErrObjNought(err) {
+		return nil // exists (or failed to load) - will be listed by the walk
+	}
+	wi.lsVersions(lom)
+	return r.pushVersions()
+}
+
+// snapshot listing: collect (sorted) names of the objects preserved for the snapshot,
+// to be merged with the current ones (see cbSnap)
+func (r *LsoXact) initSnap(msg *apc.LsoMsg) error {
+	wi := r.walk.wi
+	if wi.snap = r.Bck().Props.Snapshot(msg.Snapshot); wi.snap == nil {
+		return cmn.NewErrSnapshotNotFound(r.Bck().Bucket(), msg.Snapshot)
+	}
+	prefix := wi.snap.ID() + cos.PathSeparator
+	if cos.IsLastB(msg.Prefix, '/') {
+		prefix += msg.Prefix
+	}
+	opts := &fs.WalkBckOpts{
+		WalkOpts: fs.WalkOpts{
+			CTs:    []string{fs.ObjSnapCT},
+			Prefix: prefix,
+			Sorted: true,
+			Callback: func(fqn string, de fs.DirEntry) error {
+				var parsed fs.ParsedFQN
+				if de.IsDir() || parsed.Init(fqn) != nil {
+					return nil
+				}
+				ci := fs.CSM.ParseUbase(parsed.ObjName, fs.ObjSnapCT)
+				if !ci.Ok || ci.Extras[0] != wi.snap.ID() || !wi.match(ci.Base) {
+					return nil
+				}
+				if l := len(wi.preserved); l == 0 || wi.preserved[l-1] != ci.Base {
+					wi.preserved = append(wi.preserved, ci.Base)
+				}
+				return nil
+			},
+		},
+	}
+	opts.WalkOpts.Bck.Copy(r.Bck().Bucket())
+	return fs.WalkBck(opts)
+}
+
+// snapshot listing: walking current objects while merging in the preserved ones
+func (r *LsoXact) cbSnap(fqn string, de fs.DirEntry) error {
+	var parsed fs.ParsedFQN
+	if de.IsDir() || parsed.Init(fqn) != nil {
+		return nil
+	}
+	if !r.walk.wi.match(parsed.ObjName) {
+		return nil
+	}
+	if err := r.pushSnap(parsed.ObjName); err != nil {
+		return err
+	}
+	return r.lsSnap(parsed.ObjName)
+}
+
+// list preserved objects that precede (or are) the given name; all of them when empty
+func (r *LsoXact) pushSnap(name string) error {
+	wi := r.walk.wi
+	for len(wi.preserved) > 0 && (name == "" || wi.preserved[0] <= name) {
+		if err := r.lsSnap(wi.preserved[0]); err != nil {
+			return err
+		}
+		wi.preserved = wi.preserved[1:]
+	}
+	return nil
+}
+
+func (r *LsoXact) lsSnap(name string) error {
+	wi := r.walk.wi
+	if name == wi.lastSnap || name <= wi.msg.StartAfter {
+		return nil // (same object on another mountpath, or preserved and current)
+	}
+	wi.lastSnap = name
+	entry, err := wi.lsSnap(r.Bck(), name)
+	if err != nil || entry == nil {
+		return err
+	}
+	select {
+	case r.walk.pageCh <- entry:
+		return nil
+	case <-r.walk.stopCh.Listen():
+		return errLsoStopped
+	}
+}
+
 func (r *LsoXact) Snap() *core.Snap { return r.Base.NewSnap(r) }
 
 //
@@ -306,6 +306,7 @@ func (r *XactNsumm) cloneRes(dst, src *cmn.BsummResult) {
 
 	dst.ObjCount.Present = ratomic.LoadUint64(&src.ObjCount.Present)
 	dst.TotalSize.PresentObjs = ratomic.LoadUint64(&src.TotalSize.PresentObjs)
+	dst.TotalSize.PresentPhys = ratomic.LoadUint64(&src.TotalSize.PresentPhys)
 
 	if r.listRemote {
 		dst.ObjCount.Remote = ratomic.LoadUint64(&src.ObjCount.Remote)
@@ -353,6 +354,7 @@ func (r *XactNsumm) visitObj(lom *core.LOM, _ []byte) error {
 		ratomic.CompareAndSwapInt64(&res.ObjSize.Max, cmax, size)
 	}
 	ratomic.AddUint64(&res.TotalSize.PresentObjs, uint64(size))
+	ratomic.AddUint64(&res.TotalSize.PresentPhys, uint64(lom.Psize()))
 
 	// generic stats (same as base.LomAdd())
 	r.ObjsAdd(1, size)
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -27,6 +28,8 @@ import (
 // Rechunk transforms object storage format (monolithic <-> chunked).
 // By default, rechunk operates only on in-cluster (cached) objects - it does not
 // fetch objects from remote backends. Use `SyncRemote=true` to also update remote storage.
+// Optionally, rechunk can be throttled (`RateLimit` objects per second) and/or
+// run as a dry-run that only counts (and sizes) the objects that would be converted.
 type (
 	rechunkFactory struct {
 		xreg.RenewBase
@@ -34,7 +37,9 @@ type (
 		kind string
 	}
 	xactRechunk struct {
-		args *apc.RechunkMsg
+		args  *apc.RechunkMsg
+		rl    *cos.RateLim // when args.RateLimit > 0
+		sleep time.Duration
 		// TODO: migrate to xact.BckJogRunner to reduce boilerplate and gain auto-tuned worker pool
 		xact.BckJog
 	}
@@ -78,7 +83,7 @@ func (p *rechunkFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (wpr xreg.W
 		xprev    = prevEntry.Get()
 	)
 
-	if prevArgs.ObjSizeLimit == currArgs.ObjSizeLimit && prevArgs.ChunkSize == currArgs.ChunkSize {
+	if prevArgs.ObjSizeLimit == currArgs.ObjSizeLimit && prevArgs.ChunkSize == currArgs.ChunkSize && prevArgs.DryRun == currArgs.DryRun {
 		// Same configuration - ignore the request and reuse the existing xaction
 		return xreg.WprUse, cmn.NewErrXactUsePrev(xprev.String())
 	}
@@ -122,6 +127,14 @@ func newxactRechunk(p *rechunkFactory) (*xactRechunk, error) {
 	}
 
 	debug.AssertNoErr(err)
+	if args.RateLimit > 0 {
+		if r.rl, err = cos.NewRateLim(args.RateLimit, time.Second); err != nil {
+			return nil, err
+		}
+		r.sleep = max(time.Second/time.Duration(args.RateLimit), cos.DfltRateMinBtwn)
+	} else if args.RateLimit < 0 {
+		return nil, fmt.Errorf("invalid rechunk rate limit %d", args.RateLimit)
+	}
 	mpopts.Bck.Copy(p.Bck.Bucket())
 
 	r.BckJog.Init(p.UUID(), p.Kind(), p.Bck, mpopts, config)
@@ -155,12 +168,21 @@ func (r *xactRechunk) do(lom *core.LOM, _ []byte) error {
 	)
 	if size < r.args.ObjSizeLimit || r.args.ObjSizeLimit == 0 {
 		if !lom.IsChunked() {
-			// Track skipped object stats (no-op case)
-			r.ObjsAdd(1, size)
+			// Track skipped object stats (no-op case) - unless estimating
+			if !r.args.DryRun {
+				r.ObjsAdd(1, size)
+			}
 			return nil // Do nothing: monolithic objects stay monolithic
 		}
 		chunkSize = 0 // restore chunked objects to monolithic
 	}
+	if r.args.DryRun {
+		r.ObjsAdd(1, size) // would be converted
+		return nil
+	}
+	if r.rl != nil {
+		r.throttle()
+	}
 
 	lh, err := lom.Open()
 	if err != nil {
@@ -197,6 +219,12 @@ func (r *xactRechunk) do(lom *core.LOM, _ []byte) error {
 	return nil
 }
 
+func (r *xactRechunk) throttle() {
+	for !r.rl.TryAcquire() && !r.IsAborted() {
+		time.Sleep(r.sleep)
+	}
+}
+
 func (r *xactRechunk) Run(wg *sync.WaitGroup) {
 	wg.Done()
 	r.BckJog.Run()
@@ -222,6 +250,14 @@ func (r *xactRechunk) CtlMsg() string {
 	if r.args.SyncRemote {
 		sb.WriteString(", sync-remote:true")
 	}
+	if r.args.DryRun {
+		sb.WriteString(", dry-run:true")
+	}
+	if r.args.RateLimit > 0 {
+		sb.WriteString(", rate-limit:")
+		sb.WriteString(strconv.Itoa(r.args.RateLimit))
+		sb.WriteString("/s")
+	}
 	if r.args.Prefix != "" {
 		sb.WriteString(", prefix:")
 		sb.WriteString(r.args.Prefix)
@@ -0,0 +1,425 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/ec"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Target-side scrub: walk the bucket via mountpath joggers and, for each object:
+// - validate its metadata and recompute content checksum (as per bucket's CksumConf);
+// - validate its local replicas (mirror copies), if any;
+// - optionally (xreg.ScrubArgs.Repair), restore corrupted or missing replicas
+//   from (in this order) local copies, EC slices, or remote backend;
+// - given failure-domain labeled targets, check EC placement (ec.Metadata.DomainViolation).
+// Objects that cannot be repaired are never removed - only counted and reported.
+
+type (
+	scrubFactory struct {
+		xctn *XactScrub
+		args *xreg.ScrubArgs
+		xreg.RenewBase
+	}
+	XactScrub struct {
+		args      *xreg.ScrubArgs
+		ncorrupt  atomic.Int64 // corrupted objects (content or metadata)
+		nmissing  atomic.Int64 // missing or corrupted copies, including missing main replicas
+		nrepaired atomic.Int64
+		nfailed   atomic.Int64 // detected but not repaired
+		ndomain   atomic.Int64 // EC slices and replicas not spread across failure domains
+		xact.BckJogRunner
+	}
+)
+
+var (
+	_ core.Xact      = (*XactScrub)(nil)
+	_ xreg.Renewable = (*scrubFactory)(nil)
+)
+
+var errNoRedundancy = errors.New("no redundant copies, EC slices, or remote backend")
+
+func (*scrubFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &scrubFactory{
+		RenewBase: xreg.RenewBase{Args: args, Bck: bck},
+		args:      args.Custom.(*xreg.ScrubArgs),
+	}
+}
+
+// construction only (the renewal caller does xact.GoRunW)
+func (p *scrubFactory) Start() error {
+	r := &XactScrub{args: p.args}
+	err := r.BckJogRunner.Init(p.UUID(), p.Kind(), p.Bck, xact.BckJogRunnerOpts{
+		CbObj:      r.visit,
+		NumWorkers: xact.NwpNone, // one jogger per mountpath: sequential reads, disk-bound
+		RW:         true,
+	}, cmn.GCO.Get())
+	if err != nil {
+		return err
+	}
+	p.xctn = r
+	return nil
+}
+
+func (*scrubFactory) Kind() string     { return apc.ActScrub }
+func (p *scrubFactory) Get() core.Xact { return p.xctn }
+
+func (*scrubFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprUse, cmn.NewErrXactUsePrev(prevEntry.Get().String())
+}
+
+func (r *XactScrub) Run(wg *sync.WaitGroup) {
+	wg.Done()
+
+	nlog.Infoln(r.Name(), "repair:", r.args.Repair)
+
+	r.BckJogRunner.Run()
+	if err := r.BckJogRunner.Wait(); err != nil {
+		r.AddErr(err)
+	}
+	r.Finish()
+}
+
+func (r *XactScrub) visit(lom *core.LOM, buf []byte) error {
+	lom.Lock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		lom.Unlock(false)
+		switch {
+		case cos.IsNotExist(err):
+		case cmn.IsErrLmetaCorrupted(err):
+			r.corrupted(lom, err)
+		default:
+			r.AddErr(err, 4, cos.ModXs)
+		}
+		return nil
+	}
+	if lom.IsCopy() {
+		lom.Unlock(false)
+		r.visitCopy(lom)
+		return nil
+	}
+
+	err := lom.ValidateMetaChecksum()
+	if err == nil {
+		err = lom.ValidateContentChecksum(true /*locked*/)
+	}
+	var bad []string
+	if err == nil && lom.HasCopies() {
+		bad = r.checkCopies(lom)
+	}
+	size := lom.Lsize()
+	lom.Unlock(false)
+
+	r.ObjsAdd(1, size)
+	switch {
+	case err == nil:
+	case cos.IsNotExist(err):
+		return nil
+	case cos.IsErrBadCksum(err), cmn.IsErrLmetaCorrupted(err):
+		r.corrupted(lom, err)
+		return nil
+	default:
+		r.AddErr(err, 4, cos.ModXs)
+		return nil
+	}
+
+	if lom.ECEnabled() {
+		r.checkDomains(lom)
+	}
+
+	// copies
+	var (
+		n    = lom.NumCopies() - len(bad)
+		want = lom.NumCopies()
+	)
+	if mirror := lom.MirrorConf(); mirror.Enabled {
+		want = max(want, int(mirror.Copies))
+	}
+	if n >= want {
+		return nil
+	}
+	r.nmissing.Add(int64(want - n))
+	if !r.args.Repair {
+		nlog.Warningln(r.Name(), lom.Cname(), "copies:", n, "expected:", want)
+		return nil
+	}
+	if err := r.fixCopies(lom, bad, want, buf); err != nil {
+		r.nfailed.Add(int64(want - n))
+		r.fail(lom, err)
+		return nil
+	}
+	r.nrepaired.Add(int64(want - n))
+	return nil
+}
+
+// is under rlock; returns copies that are missing or have bad content checksums
+func (*XactScrub) checkCopies(lom *core.LOM) (bad []string) {
+	cksum := lom.Checksum()
+	for copyFQN := range lom.GetCopies() {
+		if copyFQN == lom.FQN {
+			continue
+		}
+		if cos.NoneC(cksum) {
+			if err := cos.Stat(copyFQN); err != nil {
+				bad = append(bad, copyFQN)
+			}
+			continue
+		}
+		fh, err := lom.OpenFile(copyFQN)
+		if err != nil {
+			bad = append(bad, copyFQN)
+			continue
+		}
+		_, cksumHash, err := cos.ChecksumReader(fh, cksum.Ty())
+		cos.Close(fh)
+		if err != nil || !cksumHash.Equal(cksum) {
+			bad = append(bad, copyFQN)
+		}
+	}
+	return bad
+}
+
+// remove bad copies and make new ones, to restore the expected number
+func (*XactScrub) fixCopies(lom *core.LOM, bad []string, want int, buf []byte) error {
+	lom.Lock(true)
+	defer lom.Unlock(true)
+
+	lom.UncacheUnless()
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return err
+	}
+	copies := lom.GetCopies()
+	for _, copyFQN := range bad {
+		if _, ok := copies[copyFQN]; !ok {
+			continue
+		}
+		if err := lom.DelCopies(copyFQN); err != nil {
+			return err
+		}
+	}
+	if len(bad) > 0 {
+		if err := lom.Persist(); err != nil {
+			return err
+		}
+	}
+	for lom.NumCopies() < want {
+		mi := lom.LeastUtilNoCopy()
+		if mi == nil {
+			return fmt.Errorf("%s (copies=%d): cannot find dst mountpath", lom, lom.NumCopies())
+		}
+		if err := lom.Copy(mi, buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// (main target only) report EC placement that violates failure-domain policy
+func (r *XactScrub) checkDomains(lom *core.LOM) {
+	smap := core.T.Sowner().Get()
+	if !smap.HasDomains() {
+		return
+	}
+	md, err := ec.ObjectMetadata(lom.Bck(), lom.ObjName)
+	if err != nil || md.FullReplica != core.T.SID() {
+		return
+	}
+	if domain, n := md.DomainViolation(smap); n > 0 {
+		r.ndomain.Inc()
+		nlog.Warningln(r.Name(), lom.Cname(), "failure domain", domain, "holds", n, "of", len(md.Daemons), "slices and replicas")
+	}
+}
+
+// visiting a copy: check whether the main replica exists
+func (r *XactScrub) visitCopy(lom *core.LOM) {
+	hlom := core.AllocLOM(lom.ObjName)
+	defer core.FreeLOM(hlom)
+	if err := hlom.InitBck(lom.Bck()); err != nil {
+		r.AddErr(err, 4, cos.ModXs)
+		return
+	}
+	if hlom.FQN == lom.FQN {
+		return
+	}
+	// when there are multiple copies only one of them gets to report
+	for copyFQN := range lom.GetCopies() {
+		if copyFQN != hlom.FQN && copyFQN < lom.FQN {
+			return
+		}
+	}
+	if err := cos.Stat(hlom.FQN); err == nil || !cos.IsNotExist(err) {
+		return
+	}
+
+	r.nmissing.Inc()
+	if !r.args.Repair {
+		nlog.Warningln(r.Name(), hlom.Cname(), "missing main replica at", hlom.Mountpath().String())
+		return
+	}
+	if !hlom.RestoreToLocation() {
+		r.nfailed.Inc()
+		r.fail(hlom, errors.New("failed to restore main replica from local copies"))
+		return
+	}
+	r.nrepaired.Inc()
+}
+
+func (r *XactScrub) corrupted(lom *core.LOM, err error) {
+	r.ncorrupt.Inc()
+	nlog.Warningln(r.Name(), err)
+	if !r.args.Repair {
+		return
+	}
+	if err := r.restore(lom); err != nil {
+		r.nfailed.Inc()
+		r.fail(lom, err)
+		return
+	}
+	r.nrepaired.Inc()
+	nlog.Infoln(r.Name(), "repaired", lom.Cname())
+}
+
+// restore corrupted object from (in this order): local copies, EC slices, remote backend
+// the corrupted replica is moved aside (as a workfile) and is removed only after
+// the restored object revalidates; otherwise, it is put back in place
+func (r *XactScrub) restore(lom *core.LOM) error {
+	var (
+		hasCopies = _otherCopies(lom)
+		ecEnabled = lom.ECEnabled()
+		isRemote  = lom.Bck().IsRemote()
+	)
+	if !hasCopies && !ecEnabled && !isRemote {
+		return errNoRedundancy
+	}
+	aside := lom.GenFQN(fs.WorkCT, fs.WorkfileScrub)
+	if err := _mvMain(lom, lom.FQN, aside); err != nil {
+		return err
+	}
+	err := r._restore(lom, hasCopies, ecEnabled, isRemote)
+	if err == nil {
+		if errN := cos.RemoveFile(aside); errN != nil {
+			nlog.Warningln(r.Name(), "failed to remove", aside, "[", errN, "]")
+		}
+		return nil
+	}
+	if errN := _mvMain(lom, aside, lom.FQN); errN != nil {
+		nlog.Errorln(r.Name(), "failed to put back", lom.Cname(), "from", aside, "[", errN, "]")
+	}
+	return err
+}
+
+func (r *XactScrub) _restore(lom *core.LOM, hasCopies, ecEnabled, isRemote bool) (err error) {
+	if hasCopies && lom.RestoreToLocation() {
+		if err = _revalidate(lom); err == nil {
+			return nil
+		}
+		if err := _rmMain(lom); err != nil {
+			return err
+		}
+	}
+	if ecEnabled {
+		if err = ec.ECM.Recover(lom); err == nil {
+			if err = _revalidate(lom); err == nil {
+				return nil
+			}
+			if err := _rmMain(lom); err != nil {
+				return err
+			}
+		}
+	}
+	if isRemote {
+		if _, err = core.T.GetCold(context.Background(), lom, r.Kind(), cmn.OwtGetLock); err == nil {
+			return _revalidate(lom)
+		}
+	}
+	if err == nil {
+		err = errNoRedundancy
+	}
+	return err
+}
+
+func (r *XactScrub) fail(lom *core.LOM, err error) {
+	if cos.IsErrOOS(err) {
+		r.Abort(err)
+		return
+	}
+	r.AddErr(fmt.Errorf("%s: failed to repair %s: %w", r.Name(), lom.Cname(), err), 4, cos.ModXs)
+}
+
+func _otherCopies(lom *core.LOM) bool {
+	for _, mi := range fs.GetAvail() {
+		fqn := mi.MakePathFQN(lom.Bucket(), fs.ObjCT, lom.ObjName)
+		if fqn != lom.FQN && cos.Stat(fqn) == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// (renaming overwrites partially restored content, if any)
+func _mvMain(lom *core.LOM, src, dst string) error {
+	lom.Lock(true)
+	lom.UncacheDel()
+	err := cos.Rename(src, dst)
+	lom.Unlock(true)
+	return err
+}
+
+func _rmMain(lom *core.LOM) error {
+	lom.Lock(true)
+	lom.UncacheDel()
+	err := lom.RemoveMain()
+	lom.Unlock(true)
+	if err != nil && !cos.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func _revalidate(lom *core.LOM) error {
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	lom.UncacheDel()
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return err
+	}
+	return lom.ValidateContentChecksum(true /*locked*/)
+}
+
+func (r *XactScrub) Snap() *core.Snap { return r.Base.NewSnap(r) }
+
+func (r *XactScrub) CtlMsg() string {
+	var sb cos.SB
+	sb.Init(80)
+	idxAppend(&sb, "corrupted", strconv.FormatInt(r.ncorrupt.Load(), 10))
+	idxAppend(&sb, "missing-copies", strconv.FormatInt(r.nmissing.Load(), 10))
+	if n := r.ndomain.Load(); n > 0 {
+		idxAppend(&sb, apc.ScrubCtlMsgDomains, strconv.FormatInt(n, 10))
+	}
+	if r.args.Repair {
+		idxAppend(&sb, "repaired", strconv.FormatInt(r.nrepaired.Load(), 10))
+		idxAppend(&sb, "failed", strconv.FormatInt(r.nfailed.Load(), 10))
+	}
+	if n := r.ErrCnt(); n > 0 {
+		idxAppend(&sb, "errs", strconv.Itoa(n))
+	}
+	return sb.String()
+}
@@ -0,0 +1,171 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+const scrubObjSize = 64 * cos.KiB
+
+// two mountpaths; bucket with or without mirroring
+func scrubPrep(t *testing.T, copies int) (*meta.Bck, []byte) {
+	fs.NewTestMFS(mock.NewIOS())
+	dir := t.TempDir()
+	for _, name := range []string{"mp1", "mp2"} {
+		mpath := filepath.Join(dir, name)
+		tassert.CheckFatal(t, cos.CreateDir(mpath))
+		_, err := fs.AddTestMpath(mpath, "daeID")
+		tassert.CheckFatal(t, err)
+	}
+	bck := &cmn.Bck{
+		Name:     "SCRUB_TEST",
+		Provider: apc.AIS,
+		Ns:       cmn.NsGlobal,
+		Props: &cmn.Bprops{
+			Cksum:  cmn.CksumConf{Type: cos.ChecksumOneXxh},
+			Mirror: cmn.MirrorConf{Enabled: copies > 1, Copies: int64(copies)},
+			BID:    0xa5b6e7d8,
+		},
+	}
+	core.T = mock.NewTarget(mock.NewBaseBownerMock((*meta.Bck)(bck)))
+	if errs := fs.CreateBucket(bck, false /*nilbmd*/); len(errs) > 0 {
+		tassert.CheckFatal(t, errs[0])
+	}
+	data := make([]byte, scrubObjSize)
+	for i := range data {
+		data[i] = byte(i * 7)
+	}
+	return meta.CloneBck(bck), data
+}
+
+// write the object (and its copies, if any)
+func scrubPut(t *testing.T, bck *meta.Bck, objName string, data []byte, copies int) *core.LOM {
+	lom := &core.LOM{ObjName: objName}
+	tassert.CheckFatal(t, lom.InitBck(bck))
+	tassert.CheckFatal(t, os.WriteFile(lom.FQN, data, cos.PermRWR))
+	cksum, err := cos.ChecksumBytes(data, cos.ChecksumOneXxh)
+	tassert.CheckFatal(t, err)
+	lom.SetSize(int64(len(data)))
+	lom.SetCksum(cksum)
+	lom.IncVersion()
+	lom.SetAtimeUnix(1)
+	tassert.CheckFatal(t, lom.Persist())
+
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	for lom.NumCopies() < copies {
+		mi := lom.LeastUtilNoCopy()
+		tassert.Fatalf(t, mi != nil, "no mountpath for copy")
+		tassert.CheckFatal(t, lom.Copy(mi, make([]byte, scrubObjSize)))
+	}
+	return lom
+}
+
+// flip bytes in place (same size, metadata intact)
+func scrubCorrupt(t *testing.T, fqn string, b byte) {
+	fh, err := os.OpenFile(fqn, os.O_WRONLY, 0)
+	tassert.CheckFatal(t, err)
+	_, err = fh.WriteAt(bytes.Repeat([]byte{b}, 16), 100)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, fh.Close())
+}
+
+func newScrub(t *testing.T, bck *meta.Bck, repair bool) *XactScrub {
+	p := (&scrubFactory{}).New(xreg.Args{UUID: cos.GenUUID(), Custom: &xreg.ScrubArgs{Repair: repair}}, bck)
+	tassert.CheckFatal(t, p.Start())
+	return p.Get().(*XactScrub)
+}
+
+func scrubVisit(t *testing.T, r *XactScrub, bck *meta.Bck, objName string) {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(t, lom.InitBck(bck))
+	lom.UncacheDel()
+	tassert.CheckFatal(t, r.visit(lom, make([]byte, scrubObjSize)))
+}
+
+func TestScrubDetect(t *testing.T) {
+	bck, data := scrubPrep(t, 1)
+	lom := scrubPut(t, bck, "detect", data, 1)
+	scrubCorrupt(t, lom.FQN, 0xff)
+
+	r := newScrub(t, bck, false /*repair*/)
+	scrubVisit(t, r, bck, "detect")
+	tassert.Errorf(t, r.ncorrupt.Load() == 1, "expected 1 corrupted, got %d", r.ncorrupt.Load())
+	tassert.Errorf(t, r.nrepaired.Load() == 0 && r.nfailed.Load() == 0, "expected no repair attempts")
+
+	// detect only: left in place as is
+	b, err := os.ReadFile(lom.FQN)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(b) == len(data) && !bytes.Equal(b, data), "expected corrupted content in place")
+
+	// healthy object
+	scrubPut(t, bck, "healthy", data, 1)
+	scrubVisit(t, r, bck, "healthy")
+	tassert.Errorf(t, r.ncorrupt.Load() == 1, "expected healthy object to pass, got %d corrupted", r.ncorrupt.Load())
+}
+
+func TestScrubRepairFromReplica(t *testing.T) {
+	bck, data := scrubPrep(t, 2)
+	lom := scrubPut(t, bck, "repair", data, 2)
+	scrubCorrupt(t, lom.FQN, 0xff)
+
+	r := newScrub(t, bck, true /*repair*/)
+	scrubVisit(t, r, bck, "repair")
+	tassert.Errorf(t, r.ncorrupt.Load() == 1, "expected 1 corrupted, got %d", r.ncorrupt.Load())
+	tassert.Errorf(t, r.nrepaired.Load() == 1, "expected 1 repaired, got %d (failed %d)", r.nrepaired.Load(), r.nfailed.Load())
+
+	b, err := os.ReadFile(lom.FQN)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, bytes.Equal(b, data), "expected main replica restored from the good copy")
+
+	aside := lom.GenFQN(fs.WorkCT, fs.WorkfileScrub)
+	tassert.Errorf(t, cos.Stat(aside) != nil, "expected corrupted replica removed once repaired")
+}
+
+func TestScrubRepairFailPutBack(t *testing.T) {
+	bck, data := scrubPrep(t, 2)
+	lom := scrubPut(t, bck, "putback", data, 2)
+
+	// both the main replica and its copy are corrupted (differently)
+	var copyFQN string
+	for fqn := range lom.GetCopies() {
+		if fqn != lom.FQN {
+			copyFQN = fqn
+		}
+	}
+	tassert.Fatalf(t, copyFQN != "", "expected a copy")
+	scrubCorrupt(t, lom.FQN, 0xff)
+	scrubCorrupt(t, copyFQN, 0xee)
+	orig, err := os.ReadFile(lom.FQN)
+	tassert.CheckFatal(t, err)
+
+	r := newScrub(t, bck, true /*repair*/)
+	scrubVisit(t, r, bck, "putback")
+	tassert.Errorf(t, r.nfailed.Load() == 1, "expected 1 failed, got %d (repaired %d)", r.nfailed.Load(), r.nrepaired.Load())
+
+	// the original (corrupted) replica is back in place - never removed
+	b, err := os.ReadFile(lom.FQN)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, bytes.Equal(b, orig), "expected original replica put back")
+
+	aside := lom.GenFQN(fs.WorkCT, fs.WorkfileScrub)
+	tassert.Errorf(t, cos.Stat(aside) != nil, "expected no leftover workfile")
+}
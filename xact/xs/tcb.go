@@ -8,6 +8,7 @@ package xs
 import (
 	"encoding/binary"
 	"io"
+	"net/http"
 	"strconv"
 	"sync"
 	"time"
@@ -51,6 +52,7 @@ type (
 		prune     prune    // function: sync
 		sntl      sentinel // function: coordinate finish, abort, progress
 		copyErr   atomic.Int64
+		snap      *cmn.BckSnapshot // source bucket's snapshot to copy (apc.CopyBckMsg.Snapshot)
 
 		xact.BckJogRunner // mountpath joggers + managed worker pool
 		owt               cmn.OWT
@@ -115,6 +117,13 @@ func newXactTCB(uuid, kind string, args *xreg.TCBArgs) (*XactTCB, error) {
 		}
 	}
 
+	if msg.Snapshot != "" {
+		if r.snap = args.BckFrom.Props.Snapshot(msg.Snapshot); r.snap == nil {
+			return nil, cmn.NewErrSnapshotNotFound(args.BckFrom.Bucket(), msg.Snapshot)
+		}
+		r.copier.getROC = r.snapROC
+	}
+
 	if err := core.InMaintOrDecomm(smap, core.T.Snode(), r); err != nil {
 		return nil, err
 	}
@@ -280,6 +289,9 @@ func (r *XactTCB) run(wg *sync.WaitGroup) {
 	if errJog != nil && !r.IsAborted() {
 		nlog.Warningln(r.Name(), errJog, "- benign?")
 	}
+	if r.snap != nil && !r.IsAborted() {
+		r.copyDeleted()
+	}
 
 	if r.dm != nil {
 		abortErr := r.AbortErr()
@@ -326,6 +338,18 @@ func (r *XactTCB) do(lom *core.LOM, buf []byte) error {
 	if lom.IsCopy() {
 		return nil
 	}
+	if r.snap != nil {
+		slom, err := lom.LoadSnap(r.snap)
+		if err != nil {
+			if cos.IsNotExist(err) {
+				return nil // written after the snapshot
+			}
+			return err
+		}
+		if slom != nil {
+			core.FreeLOM(slom)
+		}
+	}
 	args := r.args // TCBArgs
 	a, err := r.copier.prepare(lom, args.BckTo, args.Msg, r.Config, buf, r.owt)
 	if err != nil {
@@ -504,3 +528,105 @@ func (r *XactTCB) Snap() (snap *core.Snap) {
 	snap.SrcBck, snap.DstBck = f.Clone(), t.Clone()
 	return
 }
+
+//
+// copy bucket snapshot (see core/lsnap)
+//
+
+// reads the object as of the snapshot: preserved or current, whichever applies
+func (r *XactTCB) snapROC(lom *core.LOM, _, _ bool, _ *core.ETLArgs) (resp core.ReadResp) {
+	slom, err := lom.LoadSnap(r.snap)
+	if err != nil {
+		resp.Err = err
+		if cos.IsNotExist(err) {
+			resp.Ecode = http.StatusNotFound
+		}
+		return resp
+	}
+	if slom == nil {
+		return lom.GetROC(false /*latest*/, false /*sync*/)
+	}
+	oa := &cmn.ObjAttrs{}
+	oa.CopyFrom(slom, false)
+	fh, err := slom.NewFileHandle(slom.FQN) // (decompress and/or decrypt)
+	core.FreeLOM(slom)
+	if err != nil {
+		resp.Err = err
+		return resp
+	}
+	// source attributes as of the snapshot (compare w/ coi._reader)
+	lom.CopyVersion(oa)
+	lom.SetCustomMD(oa.GetCustomMD())
+	resp.R, resp.OAH = fh, oa
+	return resp
+}
+
+// objects deleted after the snapshot was taken (that is, preserved but not visited by
+// the joggers); walking mountpaths one at a time
+func (r *XactTCB) copyDeleted() {
+	var (
+		args  = r.args
+		smap  = core.T.Sowner().Get()
+		avail = fs.GetAvail()
+		buf   = make([]byte, memsys.DefaultBufSize)
+	)
+	for _, mi := range avail {
+		opts := &fs.WalkOpts{
+			Mi:     mi,
+			CTs:    []string{fs.ObjSnapCT},
+			Prefix: r.snap.ID() + cos.PathSeparator,
+			Callback: func(fqn string, de fs.DirEntry) error {
+				if r.IsAborted() {
+					return r.AbortErr()
+				}
+				if de.IsDir() {
+					return nil
+				}
+				return r.copyPreserved(fqn, mi, smap, buf)
+			},
+		}
+		opts.Bck.Copy(args.BckFrom.Bucket())
+		if err := fs.Walk(opts); err != nil {
+			if !r.IsAborted() {
+				r.AddErr(err)
+			}
+			return
+		}
+	}
+}
+
+func (r *XactTCB) copyPreserved(fqn string, mi *fs.Mountpath, smap *meta.Smap, buf []byte) error {
+	var parsed fs.ParsedFQN
+	if parsed.Init(fqn) != nil {
+		return nil
+	}
+	ci := fs.CSM.ParseUbase(parsed.ObjName, fs.ObjSnapCT)
+	if !ci.Ok || ci.Extras[0] != r.snap.ID() || !cmn.ObjHasPrefix(ci.Base, r.args.Msg.Prefix) {
+		return nil
+	}
+	lom := core.AllocLOM(ci.Base)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(r.args.BckFrom); err != nil {
+		return err
+	}
+	if lom.Mountpath().Path != mi.Path {
+		return nil // (not loadable - see lom.LoadSnap)
+	}
+	if _, local, err := lom.HrwTarget(smap); err != nil || !local {
+		return err
+	}
+	if cos.Stat(lom.FQN) == nil {
+		return nil // exists (ie., visited by the joggers)
+	}
+	a, err := r.copier.prepare(lom, r.args.BckTo, r.args.Msg, r.Config, buf, r.owt)
+	if err != nil {
+		return err
+	}
+	if err := r.copier.do(a, lom, r.dm); err != nil {
+		r.copyErr.Inc()
+		if !cos.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
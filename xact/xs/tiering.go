@@ -0,0 +1,165 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Storage-class tiering (see cmn.TieringConf and core/ltier):
+// - walk the bucket and move objects that haven't been accessed for `cold_after`
+//   to the cold tier (demote), and recently accessed cold objects back to the hot one (promote);
+// - skip busy (w-locked) objects, mirrored copies, chunked objects, and objects pending write-back;
+// - objects that are not at their HRW location within their tier (e.g., written prior to enabling
+//   tiering) get relocated unless the destination already exists (the latter is left to resilver).
+
+type (
+	tierFactory struct {
+		xctn *XactTiering
+		xreg.RenewBase
+	}
+	XactTiering struct {
+		now    time.Time
+		tc     cmn.TieringConf // snapshot at startup
+		ndem   atomic.Int64    // demoted (hot => cold)
+		nprom  atomic.Int64    // promoted (cold => hot)
+		nreloc atomic.Int64    // relocated within the tier
+		xact.BckJogRunner
+	}
+)
+
+var (
+	_ core.Xact      = (*XactTiering)(nil)
+	_ xreg.Renewable = (*tierFactory)(nil)
+)
+
+func (*tierFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &tierFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+// construction only (the renewal caller does xact.GoRunW)
+func (p *tierFactory) Start() error {
+	r := &XactTiering{
+		now: time.Now(),
+		tc:  p.Bck.Props.Tiering,
+	}
+	err := r.BckJogRunner.Init(p.UUID(), p.Kind(), p.Bck, xact.BckJogRunnerOpts{
+		CbObj:      r.visit,
+		NumWorkers: xact.NwpNone,
+		RW:         true,
+	}, cmn.GCO.Get())
+	if err != nil {
+		return err
+	}
+	p.xctn = r
+	return nil
+}
+
+func (*tierFactory) Kind() string     { return apc.ActTiering }
+func (p *tierFactory) Get() core.Xact { return p.xctn }
+
+func (*tierFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprUse, cmn.NewErrXactUsePrev(prevEntry.Get().String())
+}
+
+func (r *XactTiering) Run(wg *sync.WaitGroup) {
+	wg.Done()
+
+	nlog.Infoln(r.Name(), "tiering:", r.tc.String())
+
+	r.BckJogRunner.Run()
+	if err := r.BckJogRunner.Wait(); err != nil {
+		r.AddErr(err)
+	}
+	r.Finish()
+}
+
+func (r *XactTiering) visit(lom *core.LOM, buf []byte) error {
+	if !lom.TryLock(true) {
+		return nil // busy - likely, being accessed
+	}
+	defer lom.Unlock(true)
+
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		if cos.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if lom.IsCopy() || lom.HasCopies() || lom.IsChunked() || lom.IsFntl() || lom.WbPending() {
+		return nil
+	}
+	var (
+		cold  = r.tc.IsCold(lom.Atime(), r.now)
+		move  = cold != lom.IsCold()
+		reloc = !move && !lom.IsHRW()
+	)
+	if !move && !reloc {
+		return nil
+	}
+	mi, err := lom.TierMpath(fs.GetAvail(), cold)
+	if err != nil {
+		return err
+	}
+	if mi.Path == lom.Mountpath().Path {
+		return nil // (e.g., no mountpaths labeled with the destination's storage class)
+	}
+	if !lom.IsHRW() && cos.Stat(mi.MakePathFQN(lom.Bucket(), fs.ObjCT, lom.ObjName)) == nil {
+		return nil
+	}
+
+	size := lom.Lsize()
+	if err := lom.MoveTo(mi, buf); err != nil {
+		if cos.IsNotExist(err) {
+			return nil
+		}
+		r.AddErr(fmt.Errorf("%s: failed to move %s to %s: %w", r.Name(), lom.Cname(), mi, err), 4, cos.ModXs)
+		return nil
+	}
+	switch {
+	case reloc:
+		r.nreloc.Inc()
+	case cold:
+		r.ndem.Inc()
+	default:
+		r.nprom.Inc()
+	}
+	r.ObjsAdd(1, size)
+	if cmn.Rom.V(5, cos.ModXs) {
+		nlog.Infoln(r.Name(), "moved", lom.Cname(), "to", mi.String())
+	}
+	return nil
+}
+
+func (r *XactTiering) Snap() *core.Snap { return r.Base.NewSnap(r) }
+
+func (r *XactTiering) CtlMsg() string {
+	var sb cos.SB
+	sb.Init(80)
+	idxAppend(&sb, "demoted", strconv.FormatInt(r.ndem.Load(), 10))
+	idxAppend(&sb, "promoted", strconv.FormatInt(r.nprom.Load(), 10))
+	if n := r.nreloc.Load(); n > 0 {
+		idxAppend(&sb, "relocated", strconv.FormatInt(n, 10))
+	}
+	if n := r.ErrCnt(); n > 0 {
+		idxAppend(&sb, "errs", strconv.Itoa(n))
+	}
+	return sb.String()
+}
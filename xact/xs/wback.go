@@ -0,0 +1,215 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Write-back (delayed data write policy, see core/lwback):
+// - walk the bucket's write-back marks (fs.WritebackCT) and upload the respective objects
+//   to the remote backend (the latter retries 429 and 503 when rate limiting is enabled);
+// - failed uploads stay marked and get retried by the next run;
+// - started periodically by the target (for buckets that may have pending uploads)
+//   and on demand (flush).
+
+type (
+	wbFactory struct {
+		xctn *XactWriteBack
+		args *xreg.WbArgs
+		xreg.RenewBase
+	}
+	XactWriteBack struct {
+		args *xreg.WbArgs
+		nerr atomic.Int64 // failed uploads
+		xact.BckJog
+	}
+)
+
+// the object's gone (e.g., deleted by a 3rd party directly from the filesystem)
+var errWbOrphan = errors.New("orphaned write-back mark")
+
+var (
+	_ core.Xact      = (*XactWriteBack)(nil)
+	_ xreg.Renewable = (*wbFactory)(nil)
+)
+
+func (*wbFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &wbFactory{
+		RenewBase: xreg.RenewBase{Args: args, Bck: bck},
+		args:      args.Custom.(*xreg.WbArgs),
+	}
+}
+
+// construction only (the renewal caller does xact.GoRunW)
+func (p *wbFactory) Start() error {
+	r := &XactWriteBack{args: p.args}
+	mpopts := &mpather.JgroupOpts{
+		Parent:  r,
+		CTs:     []string{fs.WritebackCT},
+		VisitCT: r.visit,
+		RW:      true,
+	}
+	mpopts.Bck.Copy(p.Bck.Bucket())
+	r.BckJog.Init(p.UUID(), p.Kind(), p.Bck, mpopts, cmn.GCO.Get())
+	p.xctn = r
+	return nil
+}
+
+func (*wbFactory) Kind() string     { return apc.ActWriteBack }
+func (p *wbFactory) Get() core.Xact { return p.xctn }
+
+// user-requested flush supersedes background write-back
+func (p *wbFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (xreg.WPR, error) {
+	prev := prevEntry.(*wbFactory)
+	if p.args.Flush && !prev.args.Flush {
+		return xreg.WprAbort, nil
+	}
+	return xreg.WprUse, cmn.NewErrXactUsePrev(prevEntry.Get().String())
+}
+
+func (r *XactWriteBack) Run(wg *sync.WaitGroup) {
+	wg.Done()
+
+	r.BckJog.Run()
+	err := r.BckJog.Wait()
+	if err != nil {
+		r.AddErr(err)
+	}
+	if err != nil || r.nerr.Load() > 0 {
+		core.WbSetDirty(r.Bck().Bucket()) // to retry
+	}
+	r.Finish()
+}
+
+func (r *XactWriteBack) visit(ct *core.CT, _ []byte) error {
+	lom := core.AllocLOM(ct.ObjectName())
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(ct.Bck()); err != nil {
+		return err
+	}
+	mark, err := r.upload(lom, ct.FQN())
+	switch {
+	case mark == nil:
+		return nil // raced with delete or (another) write-back
+	case err == errWbOrphan:
+		lom.Lock(true)
+		lom.WbDone(ct.FQN(), mark.Gen)
+		lom.Unlock(true)
+		return nil
+	case err != nil:
+		r.nerr.Inc()
+		core.T.StatsUpdater().Inc(stats.ErrWritebackCount)
+		r.AddErr(fmt.Errorf("%s: failed to write back %s: %w", r.Name(), lom.Cname(), err), 4, cos.ModXs)
+		return nil // keep going
+	}
+
+	lom.Lock(true)
+	if lom.WbDone(ct.FQN(), mark.Gen) {
+		if !lom.Bck().IsRemoteAIS() {
+			lom.SetCustomKey(cmn.SourceObjMD, lom.Bck().Provider)
+		}
+		if err := lom.PersistMain(lom.IsChunked()); err != nil {
+			nlog.Warningln(r.Name(), lom.Cname(), err)
+		}
+	}
+	lom.Unlock(true)
+
+	size := lom.Lsize()
+	core.T.StatsUpdater().Inc(stats.WritebackCount)
+	core.T.StatsUpdater().Add(stats.WritebackSize, size)
+	r.ObjsAdd(1, size)
+	return nil
+}
+
+// upload (under rlock) the marked generation of the object
+func (r *XactWriteBack) upload(lom *core.LOM, mfqn string) (*core.WbMark, error) {
+	lom.Lock(false)
+	defer lom.Unlock(false)
+
+	mark, err := core.LoadWbMark(mfqn)
+	if err != nil {
+		if cos.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		if cos.IsNotExist(err) {
+			err = errWbOrphan
+		}
+		return mark, err
+	}
+	if !lom.Bck().IsRemoteAIS() {
+		lom.ObjAttrs().DelStdCustom() // backend.PutObj() will set updated values
+	}
+	var (
+		bp    = core.T.Backend(lom.Bck())
+		oreq  = mark.Req()
+		ecode int
+		fh    io.ReadCloser
+	)
+	if fh, err = wbOpen(lom); err != nil {
+		return mark, err
+	}
+	ecode, err = bp.PutObj(context.Background(), fh, lom, oreq)
+
+	// poor man's retry when no rate-limit configured (compare with ais/tgtobj _retry503)
+	if err != nil && (ecode == http.StatusServiceUnavailable || ecode == http.StatusTooManyRequests) &&
+		!lom.Bprops().RateLimit.Backend.Enabled && !r.IsAborted() {
+		time.Sleep(time.Second)
+		if fh, err = wbOpen(lom); err == nil {
+			_, err = bp.PutObj(context.Background(), fh, lom, oreq)
+		}
+	}
+	return mark, err
+}
+
+func wbOpen(lom *core.LOM) (fh io.ReadCloser, err error) {
+	if lom.IsChunked() {
+		fh, err = lom.Open()
+	} else {
+		fh, err = lom.NewFileHandle(lom.FQN) // (remote gets decompressed and decrypted)
+	}
+	if err != nil {
+		err = cmn.NewErrFailedTo(core.T, "open", lom.Cname(), err)
+	}
+	return fh, err
+}
+
+func (r *XactWriteBack) Snap() *core.Snap { return r.Base.NewSnap(r) }
+
+func (r *XactWriteBack) CtlMsg() string {
+	var sb cos.SB
+	sb.Init(80)
+	if r.args.Flush {
+		idxAppend(&sb, "flush", "true")
+	}
+	if n := r.nerr.Load(); n > 0 {
+		idxAppend(&sb, "failed", strconv.FormatInt(n, 10))
+	}
+	return sb.String()
+}
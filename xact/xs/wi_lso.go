@@ -13,6 +13,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/fs"
@@ -30,6 +31,10 @@ type (
 		lomVisitedCb lomVisitedCb // A-flow: xact.Base.ObjsAdd (via xact.Base.LomAdd); R-flow: n/a
 		custom       cos.StrKVs
 		markerDir    string
+		vers         cmn.LsoEntries   // apc.LsVersions: to precede the current entry (see lsVersions)
+		snap         *cmn.BckSnapshot // apc.LsoMsg.Snapshot
+		preserved    []string         // snapshot: names of the preserved objects yet to be listed (see lsSnap)
+		lastSnap     string           // snapshot: last listed name
 		wanted       cos.BitFlags
 	}
 )
@@ -185,6 +190,9 @@ func (wi *walkInfo) _cb(lom *core.LOM, fqn string) (*cmn.LsoEnt, error) {
 		if !isOK(status) {
 			return nil, nil
 		}
+		if wi.msg.IsFlagSet(apc.LsVersions) {
+			wi.lsVersions(lom)
+		}
 		return wi.ls(lom, status), nil
 	}
 
@@ -204,6 +212,9 @@ func (wi *walkInfo) _cb(lom *core.LOM, fqn string) (*cmn.LsoEnt, error) {
 		status = apc.LocIsCopy
 	}
 	if isOK(status) {
+		if wi.msg.IsFlagSet(apc.LsVersions) {
+			wi.lsVersions(lom)
+		}
 		return wi.ls(lom, status), nil
 	}
 
@@ -227,3 +238,63 @@ func (wi *walkInfo) _cb(lom *core.LOM, fqn string) (*cmn.LsoEnt, error) {
 
 	return wi.ls(lom, status), nil
 }
+
+// apc.LsVersions: retained prior versions and delete markers, oldest first
+// (note: same name as the current object - see cmn.LsoEnt sorting)
+func (wi *walkInfo) lsVersions(lom *core.LOM) {
+	wi.vers = wi.vers[:0]
+	vers, err := lom.Versions()
+	if err != nil {
+		nlog.Warningln("list versions:", lom.Cname(), err)
+		return
+	}
+	for _, ov := range vers {
+		en := &cmn.LsoEnt{Name: lom.ObjName, Version: ov.Version, Flags: apc.LocOK | apc.EntryIsVersion}
+		if ov.DelMarker {
+			en.SetFlag(apc.EntryIsDelMarker)
+			en.Atime = cos.FormatNanoTime(ov.Time.UnixNano(), wi.msg.TimeFormat)
+		} else {
+			en.SetFlag(apc.EntryIsCached)
+			if !wi.msg.IsFlagSet(apc.LsNameOnly) {
+				vlom, err := lom.LoadVersion(ov.Version)
+				if err != nil {
+					continue // (pruned in the meantime)
+				}
+				wi.setWanted(en, vlom)
+				core.FreeLOM(vlom)
+			}
+		}
+		wi.vers = append(wi.vers, en)
+	}
+}
+
+// snapshot listing: the object as of the snapshot - preserved or current, whichever applies
+func (wi *walkInfo) lsSnap(bck *meta.Bck, name string) (*cmn.LsoEnt, error) {
+	lom := core.AllocLOM(name)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck); err != nil {
+		return nil, err
+	}
+	if _, local, err := lom.HrwTarget(wi.smap); err != nil || !local {
+		return nil, err
+	}
+	slom, err := lom.LoadSnap(wi.snap)
+	if err != nil {
+		if cos.IsNotExist(err) {
+			err = nil
+		}
+		return nil, err
+	}
+	if slom != nil {
+		en := wi.ls(slom, apc.LocOK)
+		core.FreeLOM(slom)
+		return en, nil
+	}
+	if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
+		if cmn.IsErrObjNought(err) {
+			err = nil
+		}
+		return nil, err
+	}
+	return wi.ls(lom, apc.LocOK), nil
+}